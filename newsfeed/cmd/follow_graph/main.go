@@ -0,0 +1,204 @@
+// follow_graph imports follow edges of another system into db and cache, or exports them back.
+//
+//	follow_graph import -file edges.csv -checkpoint edges.csv.ckpt
+//	follow_graph export -file edges.ndjson -format ndjson -user 42
+//
+// A run stopped by error or signal continues from where it stopped when run again with the same -checkpoint.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"ep.k16/newsfeed/cmd"
+	"ep.k16/newsfeed/config"
+	"ep.k16/newsfeed/internal/dao/audience_dao"
+	"ep.k16/newsfeed/internal/dao/user_cache"
+	"ep.k16/newsfeed/internal/dao/user_dao"
+	"ep.k16/newsfeed/internal/handler/follow_graph"
+	"ep.k16/newsfeed/internal/service/user_service"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+type options struct {
+	file       string
+	format     string
+	batchSize  int
+	checkpoint string
+	userId     int64 // export only
+}
+
+func main() {
+	if len(os.Args) < 2 || (os.Args[1] != "import" && os.Args[1] != "export") {
+		fmt.Fprintln(os.Stderr, "usage: follow_graph import|export [flags]")
+		os.Exit(2)
+	}
+	command := os.Args[1]
+
+	opts := &options{}
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	flags.StringVar(&opts.file, "file", "", "edge file to read for import or to write for export (required)")
+	flags.StringVar(&opts.format, "format", "", "csv or ndjson, detected from file extension if empty")
+	flags.IntVar(&opts.batchSize, "batch", 1000, "number of edges per batch")
+	flags.StringVar(&opts.checkpoint, "checkpoint", "", "file keeping progress to resume a stopped run")
+	if command == "export" {
+		flags.Int64Var(&opts.userId, "user", 0, "export follows of this user only, 0 means all users")
+	}
+	_ = flags.Parse(os.Args[2:])
+
+	if len(opts.file) == 0 {
+		fmt.Fprintln(os.Stderr, "-file is required")
+		os.Exit(2)
+	}
+	if len(opts.format) == 0 {
+		opts.format = detectFormat(opts.file)
+	}
+
+	if err := run(command, opts); err != nil {
+		os.Exit(1)
+	}
+}
+
+func run(command string, opts *options) error {
+	// init logger
+	if err := cmd.InitLogger(); err != nil {
+		return err
+	}
+
+	// init config
+	cfg, err := config.LoadFollowGraphConfig()
+	if err != nil {
+		logger.Error("failed to init follow graph config", logger.E(err))
+		return err
+	}
+
+	// create db conn -> db access object
+	userDao, err := user_dao.New(&user_dao.UserDbConfig{
+		Username:     cfg.DatabaseUser,
+		Password:     cfg.DatabasePassword,
+		Host:         cfg.DatabaseHost,
+		Port:         cfg.DatabasePort,
+		DatabaseName: cfg.DatabaseName,
+	})
+	if err != nil {
+		logger.Error("failed to init dai", logger.E(err))
+		return err
+	}
+	defer userDao.Stop()
+
+	// create cache
+	var userCacheDai *user_cache.CacheDao
+	if cfg.RedisEnabled {
+		userCacheDai, err = user_cache.New(user_cache.CacheConfig{
+			Host: cfg.RedisHost,
+			Port: cfg.RedisPort,
+			TTL:  0,
+		})
+		if err != nil {
+			logger.Error("failed to init user cache", logger.E(err))
+			return err
+		}
+	}
+
+	// blocks are checked before importing follows
+	audienceDao, err := audience_dao.New(&audience_dao.AudienceDbConfig{
+		Username:     cfg.DatabaseUser,
+		Password:     cfg.DatabasePassword,
+		Host:         cfg.DatabaseHost,
+		Port:         cfg.DatabasePort,
+		DatabaseName: cfg.DatabaseName,
+	})
+	if err != nil {
+		logger.Error("failed to init audience dai", logger.E(err))
+		return err
+	}
+	defer audienceDao.Stop()
+
+	// follow msgs and mails are not used by import or export
+	userService, err := user_service.New(user_service.Config{}, userDao, userCacheDai, audienceDao, nil, nil)
+	if err != nil {
+		logger.Error("failed to init user service", logger.E(err))
+		return err
+	}
+
+	tool, err := follow_graph.New(follow_graph.Config{
+		BatchSize:      opts.batchSize,
+		CheckpointFile: opts.checkpoint,
+	}, userService)
+	if err != nil {
+		logger.Error("failed to init follow graph tool", logger.E(err))
+		return err
+	}
+
+	// stop after the running batch on signal, progress is kept in checkpoint
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	var stats *follow_graph.Stats
+	if command == "import" {
+		stats, err = importEdges(ctx, tool, opts)
+	} else {
+		stats, err = exportEdges(ctx, tool, opts)
+	}
+	if errors.Is(err, context.Canceled) {
+		logger.Info("stopped, run again with the same checkpoint to resume", logger.F("stats", stats))
+		return err
+	}
+	if err != nil {
+		logger.Error("failed to "+command+" follow graph", logger.E(err), logger.F("stats", stats))
+		return err
+	}
+
+	logger.Info(command+" follow graph successfully", logger.F("stats", stats))
+	return nil
+}
+
+func importEdges(ctx context.Context, tool *follow_graph.Tool, opts *options) (*follow_graph.Stats, error) {
+	file, err := os.Open(opts.file)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader, err := follow_graph.NewEdgeReader(file, opts.format)
+	if err != nil {
+		return nil, err
+	}
+	return tool.Import(ctx, reader)
+}
+
+func exportEdges(ctx context.Context, tool *follow_graph.Tool, opts *options) (*follow_graph.Stats, error) {
+	// a resumed export appends to the file written by the stopped run
+	checkpoint, err := follow_graph.LoadCheckpoint(opts.checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	flag := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if checkpoint > 0 {
+		flag = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	}
+	file, err := os.OpenFile(opts.file, flag, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	writer, err := follow_graph.NewEdgeWriter(file, opts.format, checkpoint == 0)
+	if err != nil {
+		return nil, err
+	}
+	return tool.Export(ctx, writer, opts.userId)
+}
+
+func detectFormat(file string) string {
+	if strings.HasSuffix(file, ".ndjson") || strings.HasSuffix(file, ".jsonl") {
+		return follow_graph.FormatNDJSON
+	}
+	return follow_graph.FormatCSV
+}
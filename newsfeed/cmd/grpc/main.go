@@ -7,13 +7,20 @@ import (
 
 	"ep.k16/newsfeed/cmd"
 	"ep.k16/newsfeed/config"
+	"ep.k16/newsfeed/internal/dao/audience_dao"
+	"ep.k16/newsfeed/internal/dao/blob_store"
 	"ep.k16/newsfeed/internal/dao/kafka_producer"
+	mailer_dao "ep.k16/newsfeed/internal/dao/mailer"
 	"ep.k16/newsfeed/internal/dao/post_cache"
 	"ep.k16/newsfeed/internal/dao/post_dao"
 	"ep.k16/newsfeed/internal/dao/user_cache"
 	"ep.k16/newsfeed/internal/dao/user_dao"
+	"ep.k16/newsfeed/internal/handler/count_reconciler"
 	"ep.k16/newsfeed/internal/handler/grpc"
+	"ep.k16/newsfeed/internal/handler/reaction_flusher"
+	"ep.k16/newsfeed/internal/handler/suggestion_refresher"
 	"ep.k16/newsfeed/internal/service/post_service"
+	"ep.k16/newsfeed/internal/service/suggestion_service"
 	"ep.k16/newsfeed/internal/service/user_service"
 	"ep.k16/newsfeed/pkg/logger"
 )
@@ -45,8 +52,20 @@ func main() {
 		return
 	}
 
+	audienceDao, err := audience_dao.New(&audience_dao.AudienceDbConfig{
+		Username:     cfg.DatabaseUser,
+		Password:     cfg.DatabasePassword,
+		Host:         cfg.DatabaseHost,
+		Port:         cfg.DatabasePort,
+		DatabaseName: cfg.DatabaseName,
+	})
+	if err != nil {
+		logger.Error("failed to init audience dai", logger.E(err))
+		return
+	}
+
 	// create cache
-	var userCacheDai user_service.UserCacheDAI
+	var userCacheDai *user_cache.CacheDao
 	if cfg.RedisEnabled {
 		userCacheDai, err = user_cache.New(user_cache.CacheConfig{
 			Host: cfg.RedisHost,
@@ -84,11 +103,6 @@ func main() {
 		return
 	}
 
-	userService, err := user_service.New(userDao, userCacheDai)
-	if err != nil {
-		logger.Error("failed to init grpc service", logger.E(err))
-		return
-	}
 	// create msg producer
 	kafkaProducer, err := kafka_producer.New(kafka_producer.KafkaConfig{
 		Brokers: cfg.KafkaBrokers,
@@ -99,23 +113,112 @@ func main() {
 		return
 	}
 
-	postService, err := post_service.New(postDao, userCacheDai, postCacheDai, kafkaProducer)
+	// create mailer
+	var mailer user_service.Mailer
+	if len(cfg.SMTPHost) > 0 {
+		mailer, err = mailer_dao.NewSMTPMailer(mailer_dao.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.MailFrom,
+		})
+	} else {
+		mailer, err = mailer_dao.NewOutboxMailer(mailer_dao.OutboxConfig{
+			Dir:  cfg.MailOutboxDir,
+			From: cfg.MailFrom,
+		})
+	}
+	if err != nil {
+		logger.Error("failed to init mailer", logger.E(err))
+		return
+	}
+
+	userService, err := user_service.New(user_service.Config{
+		RefreshTokenTTL:  cfg.RefreshTokenTTL,
+		PasswordResetTTL: cfg.PasswordResetTTL,
+		PasswordResetURL: cfg.PasswordResetURL,
+
+		EmailVerificationTTL:    cfg.EmailVerificationTTL,
+		EmailVerificationURL:    cfg.EmailVerificationURL,
+		UnverifiedDeniedActions: cfg.UnverifiedDeniedActions,
+	}, userDao, userCacheDai, audienceDao, kafkaProducer, mailer)
+	if err != nil {
+		logger.Error("failed to init grpc service", logger.E(err))
+		return
+	}
+
+	// create media store
+	blobStore, err := blob_store.NewLocalStore(blob_store.LocalStoreConfig{
+		Dir:     cfg.MediaDir,
+		BaseURL: cfg.MediaBaseURL,
+	})
+	if err != nil {
+		logger.Error("failed to init blob store", logger.E(err))
+		return
+	}
+
+	postService, err := post_service.New(post_service.Config{
+		DefaultRanking: cfg.NewsfeedRanking,
+		MaxMediaSize:   cfg.MediaMaxSize,
+	}, postDao, userDao, audienceDao, userCacheDai, postCacheDai, kafkaProducer, blobStore)
 	if err != nil {
 		logger.Error("failed to init post service", logger.E(err))
 		return
 	}
 
+	suggestionService, err := suggestion_service.New(suggestion_service.Config{
+		SuggestionTTL: cfg.SuggestionTTL,
+	}, userDao, audienceDao, userCacheDai)
+	if err != nil {
+		logger.Error("failed to init suggestion service", logger.E(err))
+		return
+	}
+
 	grpcConfig := grpc.Config{
-		Host: cfg.Host,
-		Port: cfg.Port,
+		Host:         cfg.Host,
+		Port:         cfg.Port,
+		CursorSecret: cfg.CursorSecret,
+	}
+	if cfg.MediaMaxSize > 0 {
+		grpcConfig.MaxRecvMsgSize = int(cfg.MediaMaxSize) + 1<<20 // leave room for other fields of upload request
 	}
-	grpcServer, err := grpc.New(grpcConfig, userService, postService)
+	grpcServer, err := grpc.New(grpcConfig, userService, postService, suggestionService)
 	if err != nil {
 		logger.Error("failed to init grpc grpc server", logger.E(err))
 		return
 	}
 
+	reactionFlusher, err := reaction_flusher.New(reaction_flusher.Config{
+		Interval:  cfg.ReactionFlushInterval,
+		BatchSize: cfg.ReactionFlushBatchSize,
+	}, postService)
+	if err != nil {
+		logger.Error("failed to init reaction flusher", logger.E(err))
+		return
+	}
+
+	suggestionRefresher, err := suggestion_refresher.New(suggestion_refresher.Config{
+		Interval: cfg.SuggestionRefreshInterval,
+	}, suggestionService)
+	if err != nil {
+		logger.Error("failed to init suggestion refresher", logger.E(err))
+		return
+	}
+
+	countReconciler, err := count_reconciler.New(count_reconciler.Config{
+		Interval: cfg.CountReconcileInterval,
+	}, userService)
+	if err != nil {
+		logger.Error("failed to init count reconciler", logger.E(err))
+		return
+	}
+
 	// run servers
+	go reactionFlusher.Start()
+	go suggestionRefresher.Start()
+	go countReconciler.Start()
+
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 	errChan := make(chan error, 1)
@@ -138,7 +241,12 @@ func main() {
 	// shutdown
 	// TODO: add timeout for shutdown
 	grpcServer.Stop()
+	reactionFlusher.Stop()
+	suggestionRefresher.Stop()
+	countReconciler.Stop()
 	userDao.Stop()
+	postDao.Stop()
+	audienceDao.Stop()
 
 	logger.Info("process stopped")
 }
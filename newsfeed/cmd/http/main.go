@@ -41,9 +41,13 @@ func main() {
 
 	// create http server
 	httpServer, err := http.New(http.Config{
-		Host:   cfg.Host,
-		Port:   cfg.Port,
-		JwtKey: []byte(cfg.JwtKey),
+		Host:                 cfg.Host,
+		Port:                 cfg.Port,
+		JwtKeyDir:            cfg.JwtKeyDir,
+		JwtKeyReloadInterval: cfg.JwtKeyReloadInterval,
+		AccessTokenTTL:       cfg.AccessTokenTTL,
+		MediaDir:             cfg.MediaDir,
+		DevAuth:              cfg.DevAuthEnabled,
 	}, grpcCli)
 	if err != nil {
 		logger.Error("failed to init http server", logger.E(err))
@@ -8,8 +8,10 @@ import (
 
 	"ep.k16/newsfeed/cmd"
 	"ep.k16/newsfeed/config"
+	"ep.k16/newsfeed/internal/dao/audience_dao"
 	"ep.k16/newsfeed/internal/dao/post_cache"
 	"ep.k16/newsfeed/internal/dao/user_cache"
+	"ep.k16/newsfeed/internal/dao/user_dao"
 	"ep.k16/newsfeed/internal/handler/newsfeed_processor"
 	"ep.k16/newsfeed/internal/service/post_service"
 	"ep.k16/newsfeed/pkg/logger"
@@ -29,6 +31,31 @@ func main() {
 	}
 	logger.Info("init newsfeed worker config successfully", logger.F("cfg", cfg))
 
+	// create db conn -> db access object
+	userDao, err := user_dao.New(&user_dao.UserDbConfig{
+		Username:     cfg.DatabaseUser,
+		Password:     cfg.DatabasePassword,
+		Host:         cfg.DatabaseHost,
+		Port:         cfg.DatabasePort,
+		DatabaseName: cfg.DatabaseName,
+	})
+	if err != nil {
+		logger.Error("failed to init dai", logger.E(err))
+		return
+	}
+
+	audienceDao, err := audience_dao.New(&audience_dao.AudienceDbConfig{
+		Username:     cfg.DatabaseUser,
+		Password:     cfg.DatabasePassword,
+		Host:         cfg.DatabaseHost,
+		Port:         cfg.DatabasePort,
+		DatabaseName: cfg.DatabaseName,
+	})
+	if err != nil {
+		logger.Error("failed to init audience dai", logger.E(err))
+		return
+	}
+
 	// create cache
 	var postCacheDai post_service.PostCacheDAI
 	postCacheDai, err = post_cache.New(post_cache.CacheConfig{
@@ -53,7 +80,12 @@ func main() {
 	}
 
 	// create service
-	newsfeedService, err := post_service.New(nil, userCacheDai, postCacheDai, nil)
+	newsfeedService, err := post_service.New(post_service.Config{
+		NewsfeedMaxLength: cfg.NewsfeedMaxLength,
+		FanoutBatchSize:   cfg.FanoutBatchSize,
+
+		HighFollowerThreshold: cfg.HighFollowerThreshold,
+	}, nil, userDao, audienceDao, userCacheDai, postCacheDai, nil, nil)
 	if err != nil {
 		logger.Error("failed to init post service", logger.E(err))
 		return
@@ -97,6 +129,8 @@ func main() {
 	// TODO: add timeout for shutdown
 	cancel()
 	newsfeedProcessor.Stop()
+	userDao.Stop()
+	audienceDao.Stop()
 
 	logger.Info("process stopped")
 }
@@ -1,8 +1,8 @@
 package config
 
 import (
+	"fmt"
 	"os"
-	"strings"
 )
 
 type EnvType string
@@ -14,14 +14,21 @@ const (
 	EnvTypeProd  EnvType = "prod"
 )
 
-func getEnvType() EnvType {
-	e := os.Getenv(EnvKey)
-	switch {
-	case e == strings.ToLower(string(EnvTypeLocal)):
-		return EnvTypeLocal
-	case e == strings.ToLower(string(EnvTypeProd)):
-		return EnvTypeProd
+// getEnvType returns the env of ENV var, empty means local so .env file is loaded.
+// Unknown values fail instead of falling back to local, e.g. a misspelled prod must not enable local only features.
+func getEnvType() (EnvType, error) {
+	switch e := os.Getenv(EnvKey); e {
+	case "", string(EnvTypeLocal):
+		return EnvTypeLocal, nil
+	case string(EnvTypeProd):
+		return EnvTypeProd, nil
 	default:
-		return EnvTypeLocal
+		return "", fmt.Errorf("unknown %s %q, must be %s or %s", EnvKey, e, EnvTypeLocal, EnvTypeProd)
 	}
 }
+
+// IsLocalEnv checks if ENV var is explicitly local, it is read after .env file is loaded by config.
+// Features unsafe outside local development check it themselves instead of trusting their config.
+func IsLocalEnv() bool {
+	return os.Getenv(EnvKey) == string(EnvTypeLocal)
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 	"github.com/joho/godotenv"
@@ -25,10 +26,46 @@ type GrpcConfig struct {
 
 	KafkaBrokers []string `env:"KAFKA_BROKERS"`
 	KafkaTopic   string   `env:"KAFKA_TOPIC"`
+
+	NewsfeedRanking string `env:"NEWSFEED_RANKING"`
+
+	MediaDir     string `env:"MEDIA_DIR"`      // local dir storing uploaded media
+	MediaBaseURL string `env:"MEDIA_BASE_URL"` // url serving MediaDir
+	MediaMaxSize int64  `env:"MEDIA_MAX_SIZE"` // in bytes
+
+	ReactionFlushInterval  time.Duration `env:"REACTION_FLUSH_INTERVAL"`
+	ReactionFlushBatchSize int64         `env:"REACTION_FLUSH_BATCH_SIZE"`
+
+	SuggestionRefreshInterval time.Duration `env:"SUGGESTION_REFRESH_INTERVAL"`
+	SuggestionTTL             time.Duration `env:"SUGGESTION_TTL"` // should be longer than refresh interval
+
+	CountReconcileInterval time.Duration `env:"COUNT_RECONCILE_INTERVAL"`
+
+	CursorSecret string `env:"CURSOR_SECRET"`
+
+	RefreshTokenTTL time.Duration `env:"REFRESH_TOKEN_TTL"`
+
+	PasswordResetTTL time.Duration `env:"PASSWORD_RESET_TTL"`
+	PasswordResetURL string        `env:"PASSWORD_RESET_URL"`
+
+	EmailVerificationTTL    time.Duration `env:"EMAIL_VERIFICATION_TTL"`
+	EmailVerificationURL    string        `env:"EMAIL_VERIFICATION_URL"`
+	UnverifiedDeniedActions []string      `env:"UNVERIFIED_DENIED_ACTIONS"` // post, comment, react, follow
+
+	// mails are sent by SMTP if SMTP_HOST is set, otherwise they are written into MAIL_OUTBOX_DIR
+	MailFrom      string `env:"MAIL_FROM"`
+	MailOutboxDir string `env:"MAIL_OUTBOX_DIR"`
+	SMTPHost      string `env:"SMTP_HOST"`
+	SMTPPort      int    `env:"SMTP_PORT"`
+	SMTPUsername  string `env:"SMTP_USERNAME"`
+	SMTPPassword  string `env:"SMTP_PASSWORD"`
 }
 
 func LoadGrpcConfig() (*GrpcConfig, error) {
-	envType := getEnvType()
+	envType, err := getEnvType()
+	if err != nil {
+		return nil, err
+	}
 
 	if envType == EnvTypeLocal { // if local, inject env vars from local .env file
 		if err := godotenv.Load(".env"); err != nil {
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 	"github.com/joho/godotenv"
@@ -16,13 +17,22 @@ type HttpConfig struct {
 	GrpcHost string `env:"GRPC_HOST"`
 	GrpcPort int    `env:"GRPC_PORT"`
 
-	JwtKey string `env:"JWT_KEY"`
+	JwtKeyDir            string        `env:"JWT_KEY_DIR"`
+	JwtKeyReloadInterval time.Duration `env:"JWT_KEY_RELOAD_INTERVAL"`
+	AccessTokenTTL       time.Duration `env:"ACCESS_TOKEN_TTL"`
+
+	MediaDir string `env:"MEDIA_DIR"` // served at /media, empty to disable
+
+	DevAuthEnabled bool `env:"DEV_AUTH_ENABLED"` // impersonate users by header instead of token, local env only
 }
 
 // LoadHttpConfig loads config based on the environment.
-// envType: "local" (default) or "prod"
+// envType: "local" (default) or "prod", other values fail
 func LoadHttpConfig() (*HttpConfig, error) {
-	envType := getEnvType()
+	envType, err := getEnvType()
+	if err != nil {
+		return nil, err
+	}
 
 	if envType == EnvTypeLocal { // if local, inject env vars from local .env file
 		if err := godotenv.Load(".env"); err != nil {
@@ -36,5 +46,10 @@ func LoadHttpConfig() (*HttpConfig, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %s", err)
 	}
 
+	// dev auth skips authentication, so it must never be turned on by mistake outside local env
+	if cfg.DevAuthEnabled && !IsLocalEnv() {
+		return nil, fmt.Errorf("DEV_AUTH_ENABLED is only allowed with %s=%s", EnvKey, EnvTypeLocal)
+	}
+
 	return cfg, nil
 }
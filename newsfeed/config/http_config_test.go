@@ -0,0 +1,29 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadHttpConfig_DevAuth(t *testing.T) {
+	t.Setenv(EnvKey, string(EnvTypeProd))
+	t.Setenv("DEV_AUTH_ENABLED", "true")
+
+	cfg, err := LoadHttpConfig()
+
+	assert.Error(t, err)
+	assert.Nil(t, cfg)
+}
+
+func TestLoadHttpConfig_UnknownEnv(t *testing.T) {
+	for _, env := range []string{"Prod", "production"} {
+		t.Setenv(EnvKey, env)
+		t.Setenv("DEV_AUTH_ENABLED", "true")
+
+		cfg, err := LoadHttpConfig()
+
+		assert.Error(t, err)
+		assert.Nil(t, cfg)
+	}
+}
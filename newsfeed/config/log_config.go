@@ -16,7 +16,10 @@ type LogConfig struct {
 }
 
 func LoadLogConfig() (*LogConfig, error) {
-	envType := getEnvType()
+	envType, err := getEnvType()
+	if err != nil {
+		return nil, err
+	}
 
 	if envType == EnvTypeLocal { // if local, inject env vars from local .env file
 		if err := godotenv.Load(".env"); err != nil {
@@ -9,17 +9,32 @@ import (
 
 type NewsfeedWorkerConfig struct {
 	Env EnvType `env:"ENV"`
-	
+
+	// followers and audience lists are read from db while their cached sets are not complete
+	DatabaseUser     string `env:"DATABASE_USER"`
+	DatabasePassword string `env:"DATABASE_PASSWORD"`
+	DatabaseHost     string `env:"DATABASE_HOST"`
+	DatabasePort     int    `env:"DATABASE_PORT"`
+	DatabaseName     string `env:"DATABASE_NAME"`
+
 	RedisHost string `env:"REDIS_HOST"`
 	RedisPort int    `env:"REDIS_PORT"`
 
+	NewsfeedMaxLength int64 `env:"NEWSFEED_MAX_LENGTH"`
+	FanoutBatchSize   int64 `env:"NEWSFEED_FANOUT_BATCH_SIZE"`
+
+	HighFollowerThreshold int64 `env:"NEWSFEED_HIGH_FOLLOWER_THRESHOLD"`
+
 	KafkaBrokers       []string `env:"KAFKA_BROKERS"`
 	KafkaTopic         string   `env:"KAFKA_TOPIC"`
 	KafkaConsumerGroup string   `env:"KAFKA_CONSUMER_GROUP"`
 }
 
 func LoadNewsfeedWorkerConfig() (*NewsfeedWorkerConfig, error) {
-	envType := getEnvType()
+	envType, err := getEnvType()
+	if err != nil {
+		return nil, err
+	}
 
 	if envType == EnvTypeLocal { // if local, inject env vars from local .env file
 		if err := godotenv.Load(".env"); err != nil {
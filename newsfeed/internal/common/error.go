@@ -18,6 +18,7 @@ const (
 	CodeInvalidRequest ErrorCode = 100
 	CodeUnauthorized   ErrorCode = 101
 	CodeNotFound       ErrorCode = 102
+	CodeForbidden      ErrorCode = 103
 
 	// Biz: 2xx
 	CodeInvalidLogin       ErrorCode = 200
@@ -25,6 +26,7 @@ const (
 	CodeNotExistedUsername ErrorCode = 202
 	CodeNotExistedUserID   ErrorCode = 203
 	CodeNotImplemented     ErrorCode = 204
+	CodeEmailNotVerified   ErrorCode = 205
 
 	// Internal: 9xx
 	CodeInternal      ErrorCode = 900
@@ -0,0 +1,181 @@
+package audience_dao
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+type (
+	AudienceDAO struct {
+		db *gorm.DB
+	}
+
+	AudienceDbConfig struct {
+		Username     string
+		Password     string
+		Host         string
+		Port         int
+		DatabaseName string
+	}
+)
+
+func New(conf *AudienceDbConfig) (*AudienceDAO, error) {
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+		conf.Username, conf.Password, conf.Host, conf.Port, conf.DatabaseName)
+
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to db: %s", err)
+	}
+
+	return &AudienceDAO{
+		db: db,
+	}, nil
+}
+
+func NewWithGormDB(db *gorm.DB) (*AudienceDAO, error) {
+	return &AudienceDAO{db: db}, nil
+}
+
+func (d *AudienceDAO) Stop() error {
+	sqlDb, err := d.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDb.Close()
+}
+
+// AddAudienceMember adds a user to an audience list, adding an existing member does nothing
+func (d *AudienceDAO) AddAudienceMember(ctx context.Context, member *model.AudienceMember) error {
+	dbMember := &AudienceMemberDbModel{
+		OwnerID:          member.OwnerID,
+		ListName:         member.List,
+		MemberID:         member.MemberID,
+		CreatedTimestamp: member.CreatedTimestamp,
+	}
+	return d.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(dbMember).Error
+}
+
+func (d *AudienceDAO) RemoveAudienceMember(ctx context.Context, ownerId int64, list string, memberId int64) error {
+	return d.db.WithContext(ctx).
+		Where("owner_id = ? AND list_name = ? AND member_id = ?", ownerId, list, memberId).
+		Delete(&AudienceMemberDbModel{}).Error
+}
+
+func (d *AudienceDAO) IsAudienceMember(ctx context.Context, ownerId int64, list string, memberId int64) (bool, error) {
+	var count int64
+	err := d.db.WithContext(ctx).Model(&AudienceMemberDbModel{}).
+		Where("owner_id = ? AND list_name = ? AND member_id = ?", ownerId, list, memberId).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetAudienceMembersBetween returns members of a kind of list between user and given peers in both directions,
+// members of lists of user and lists of peers containing user
+func (d *AudienceDAO) GetAudienceMembersBetween(ctx context.Context, userId int64, list string, peerIds []int64) ([]*model.AudienceMember, error) {
+	if len(peerIds) == 0 {
+		return []*model.AudienceMember{}, nil
+	}
+
+	dbMembers := make([]*AudienceMemberDbModel, 0)
+	err := d.db.WithContext(ctx).
+		Where("list_name = ?", list).
+		Where(d.db.Where("owner_id = ? AND member_id IN ?", userId, peerIds).
+			Or("member_id = ? AND owner_id IN ?", userId, peerIds)).
+		Find(&dbMembers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*model.AudienceMember, len(dbMembers))
+	for i, m := range dbMembers {
+		members[i] = toAudienceMemberModel(m)
+	}
+	return members, nil
+}
+
+// GetAudienceMembersAmong returns members of a kind of list whose owner and member are both among given users
+func (d *AudienceDAO) GetAudienceMembersAmong(ctx context.Context, list string, userIds []int64) ([]*model.AudienceMember, error) {
+	if len(userIds) == 0 {
+		return []*model.AudienceMember{}, nil
+	}
+
+	dbMembers := make([]*AudienceMemberDbModel, 0)
+	err := d.db.WithContext(ctx).
+		Where("list_name = ? AND owner_id IN ? AND member_id IN ?", list, userIds, userIds).
+		Find(&dbMembers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*model.AudienceMember, len(dbMembers))
+	for i, m := range dbMembers {
+		members[i] = toAudienceMemberModel(m)
+	}
+	return members, nil
+}
+
+// GetAudienceMembersOfUser returns all members of lists of user and members of blocked and muted lists of others
+// having user as member, used to rebuild cached audience sets of user
+func (d *AudienceDAO) GetAudienceMembersOfUser(ctx context.Context, userId int64) ([]*model.AudienceMember, error) {
+	dbMembers := make([]*AudienceMemberDbModel, 0)
+	err := d.db.WithContext(ctx).
+		Where("owner_id = ?", userId).
+		Or("member_id = ? AND list_name IN ?", userId, []string{model.AudienceListBlocked, model.AudienceListMuted}).
+		Find(&dbMembers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*model.AudienceMember, len(dbMembers))
+	for i, m := range dbMembers {
+		members[i] = toAudienceMemberModel(m)
+	}
+	return members, nil
+}
+
+// GetAudienceMembers returns members of an audience list sorted by id desc (latest added first).
+// paging.Cursor holds the last id of the previous page, nil means the first page.
+func (d *AudienceDAO) GetAudienceMembers(ctx context.Context, ownerId int64, list string, paging *model.Paging) ([]*model.AudienceMember, error) {
+	query := d.db.WithContext(ctx).Model(&AudienceMemberDbModel{}).
+		Where("owner_id = ? AND list_name = ?", ownerId, list)
+	if paging.Cursor != nil {
+		query = query.Where("id < ?", paging.Cursor.ID)
+	}
+
+	dbMembers := make([]*AudienceMemberDbModel, 0, paging.Limit)
+	result := query.
+		Order("id DESC").
+		Limit(int(paging.Limit)).
+		Find(&dbMembers)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	members := make([]*model.AudienceMember, len(dbMembers))
+	for i, m := range dbMembers {
+		members[i] = toAudienceMemberModel(m)
+	}
+	return members, nil
+}
+
+func toAudienceMemberModel(member *AudienceMemberDbModel) *model.AudienceMember {
+	return &model.AudienceMember{
+		ID:               member.ID,
+		OwnerID:          member.OwnerID,
+		List:             member.ListName,
+		MemberID:         member.MemberID,
+		CreatedTimestamp: member.CreatedTimestamp,
+	}
+}
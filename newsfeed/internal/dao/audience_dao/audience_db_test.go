@@ -0,0 +1,150 @@
+package audience_dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
+)
+
+func newMockAudienceDAO(t *testing.T) (*AudienceDAO, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	dao, err := NewWithGormDB(gormDB)
+	assert.NoError(t, err)
+	return dao, mock
+}
+
+func TestAudienceDAO_AddAudienceMember(t *testing.T) {
+	// Assume
+	dao, mock := newMockAudienceDAO(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `audience_members` .* ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(1, model.AudienceListCloseFriends, 2, 1700000000).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+
+	// Act
+	err := dao.AddAudienceMember(context.Background(), &model.AudienceMember{
+		OwnerID:          1,
+		List:             model.AudienceListCloseFriends,
+		MemberID:         2,
+		CreatedTimestamp: 1700000000,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestAudienceDAO_GetAudienceMembers(t *testing.T) {
+	// Assume
+	dao, mock := newMockAudienceDAO(t)
+
+	rows := sqlmock.NewRows([]string{"id", "owner_id", "list_name", "member_id", "created_timestamp"}).
+		AddRow(4, 1, model.AudienceListCloseFriends, 3, 1700000100).
+		AddRow(2, 1, model.AudienceListCloseFriends, 2, 1700000000)
+	mock.ExpectQuery("SELECT \\* FROM `audience_members` WHERE \\(owner_id = \\? AND list_name = \\?\\) AND id < \\? ORDER BY id DESC LIMIT \\?").
+		WithArgs(1, model.AudienceListCloseFriends, 5, 2).
+		WillReturnRows(rows)
+
+	// Act
+	members, err := dao.GetAudienceMembers(context.Background(), 1, model.AudienceListCloseFriends, &model.Paging{Cursor: &cursor.Cursor{ID: 5}, Limit: 2})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, members, 2)
+	assert.Equal(t, int64(3), members[0].MemberID)
+	assert.Equal(t, int64(2), members[1].MemberID)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestAudienceDAO_GetAudienceMembersBetween(t *testing.T) {
+	// Assume
+	dao, mock := newMockAudienceDAO(t)
+
+	rows := sqlmock.NewRows([]string{"id", "owner_id", "list_name", "member_id", "created_timestamp"}).
+		AddRow(4, 1, model.AudienceListBlocked, 3, 1700000100).
+		AddRow(6, 2, model.AudienceListBlocked, 1, 1700000200)
+	mock.ExpectQuery("SELECT \\* FROM `audience_members` WHERE list_name = \\? AND \\(\\(owner_id = \\? AND member_id IN \\(\\?,\\?\\)\\) OR \\(member_id = \\? AND owner_id IN \\(\\?,\\?\\)\\)\\)").
+		WithArgs(model.AudienceListBlocked, 1, 2, 3, 1, 2, 3).
+		WillReturnRows(rows)
+
+	// Act
+	members, err := dao.GetAudienceMembersBetween(context.Background(), 1, model.AudienceListBlocked, []int64{2, 3})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, members, 2)
+	assert.Equal(t, int64(3), members[0].MemberID)
+	assert.Equal(t, int64(2), members[1].OwnerID)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestAudienceDAO_GetAudienceMembersAmong(t *testing.T) {
+	// Assume
+	dao, mock := newMockAudienceDAO(t)
+
+	rows := sqlmock.NewRows([]string{"id", "owner_id", "list_name", "member_id", "created_timestamp"}).
+		AddRow(5, 2, model.AudienceListBlocked, 1, 1700000100)
+	mock.ExpectQuery("SELECT \\* FROM `audience_members` WHERE list_name = \\? AND owner_id IN \\(\\?,\\?\\) AND member_id IN \\(\\?,\\?\\)").
+		WithArgs(model.AudienceListBlocked, 1, 2, 1, 2).
+		WillReturnRows(rows)
+
+	// Act
+	members, err := dao.GetAudienceMembersAmong(context.Background(), model.AudienceListBlocked, []int64{1, 2})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, members, 1)
+	assert.Equal(t, int64(2), members[0].OwnerID)
+	assert.Equal(t, int64(1), members[0].MemberID)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestAudienceDAO_GetAudienceMembersOfUser(t *testing.T) {
+	// Assume
+	dao, mock := newMockAudienceDAO(t)
+
+	rows := sqlmock.NewRows([]string{"id", "owner_id", "list_name", "member_id", "created_timestamp"}).
+		AddRow(4, 1, model.AudienceListCloseFriends, 3, 1700000100).
+		AddRow(6, 2, model.AudienceListBlocked, 1, 1700000200)
+	mock.ExpectQuery("SELECT \\* FROM `audience_members` WHERE owner_id = \\? OR \\(member_id = \\? AND list_name IN \\(\\?,\\?\\)\\)").
+		WithArgs(1, 1, model.AudienceListBlocked, model.AudienceListMuted).
+		WillReturnRows(rows)
+
+	// Act
+	members, err := dao.GetAudienceMembersOfUser(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, members, 2)
+	assert.Equal(t, model.AudienceListCloseFriends, members[0].List)
+	assert.Equal(t, int64(2), members[1].OwnerID)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,13 @@
+package audience_dao
+
+type AudienceMemberDbModel struct {
+	ID               int64  `gorm:"column:id"`
+	OwnerID          int64  `gorm:"column:owner_id"`
+	ListName         string `gorm:"column:list_name"`
+	MemberID         int64  `gorm:"column:member_id"`
+	CreatedTimestamp int64  `gorm:"column:created_timestamp"`
+}
+
+func (AudienceMemberDbModel) TableName() string {
+	return "audience_members"
+}
@@ -0,0 +1,93 @@
+package blob_store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type (
+	// LocalStore keeps blobs as files under a directory, which is served by http server at BaseURL
+	LocalStore struct {
+		cfg LocalStoreConfig
+	}
+	LocalStoreConfig struct {
+		Dir     string
+		BaseURL string // e.g. http://localhost:8080/media
+	}
+)
+
+func NewLocalStore(cfg LocalStoreConfig) (*LocalStore, error) {
+	if len(cfg.Dir) == 0 {
+		return nil, errors.New("dir is required")
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create dir: %s", err)
+	}
+
+	return &LocalStore{cfg: cfg}, nil
+}
+
+func (s *LocalStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	path, err := s.getPath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// write to a temp file then rename, so readers never see a partial file
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name()) // no-op after rename
+
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpFile.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), path)
+}
+
+func (s *LocalStore) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.getPath(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	path, err := s.getPath(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStore) URL(key string) string {
+	return strings.TrimRight(s.cfg.BaseURL, "/") + "/" + key
+}
+
+// getPath maps key to a file path, keys must not escape the store dir
+func (s *LocalStore) getPath(key string) (string, error) {
+	if len(key) == 0 || !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid blob key: %s", key)
+	}
+	return filepath.Join(s.cfg.Dir, filepath.FromSlash(key)), nil
+}
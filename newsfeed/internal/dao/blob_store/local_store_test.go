@@ -0,0 +1,43 @@
+package blob_store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalStore(LocalStoreConfig{
+		Dir:     t.TempDir(),
+		BaseURL: "http://localhost:8080/media/",
+	})
+	assert.NoError(t, err)
+
+	t.Run("put get delete", func(t *testing.T) {
+		key := "1/abc.png"
+
+		err := store.Put(ctx, key, []byte("data"), "image/png")
+		assert.NoError(t, err)
+
+		data, err := store.Get(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("data"), data)
+		assert.Equal(t, "http://localhost:8080/media/1/abc.png", store.URL(key))
+
+		assert.NoError(t, store.Delete(ctx, key))
+		assert.NoError(t, store.Delete(ctx, key)) // deleting missing blob is ok
+
+		_, err = store.Get(ctx, key)
+		assert.Error(t, err)
+	})
+
+	t.Run("key escaping dir", func(t *testing.T) {
+		err := store.Put(ctx, "../outside.png", []byte("data"), "image/png")
+		assert.Error(t, err)
+
+		err = store.Put(ctx, "/etc/outside.png", []byte("data"), "image/png")
+		assert.Error(t, err)
+	})
+}
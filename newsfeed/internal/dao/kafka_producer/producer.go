@@ -53,20 +53,46 @@ func (p *KafkaProducer) Stop() {
 	p.saramaProducer.Close()
 }
 
+// eventHeaderKey is the message header telling which event happens, read by newsfeed_processor
+const eventHeaderKey = "event"
+
 func (p *KafkaProducer) SendPost(ctx context.Context, post *model.Post) error {
+	return p.sendPostEvent(ctx, post, model.PostEventCreated)
+}
+
+// SendPostDeletion sends a deleted post, so newsfeed worker retracts it from newsfeeds
+func (p *KafkaProducer) SendPostDeletion(ctx context.Context, post *model.Post) error {
+	return p.sendPostEvent(ctx, post, model.PostEventDeleted)
+}
+
+// SendUnfollow sends a removed follow, so newsfeed worker purges posts of the unfollowed user from newsfeed of the follower
+func (p *KafkaProducer) SendUnfollow(ctx context.Context, follow *model.Follow) error {
+	// keyed by the unfollowed user, same as its posts, so the unfollow is consumed after posts sent before it
+	return p.sendEvent(ctx, follow.Following.ID, follow, model.FollowEventUnfollowed)
+}
+
+func (p *KafkaProducer) sendPostEvent(ctx context.Context, post *model.Post, event string) error {
+	// key is anything u want, here use user_id, so all posts from the same grpc will be consumed by the same instance.
+	// It also keeps events of a post in order, so a deletion is never consumed before its creation
+	return p.sendEvent(ctx, post.UserID, post, event)
+}
+
+func (p *KafkaProducer) sendEvent(ctx context.Context, userId int64, value any, event string) error {
 	p.wg.Add(1)
 	defer p.wg.Done()
 
-	data, err := json.Marshal(post)
+	data, err := json.Marshal(value)
 	if err != nil {
 		return err
 	}
 
 	msg := &sarama.ProducerMessage{
 		Topic: p.cfg.Topic,
-		// key is anything u want, here use user_id, so all posts from the same grpc will be consumed by the same instance
-		Key:       sarama.StringEncoder(strconv.Itoa(int(post.UserID))),
-		Value:     sarama.ByteEncoder(data),
+		Key:   sarama.StringEncoder(strconv.Itoa(int(userId))),
+		Value: sarama.ByteEncoder(data),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(eventHeaderKey), Value: []byte(event)},
+		},
 		Timestamp: time.Now(),
 	}
 
@@ -77,6 +103,7 @@ func (p *KafkaProducer) SendPost(ctx context.Context, post *model.Post) error {
 	logger.Debug("send msg successfully",
 		logger.F("partition", partition),
 		logger.F("offset", offset),
+		logger.F("event", event),
 		logger.F("message", string(data)))
 
 	return nil
@@ -0,0 +1,38 @@
+package mailer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+var ErrInvalidMail = errors.New("invalid mail")
+
+// buildMessage returns a mail as an RFC 5322 message
+func buildMessage(from string, mail *model.Mail) ([]byte, error) {
+	if len(mail.To) == 0 {
+		return nil, fmt.Errorf("%w: recipient is empty", ErrInvalidMail)
+	}
+	// line breaks in headers would let a value add its own headers or recipients
+	for _, header := range []string{from, mail.To, mail.Subject} {
+		if strings.ContainsAny(header, "\r\n") {
+			return nil, fmt.Errorf("%w: header has line break", ErrInvalidMail)
+		}
+	}
+
+	msg := &bytes.Buffer{}
+	fmt.Fprintf(msg, "From: %s\r\n", from)
+	fmt.Fprintf(msg, "To: %s\r\n", mail.To)
+	fmt.Fprintf(msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", mail.Subject))
+	fmt.Fprintf(msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+	return msg.Bytes(), nil
+}
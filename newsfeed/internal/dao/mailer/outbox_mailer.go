@@ -0,0 +1,60 @@
+package mailer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+type (
+	// OutboxMailer writes mails as .eml files into a dir instead of sending them,
+	// so local development and tests need no mail server
+	OutboxMailer struct {
+		cfg OutboxConfig
+	}
+	OutboxConfig struct {
+		Dir  string
+		From string
+	}
+)
+
+func NewOutboxMailer(cfg OutboxConfig) (*OutboxMailer, error) {
+	if len(cfg.Dir) == 0 {
+		return nil, errors.New("outbox dir is required")
+	}
+	if len(cfg.From) == 0 {
+		return nil, errors.New("mail sender is required")
+	}
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create outbox dir: %s", err)
+	}
+
+	return &OutboxMailer{cfg: cfg}, nil
+}
+
+// Send writes a mail to <dir>/<unix nano>-<random>.eml, files sort by the time they were sent
+func (m *OutboxMailer) Send(ctx context.Context, mail *model.Mail) error {
+	msg, err := buildMessage(m.cfg.From, mail)
+	if err != nil {
+		return err
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), hex.EncodeToString(suffix))
+
+	// mails have tokens, so only the owner can read them
+	if err := os.WriteFile(filepath.Join(m.cfg.Dir, name), msg, 0o600); err != nil {
+		return fmt.Errorf("failed to write mail: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func TestOutboxMailer_Send(t *testing.T) {
+	dir := t.TempDir()
+	mailer, err := NewOutboxMailer(OutboxConfig{Dir: dir, From: "newsfeed@example.com"})
+	assert.NoError(t, err)
+
+	t.Run("write mail file", func(t *testing.T) {
+		err := mailer.Send(context.Background(), &model.Mail{
+			To:      "user1@example.com",
+			Subject: "Reset your password",
+			Body:    "token",
+		})
+		assert.NoError(t, err)
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+
+		data, err := os.ReadFile(files[0])
+		assert.NoError(t, err)
+		msg := string(data)
+		assert.Contains(t, msg, "From: newsfeed@example.com\r\n")
+		assert.Contains(t, msg, "To: user1@example.com\r\n")
+		assert.Contains(t, msg, "Subject: Reset your password\r\n")
+		assert.True(t, strings.HasSuffix(msg, "token"))
+	})
+
+	t.Run("reject header injection", func(t *testing.T) {
+		err := mailer.Send(context.Background(), &model.Mail{
+			To:      "user1@example.com\r\nBcc: attacker@example.com",
+			Subject: "Reset your password",
+			Body:    "token",
+		})
+		assert.True(t, errors.Is(err, ErrInvalidMail))
+	})
+}
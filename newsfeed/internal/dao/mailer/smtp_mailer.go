@@ -0,0 +1,57 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/smtp"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+type (
+	// SMTPMailer sends mails through an SMTP server, STARTTLS is used if the server supports it
+	SMTPMailer struct {
+		cfg SMTPConfig
+	}
+	SMTPConfig struct {
+		Host     string
+		Port     int
+		Username string // empty to send without authentication
+		Password string
+		From     string // e.g. Newsfeed <no-reply@example.com>
+	}
+)
+
+func NewSMTPMailer(cfg SMTPConfig) (*SMTPMailer, error) {
+	if len(cfg.Host) == 0 {
+		return nil, errors.New("smtp host is required")
+	}
+	if cfg.Port <= 0 {
+		return nil, errors.New("smtp port is required")
+	}
+	if len(cfg.From) == 0 {
+		return nil, errors.New("mail sender is required")
+	}
+
+	return &SMTPMailer{cfg: cfg}, nil
+}
+
+// Send sends a mail, it does not stop when ctx is canceled since net/smtp does not support it
+func (m *SMTPMailer) Send(ctx context.Context, mail *model.Mail) error {
+	msg, err := buildMessage(m.cfg.From, mail)
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if len(m.cfg.Username) > 0 {
+		auth = smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)
+	}
+
+	addr := fmt.Sprintf("%s:%d", m.cfg.Host, m.cfg.Port)
+	if err := smtp.SendMail(addr, auth, m.cfg.From, []string{mail.To}, msg); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+	return nil
+}
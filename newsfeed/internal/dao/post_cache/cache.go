@@ -2,13 +2,19 @@ package post_cache
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/redis/go-redis/v9"
+
+	"ep.k16/newsfeed/internal/service/model"
 )
 
 const (
+	PostKeyFormat     = "post:%d"          // post:<postid>
 	PostsKeyFormat    = "grpc:%d:posts"    // grpc:<userid>:posts
 	NewsfeedKeyFormat = "grpc:%d:newsfeed" // grpc:<userid>:newsfeed
 )
@@ -43,3 +49,219 @@ func New(cfg CacheConfig) (*CacheDao, error) {
 	}
 	return dao, nil
 }
+
+func (dao *CacheDao) SetCachedPost(ctx context.Context, post *model.Post) error {
+	if post == nil {
+		return nil
+	}
+	data, err := json.Marshal(post)
+	if err != nil {
+		return err
+	}
+
+	key := getPostKey(post.ID)
+	err = dao.redisCli.Set(ctx, key, string(data), 0).Err() // dont set TTL
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddCachedPost caches post only if it is not cached yet and returns whether it was added.
+// A post cached by an edit or a new comment is newer than the post of the create msg, so it is kept.
+func (dao *CacheDao) AddCachedPost(ctx context.Context, post *model.Post) (bool, error) {
+	if post == nil {
+		return false, nil
+	}
+	data, err := json.Marshal(post)
+	if err != nil {
+		return false, err
+	}
+
+	return dao.redisCli.SetNX(ctx, getPostKey(post.ID), string(data), 0).Result() // dont set TTL
+}
+
+func (dao *CacheDao) GetCachedPostByID(ctx context.Context, postId int64) (*model.Post, error) {
+	key := getPostKey(postId)
+
+	data, err := dao.redisCli.Get(ctx, key).Result()
+	if err != nil && errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	post := &model.Post{}
+	err = json.Unmarshal([]byte(data), post)
+	if err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// DeleteCachedPost deletes cached post with its reactions
+func (dao *CacheDao) DeleteCachedPost(ctx context.Context, postId int64) error {
+	return dao.redisCli.Del(ctx, getPostKey(postId), getReactionsKey(postId), getReactionCountsKey(postId)).Err()
+}
+
+// AddPostToNewsfeeds adds post to newsfeed sorted sets of all given users: value=post_id, score=created timestamp.
+// Each newsfeed is trimmed to keep only the newest maxLength posts, maxLength <= 0 means no limit.
+func (dao *CacheDao) AddPostToNewsfeeds(ctx context.Context, userIds []int64, post *model.Post, maxLength int64) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	// send all commands in 1 round trip
+	pipe := dao.redisCli.Pipeline()
+	for _, userId := range userIds {
+		key := getNewsfeedKey(userId)
+		pipe.ZAdd(ctx, key, redis.Z{
+			Score:  float64(post.CreatedTimestamp),
+			Member: post.ID,
+		})
+		if maxLength > 0 {
+			// sorted set is ascending by score, so remove from the oldest, keep the last maxLength members
+			pipe.ZRemRangeByRank(ctx, key, 0, -(maxLength + 1))
+		}
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RemovePostFromNewsfeeds removes post from newsfeed sorted sets of all given users
+func (dao *CacheDao) RemovePostFromNewsfeeds(ctx context.Context, userIds []int64, postId int64) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	pipe := dao.redisCli.Pipeline()
+	for _, userId := range userIds {
+		pipe.ZRem(ctx, getNewsfeedKey(userId), postId)
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RemovePostsFromNewsfeed removes posts from newsfeed sorted set of a user
+func (dao *CacheDao) RemovePostsFromNewsfeed(ctx context.Context, userId int64, postIds []int64) error {
+	if len(postIds) == 0 {
+		return nil
+	}
+
+	members := make([]any, len(postIds))
+	for i := range postIds {
+		members[i] = postIds[i]
+	}
+	return dao.redisCli.ZRem(ctx, getNewsfeedKey(userId), members...).Err()
+}
+
+// GetCachedPostsByIDs returns cached posts in the same order as postIds, missing posts are nil
+func (dao *CacheDao) GetCachedPostsByIDs(ctx context.Context, postIds []int64) ([]*model.Post, error) {
+	if len(postIds) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, len(postIds))
+	for i := range postIds {
+		keys[i] = getPostKey(postIds[i])
+	}
+	datas, err := dao.redisCli.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*model.Post, len(postIds))
+	for i := range datas {
+		data, ok := datas[i].(string)
+		if !ok { // not cached
+			continue
+		}
+		post := &model.Post{}
+		if err := json.Unmarshal([]byte(data), post); err != nil {
+			return nil, err
+		}
+		posts[i] = post
+	}
+	return posts, nil
+}
+
+// AddPostToUserPosts adds post to sorted set of posts of its author: value=post_id, score=created timestamp.
+// It is used to pull posts of high-follower users, which are not pushed to newsfeeds of their followers.
+func (dao *CacheDao) AddPostToUserPosts(ctx context.Context, post *model.Post, maxLength int64) error {
+	key := getUserPostsKey(post.UserID)
+
+	pipe := dao.redisCli.Pipeline()
+	pipe.ZAdd(ctx, key, redis.Z{
+		Score:  float64(post.CreatedTimestamp),
+		Member: post.ID,
+	})
+	if maxLength > 0 {
+		pipe.ZRemRangeByRank(ctx, key, 0, -(maxLength + 1))
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RemovePostFromUserPosts removes post from sorted set of posts of its author
+func (dao *CacheDao) RemovePostFromUserPosts(ctx context.Context, post *model.Post) error {
+	return dao.redisCli.ZRem(ctx, getUserPostsKey(post.UserID), post.ID).Err()
+}
+
+// GetNewsfeedItems returns items of newsfeed of a user sorted by timestamp desc,
+// only items with timestamp <= maxTs are returned, maxTs <= 0 means no upper bound
+func (dao *CacheDao) GetNewsfeedItems(ctx context.Context, userId int64, maxTs int64, offset, count int64) ([]*model.FeedItem, error) {
+	return dao.getFeedItems(ctx, getNewsfeedKey(userId), maxTs, offset, count)
+}
+
+// GetUserPostItems returns items of posts of a user sorted by timestamp desc, same as GetNewsfeedItems
+func (dao *CacheDao) GetUserPostItems(ctx context.Context, userId int64, maxTs int64, offset, count int64) ([]*model.FeedItem, error) {
+	return dao.getFeedItems(ctx, getUserPostsKey(userId), maxTs, offset, count)
+}
+
+func (dao *CacheDao) getFeedItems(ctx context.Context, key string, maxTs int64, offset, count int64) ([]*model.FeedItem, error) {
+	max := "+inf"
+	if maxTs > 0 {
+		max = strconv.FormatInt(maxTs, 10)
+	}
+
+	// ZRevRangeByScore sort by score desc, means timestamp desc
+	zs, err := dao.redisCli.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+		Min:    "-inf",
+		Max:    max,
+		Offset: offset,
+		Count:  count,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.FeedItem, 0, len(zs))
+	for _, entry := range zs {
+		member := entry.Member.(string)
+		id, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return nil, errors.New("failed to parse post id from cached")
+		}
+		items = append(items, &model.FeedItem{
+			PostID:    id,
+			Timestamp: int64(entry.Score),
+		})
+	}
+	return items, nil
+}
+
+func getPostKey(postId int64) string {
+	return fmt.Sprintf(PostKeyFormat, postId)
+}
+
+func getUserPostsKey(userId int64) string {
+	return fmt.Sprintf(PostsKeyFormat, userId)
+}
+
+func getNewsfeedKey(userId int64) string {
+	return fmt.Sprintf(NewsfeedKeyFormat, userId)
+}
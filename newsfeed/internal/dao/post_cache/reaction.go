@@ -0,0 +1,227 @@
+package post_cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+const (
+	ReactionsKeyFormat      = "post:%d:reactions"       // post:<postid>:reactions, hash user_id -> reaction type
+	ReactionCountsKeyFormat = "post:%d:reaction_counts" // post:<postid>:reaction_counts, hash reaction type -> count
+	DirtyReactionsKey       = "post:reactions:dirty"    // set of <postid>:<userid> changed since the last flush
+
+	// reactionsLoadedField marks that reactions of a post are loaded from db,
+	// so a missing counts hash means cache loss instead of a post without reactions
+	reactionsLoadedField = "_loaded"
+)
+
+// setReactionScript changes reaction of a user and counters atomically, returns -1 if reactions are not loaded
+var setReactionScript = redis.NewScript(`
+if redis.call('HEXISTS', KEYS[2], ARGV[4]) == 0 then
+	return -1
+end
+local old = redis.call('HGET', KEYS[1], ARGV[1])
+if (old == ARGV[2]) or (not old and ARGV[2] == '') then
+	return 0
+end
+if old then
+	redis.call('HINCRBY', KEYS[2], old, -1)
+end
+if ARGV[2] == '' then
+	redis.call('HDEL', KEYS[1], ARGV[1])
+else
+	redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+	redis.call('HINCRBY', KEYS[2], ARGV[2], 1)
+end
+redis.call('SADD', KEYS[3], ARGV[3])
+return 1
+`)
+
+// loadReactionsScript fills reactions of a post unless another request has loaded them, ARGV: loaded field, user_id, type, ...
+var loadReactionsScript = redis.NewScript(`
+if redis.call('HEXISTS', KEYS[2], ARGV[1]) == 1 then
+	return 0
+end
+redis.call('DEL', KEYS[1], KEYS[2])
+for i = 2, #ARGV, 2 do
+	redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 1])
+	redis.call('HINCRBY', KEYS[2], ARGV[i + 1], 1)
+end
+redis.call('HSET', KEYS[2], ARGV[1], 1)
+return 1
+`)
+
+// SetReaction sets reaction of a user on a post, empty reactionType removes it.
+// It returns false if reactions of the post are not loaded, they must be loaded by LoadReactions first.
+func (dao *CacheDao) SetReaction(ctx context.Context, postId, userId int64, reactionType string) (bool, error) {
+	keys := []string{getReactionsKey(postId), getReactionCountsKey(postId), DirtyReactionsKey}
+	res, err := setReactionScript.Run(ctx, dao.redisCli, keys,
+		userId, reactionType, toDirtyReactionMember(postId, userId), reactionsLoadedField).Int()
+	if err != nil {
+		return false, err
+	}
+	return res >= 0, nil
+}
+
+// LoadReactions fills cached reactions of a post from persisted ones, it does nothing if they are already loaded
+func (dao *CacheDao) LoadReactions(ctx context.Context, postId int64, reactions []*model.Reaction) error {
+	keys := []string{getReactionsKey(postId), getReactionCountsKey(postId)}
+	args := make([]any, 0, 1+2*len(reactions))
+	args = append(args, reactionsLoadedField)
+	for _, reaction := range reactions {
+		args = append(args, reaction.UserID, reaction.Type)
+	}
+	return loadReactionsScript.Run(ctx, dao.redisCli, keys, args...).Err()
+}
+
+// GetPostReactions returns reaction counters of posts and reaction of viewer in the same order as postIds,
+// entries of posts whose reactions are not loaded are nil
+func (dao *CacheDao) GetPostReactions(ctx context.Context, postIds []int64, viewerId int64) ([]*model.PostReactions, error) {
+	if len(postIds) == 0 {
+		return nil, nil
+	}
+
+	pipe := dao.redisCli.Pipeline()
+	countCmds := make([]*redis.MapStringStringCmd, len(postIds))
+	viewerCmds := make([]*redis.StringCmd, len(postIds))
+	for i, postId := range postIds {
+		countCmds[i] = pipe.HGetAll(ctx, getReactionCountsKey(postId))
+		viewerCmds[i] = pipe.HGet(ctx, getReactionsKey(postId), strconv.FormatInt(viewerId, 10))
+	}
+	_, _ = pipe.Exec(ctx) // errors are checked per command, missing viewer reaction is not an error
+
+	res := make([]*model.PostReactions, len(postIds))
+	for i := range postIds {
+		if err := countCmds[i].Err(); err != nil {
+			return nil, err
+		}
+		if err := viewerCmds[i].Err(); err != nil && !errors.Is(err, redis.Nil) {
+			return nil, err
+		}
+
+		counts := countCmds[i].Val()
+		if _, ok := counts[reactionsLoadedField]; !ok {
+			continue
+		}
+
+		reactions := &model.PostReactions{
+			Counts:         make(map[string]int64),
+			ViewerReaction: viewerCmds[i].Val(), // empty if not reacted
+		}
+		for reactionType, countStr := range counts {
+			if reactionType == reactionsLoadedField {
+				continue
+			}
+			count, err := strconv.ParseInt(countStr, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse reaction count of post %d", postIds[i])
+			}
+			if count > 0 {
+				reactions.Counts[reactionType] = count
+			}
+		}
+		res[i] = reactions
+	}
+	return res, nil
+}
+
+// PopDirtyReactions removes up to count changed reactions from the dirty set and returns their current state,
+// removed reactions have empty Type
+func (dao *CacheDao) PopDirtyReactions(ctx context.Context, count int64) ([]*model.Reaction, error) {
+	members, err := dao.redisCli.SPopN(ctx, DirtyReactionsKey, count).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	reactions := make([]*model.Reaction, 0, len(members))
+	for _, member := range members {
+		postId, userId, err := parseDirtyReactionMember(member)
+		if err != nil {
+			return nil, err
+		}
+		reactions = append(reactions, &model.Reaction{PostID: postId, UserID: userId})
+	}
+
+	pipe := dao.redisCli.Pipeline()
+	loadedCmds := make([]*redis.BoolCmd, len(reactions))
+	typeCmds := make([]*redis.StringCmd, len(reactions))
+	for i, reaction := range reactions {
+		loadedCmds[i] = pipe.HExists(ctx, getReactionCountsKey(reaction.PostID), reactionsLoadedField)
+		typeCmds[i] = pipe.HGet(ctx, getReactionsKey(reaction.PostID), strconv.FormatInt(reaction.UserID, 10))
+	}
+	_, _ = pipe.Exec(ctx) // errors are checked per command, missing reaction means it was removed
+
+	res := make([]*model.Reaction, 0, len(reactions))
+	for i, reaction := range reactions {
+		err := loadedCmds[i].Err()
+		if err == nil {
+			err = typeCmds[i].Err()
+		}
+		if err != nil && !errors.Is(err, redis.Nil) {
+			// put them back, so they are flushed next time
+			if addErr := dao.AddDirtyReactions(ctx, reactions); addErr != nil {
+				return nil, fmt.Errorf("%s, failed to restore dirty reactions: %s", err, addErr)
+			}
+			return nil, err
+		}
+
+		// reactions of post are lost with cache, its unflushed changes are lost too,
+		// flushing an empty state would delete persisted reactions
+		if !loadedCmds[i].Val() {
+			continue
+		}
+		reaction.Type = typeCmds[i].Val()
+		res = append(res, reaction)
+	}
+	return res, nil
+}
+
+// AddDirtyReactions marks reactions as changed, it is used to retry flushing them
+func (dao *CacheDao) AddDirtyReactions(ctx context.Context, reactions []*model.Reaction) error {
+	if len(reactions) == 0 {
+		return nil
+	}
+	members := make([]any, len(reactions))
+	for i, reaction := range reactions {
+		members[i] = toDirtyReactionMember(reaction.PostID, reaction.UserID)
+	}
+	return dao.redisCli.SAdd(ctx, DirtyReactionsKey, members...).Err()
+}
+
+func toDirtyReactionMember(postId, userId int64) string {
+	return fmt.Sprintf("%d:%d", postId, userId)
+}
+
+func parseDirtyReactionMember(member string) (int64, int64, error) {
+	parts := strings.Split(member, ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid dirty reaction: %s", member)
+	}
+	postId, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid dirty reaction: %s", member)
+	}
+	userId, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid dirty reaction: %s", member)
+	}
+	return postId, userId, nil
+}
+
+func getReactionsKey(postId int64) string {
+	return fmt.Sprintf(ReactionsKeyFormat, postId)
+}
+
+func getReactionCountsKey(postId int64) string {
+	return fmt.Sprintf(ReactionCountsKeyFormat, postId)
+}
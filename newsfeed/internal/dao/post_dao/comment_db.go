@@ -0,0 +1,128 @@
+package post_dao
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+// CreateComment inserts comment and increases comment count of its post and reply count of its parent
+func (d *PostDAO) CreateComment(ctx context.Context, comment *model.Comment) (*model.Comment, error) {
+	dbComment := &CommentDbModel{
+		PostID:           comment.PostID,
+		UserID:           comment.UserID,
+		ParentID:         comment.ParentID,
+		Content:          comment.Content,
+		CreatedTimestamp: comment.CreatedTimestamp,
+		Removed:          false,
+	}
+
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(dbComment).Error; err != nil {
+			return err
+		}
+
+		err := tx.Model(&PostDbModel{}).Where("id = ?", comment.PostID).
+			Update("comment_count", gorm.Expr("comment_count + ?", 1)).Error
+		if err != nil {
+			return err
+		}
+
+		if comment.ParentID > 0 {
+			err = tx.Model(&CommentDbModel{}).Where("id = ?", comment.ParentID).
+				Update("reply_count", gorm.Expr("reply_count + ?", 1)).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCommentModel(dbComment), nil
+}
+
+func (d *PostDAO) GetCommentByID(ctx context.Context, commentId int64) (*model.Comment, error) {
+	dbComment := &CommentDbModel{}
+	err := d.db.WithContext(ctx).Where("id=? and removed=false", commentId).First(dbComment).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toCommentModel(dbComment), nil
+}
+
+// GetCommentsByPostID returns comments of a post sorted by id asc (oldest first).
+// parentId = 0 returns top-level comments, otherwise replies of that comment.
+// paging.Cursor holds the last comment id of the previous page, nil means the first page.
+func (d *PostDAO) GetCommentsByPostID(ctx context.Context, postId, parentId int64, paging *model.Paging) ([]*model.Comment, error) {
+	query := d.db.WithContext(ctx).Model(&CommentDbModel{}).
+		Where("post_id = ? AND parent_id = ? AND removed = ?", postId, parentId, false)
+	if paging.Cursor != nil {
+		query = query.Where("id > ?", paging.Cursor.ID)
+	}
+
+	dbComments := make([]*CommentDbModel, 0, paging.Limit)
+	result := query.
+		Order("id ASC").
+		Limit(int(paging.Limit)).
+		Find(&dbComments)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	comments := make([]*model.Comment, len(dbComments))
+	for i := range dbComments {
+		comments[i] = toCommentModel(dbComments[i])
+	}
+	return comments, nil
+}
+
+// DeleteComment removes comment with its replies and decreases comment count of its post accordingly
+func (d *PostDAO) DeleteComment(ctx context.Context, comment *model.Comment) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&CommentDbModel{}).
+			Where("(id = ? OR parent_id = ?) AND removed = ?", comment.ID, comment.ID, false).
+			Update("removed", true)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 { // already removed
+			return nil
+		}
+
+		err := tx.Model(&PostDbModel{}).Where("id = ?", comment.PostID).
+			Update("comment_count", gorm.Expr("comment_count - ?", result.RowsAffected)).Error
+		if err != nil {
+			return err
+		}
+
+		if comment.ParentID > 0 {
+			err = tx.Model(&CommentDbModel{}).Where("id = ?", comment.ParentID).
+				Update("reply_count", gorm.Expr("reply_count - ?", 1)).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func toCommentModel(comment *CommentDbModel) *model.Comment {
+	return &model.Comment{
+		ID:               comment.ID,
+		PostID:           comment.PostID,
+		UserID:           comment.UserID,
+		ParentID:         comment.ParentID,
+		Content:          comment.Content,
+		ReplyCount:       comment.ReplyCount,
+		CreatedTimestamp: comment.CreatedTimestamp,
+	}
+}
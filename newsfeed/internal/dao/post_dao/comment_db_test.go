@@ -0,0 +1,94 @@
+package post_dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
+)
+
+func TestPostDAO_CreateComment(t *testing.T) {
+	// Assume
+	dao, mock := newMockPostDAO(t)
+
+	comment := &model.Comment{
+		PostID:           10,
+		UserID:           1,
+		ParentID:         5,
+		Content:          "nice",
+		CreatedTimestamp: 1700000000,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `comments`").
+		WillReturnResult(sqlmock.NewResult(6, 1))
+	mock.ExpectExec("UPDATE `posts` SET `comment_count`=comment_count \\+ \\? WHERE id = \\?").
+		WithArgs(1, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `comments` SET `reply_count`=reply_count \\+ \\? WHERE id = \\?").
+		WithArgs(1, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Act
+	created, err := dao.CreateComment(context.Background(), comment)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), created.ID)
+	assert.Equal(t, int64(5), created.ParentID)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestPostDAO_DeleteComment(t *testing.T) {
+	// Assume
+	dao, mock := newMockPostDAO(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `comments` SET `removed`=\\? WHERE \\(id = \\? OR parent_id = \\?\\) AND removed = \\?").
+		WithArgs(true, 5, 5, false).
+		WillReturnResult(sqlmock.NewResult(0, 3)) // comment with 2 replies
+	mock.ExpectExec("UPDATE `posts` SET `comment_count`=comment_count - \\? WHERE id = \\?").
+		WithArgs(3, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Act
+	err := dao.DeleteComment(context.Background(), &model.Comment{ID: 5, PostID: 10})
+
+	// Assert
+	assert.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestPostDAO_GetCommentsByPostID(t *testing.T) {
+	// Assume
+	dao, mock := newMockPostDAO(t)
+
+	rows := sqlmock.NewRows([]string{"id", "post_id", "user_id", "parent_id", "content"}).
+		AddRow(6, 10, 1, 0, "first").
+		AddRow(7, 10, 2, 0, "second")
+	mock.ExpectQuery("SELECT \\* FROM `comments` WHERE \\(post_id = \\? AND parent_id = \\? AND removed = \\?\\) AND id > \\? ORDER BY id ASC LIMIT \\?").
+		WithArgs(10, 0, false, 5, 2).
+		WillReturnRows(rows)
+
+	// Act
+	comments, err := dao.GetCommentsByPostID(context.Background(), 10, 0, &model.Paging{Cursor: &cursor.Cursor{ID: 5}, Limit: 2})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, comments, 2)
+	assert.Equal(t, int64(6), comments[0].ID)
+	assert.Equal(t, int64(7), comments[1].ID)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,110 @@
+package post_dao
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func (d *PostDAO) CreateMedia(ctx context.Context, media *model.Media) (*model.Media, error) {
+	dbMedia := &MediaDbModel{
+		UserID:           media.UserID,
+		ContentType:      media.ContentType,
+		Size:             media.Size,
+		Width:            media.Width,
+		Height:           media.Height,
+		BlobKey:          media.BlobKey,
+		ThumbnailKey:     media.ThumbnailKey,
+		CreatedTimestamp: media.CreatedTimestamp,
+	}
+
+	result := d.db.WithContext(ctx).Create(dbMedia)
+	if err := result.Error; err != nil {
+		return nil, err
+	}
+	return toMediaModel(dbMedia), nil
+}
+
+// GetMediaByIDs returns existing media of given ids, sorted by id
+func (d *PostDAO) GetMediaByIDs(ctx context.Context, mediaIds []int64) ([]*model.Media, error) {
+	if len(mediaIds) == 0 {
+		return nil, nil
+	}
+
+	dbMedia := make([]*MediaDbModel, 0, len(mediaIds))
+	err := d.db.WithContext(ctx).Where("id IN ?", mediaIds).Order("id").Find(&dbMedia).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*model.Media, len(dbMedia))
+	for i := range dbMedia {
+		res[i] = toMediaModel(dbMedia[i])
+	}
+	return res, nil
+}
+
+// attachMedia links media to a new post in its transaction.
+// Only unattached media of the post author are updated, so a media cannot be attached to 2 posts.
+func attachMedia(tx *gorm.DB, post *PostDbModel, mediaIds []int64) error {
+	for i, mediaId := range mediaIds {
+		result := tx.Model(&MediaDbModel{}).
+			Where("id = ? AND user_id = ? AND post_id = ?", mediaId, post.UserID, 0).
+			Updates(map[string]any{
+				"post_id":  post.ID,
+				"position": i,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("media %d cannot be attached", mediaId)
+		}
+	}
+	return nil
+}
+
+// loadPostMedia sets MediaIDs and Media of posts by 1 query
+func (d *PostDAO) loadPostMedia(ctx context.Context, posts []*model.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postById := make(map[int64]*model.Post, len(posts))
+	postIds := make([]int64, len(posts))
+	for i, post := range posts {
+		postById[post.ID] = post
+		postIds[i] = post.ID
+	}
+
+	dbMedia := make([]*MediaDbModel, 0)
+	err := d.db.WithContext(ctx).Where("post_id IN ?", postIds).Order("post_id, position").Find(&dbMedia).Error
+	if err != nil {
+		return err
+	}
+
+	for _, m := range dbMedia {
+		post := postById[m.PostID]
+		post.MediaIDs = append(post.MediaIDs, m.ID)
+		post.Media = append(post.Media, toMediaModel(m))
+	}
+	return nil
+}
+
+func toMediaModel(media *MediaDbModel) *model.Media {
+	return &model.Media{
+		ID:               media.ID,
+		UserID:           media.UserID,
+		PostID:           media.PostID,
+		ContentType:      media.ContentType,
+		Size:             media.Size,
+		Width:            media.Width,
+		Height:           media.Height,
+		BlobKey:          media.BlobKey,
+		ThumbnailKey:     media.ThumbnailKey,
+		CreatedTimestamp: media.CreatedTimestamp,
+	}
+}
@@ -1,8 +1,71 @@
 package post_dao
 
 type PostDbModel struct {
+	ID               int64  `gorm:"column:id"`
+	UserID           int64  `gorm:"column:user_id"`
+	Content          string `gorm:"column:content"`
+	CreatedTimestamp int64  `gorm:"column:created_timestamp"`
+	Removed          bool   `gorm:"column:removed"`
+	CommentCount     int64  `gorm:"column:comment_count"` // denormalized, updated with comments in the same transaction
+	EditedTimestamp  int64  `gorm:"column:edited_timestamp"`
+	Visibility       string `gorm:"column:visibility"`
 }
 
 func (PostDbModel) TableName() string {
 	return "posts"
 }
+
+type MediaDbModel struct {
+	ID               int64  `gorm:"column:id"`
+	UserID           int64  `gorm:"column:user_id"`
+	PostID           int64  `gorm:"column:post_id"`
+	Position         int    `gorm:"column:position"` // order in attached post
+	ContentType      string `gorm:"column:content_type"`
+	Size             int64  `gorm:"column:size"`
+	Width            int    `gorm:"column:width"`
+	Height           int    `gorm:"column:height"`
+	BlobKey          string `gorm:"column:blob_key"`
+	ThumbnailKey     string `gorm:"column:thumbnail_key"`
+	CreatedTimestamp int64  `gorm:"column:created_timestamp"`
+}
+
+func (MediaDbModel) TableName() string {
+	return "media"
+}
+
+type CommentDbModel struct {
+	ID               int64  `gorm:"column:id"`
+	PostID           int64  `gorm:"column:post_id"`
+	UserID           int64  `gorm:"column:user_id"`
+	ParentID         int64  `gorm:"column:parent_id"`
+	Content          string `gorm:"column:content"`
+	ReplyCount       int64  `gorm:"column:reply_count"`
+	CreatedTimestamp int64  `gorm:"column:created_timestamp"`
+	Removed          bool   `gorm:"column:removed"`
+}
+
+func (CommentDbModel) TableName() string {
+	return "comments"
+}
+
+type PostReactionDbModel struct {
+	PostID           int64  `gorm:"column:post_id;primaryKey"`
+	UserID           int64  `gorm:"column:user_id;primaryKey"`
+	ReactionType     string `gorm:"column:reaction_type"`
+	UpdatedTimestamp int64  `gorm:"column:updated_timestamp"`
+}
+
+func (PostReactionDbModel) TableName() string {
+	return "post_reactions"
+}
+
+type PostRevisionDbModel struct {
+	ID               int64  `gorm:"column:id"`
+	PostID           int64  `gorm:"column:post_id"`
+	Content          string `gorm:"column:content"`
+	CreatedTimestamp int64  `gorm:"column:created_timestamp"`
+}
+
+func (PostRevisionDbModel) TableName() string {
+	return "post_revisions"
+}
@@ -2,6 +2,7 @@ package post_dao
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"gorm.io/driver/mysql"
@@ -38,6 +39,120 @@ func New(conf *PostDbConfig) (*PostDAO, error) {
 	}, nil
 }
 
+func NewWithGormDB(db *gorm.DB) (*PostDAO, error) {
+	return &PostDAO{db: db}, nil
+}
+
+func (d *PostDAO) Stop() error {
+	sqlDb, err := d.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDb.Close()
+}
+
 func (d *PostDAO) CreatePost(ctx context.Context, post *model.Post) (*model.Post, error) {
-	panic("implement me")
+	dbPost := &PostDbModel{
+		UserID:           post.UserID,
+		Content:          post.Content,
+		CreatedTimestamp: post.CreatedTimestamp,
+		Removed:          false,
+		Visibility:       post.Visibility,
+	}
+
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(dbPost).Error; err != nil {
+			return err
+		}
+		if err := updatePostCount(tx, dbPost.UserID, 1); err != nil {
+			return err
+		}
+		return attachMedia(tx, dbPost, post.MediaIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	createdPost := toPostModel(dbPost)
+	createdPost.MediaIDs = post.MediaIDs
+	return createdPost, nil
+}
+
+func (d *PostDAO) GetPostByID(ctx context.Context, postId int64) (*model.Post, error) {
+	dbPost := &PostDbModel{}
+	err := d.db.WithContext(ctx).Where("id=? and removed=false", postId).First(dbPost).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	post := toPostModel(dbPost)
+	if err := d.loadPostMedia(ctx, []*model.Post{post}); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// DeletePost marks a post as removed, deleting a removed post does nothing
+func (d *PostDAO) DeletePost(ctx context.Context, post *model.Post) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PostDbModel{}).
+			Where("id = ? AND removed = ?", post.ID, false).
+			Update("removed", true)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return updatePostCount(tx, post.UserID, -1)
+	})
+}
+
+// updatePostCount adds delta to post count of user, db should be a transaction changing posts of user
+func updatePostCount(db *gorm.DB, userId int64, delta int64) error {
+	return db.Table("users").Where("id = ?", userId).
+		Update("post_count", gorm.Expr("post_count + ?", delta)).Error
+}
+
+// GetPostsByUserID returns posts of a user having one of given visibilities sorted by id desc (newest first).
+// paging.Cursor holds the last post id of the previous page, nil means the first page.
+func (d *PostDAO) GetPostsByUserID(ctx context.Context, userId int64, visibilities []string, paging *model.Paging) ([]*model.Post, error) {
+	query := d.db.WithContext(ctx).Model(&PostDbModel{}).
+		Where("user_id = ? AND removed = ? AND visibility IN ?", userId, false, visibilities)
+	if paging.Cursor != nil {
+		query = query.Where("id < ?", paging.Cursor.ID)
+	}
+
+	dbPosts := make([]*PostDbModel, 0, paging.Limit)
+	result := query.
+		Order("id DESC"). // id is auto increment, so it follows created order
+		Limit(int(paging.Limit)).
+		Find(&dbPosts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	posts := make([]*model.Post, len(dbPosts))
+	for i := range dbPosts {
+		posts[i] = toPostModel(dbPosts[i])
+	}
+	if err := d.loadPostMedia(ctx, posts); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+func toPostModel(post *PostDbModel) *model.Post {
+	return &model.Post{
+		ID:               post.ID,
+		UserID:           post.UserID,
+		Content:          post.Content,
+		CreatedTimestamp: post.CreatedTimestamp,
+		CommentCount:     post.CommentCount,
+		EditedTimestamp:  post.EditedTimestamp,
+		Visibility:       post.Visibility,
+	}
 }
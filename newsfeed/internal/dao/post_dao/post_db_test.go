@@ -0,0 +1,163 @@
+package post_dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
+)
+
+func newMockPostDAO(t *testing.T) (*PostDAO, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	return &PostDAO{db: gormDB}, mock
+}
+
+func TestPostDAO_CreatePost(t *testing.T) {
+	// Assume
+	dao, mock := newMockPostDAO(t)
+
+	post := &model.Post{
+		UserID:           1,
+		Content:          "hello world",
+		CreatedTimestamp: 1700000000,
+		Visibility:       model.VisibilityPublic,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").
+		WithArgs(
+			post.UserID,
+			post.Content,
+			post.CreatedTimestamp,
+			false, // removed
+			0,     // comment_count
+			0,     // edited_timestamp
+			post.Visibility,
+		).
+		WillReturnResult(sqlmock.NewResult(10, 1))
+	mock.ExpectExec("UPDATE `users` SET `post_count`=post_count \\+ \\? WHERE id = \\?").
+		WithArgs(1, post.UserID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Act
+	created, err := dao.CreatePost(context.Background(), post)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &model.Post{
+		ID:               10,
+		UserID:           1,
+		Content:          "hello world",
+		CreatedTimestamp: 1700000000,
+		Visibility:       model.VisibilityPublic,
+	}, created)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestPostDAO_CreatePost_WithMedia(t *testing.T) {
+	// Assume
+	dao, mock := newMockPostDAO(t)
+
+	post := &model.Post{
+		UserID:           1,
+		CreatedTimestamp: 1700000000,
+		MediaIDs:         []int64{21, 20},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO").
+		WillReturnResult(sqlmock.NewResult(10, 1))
+	mock.ExpectExec("UPDATE `users` SET `post_count`").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `media` SET `position`=\\?,`post_id`=\\? WHERE id = \\? AND user_id = \\? AND post_id = \\?").
+		WithArgs(0, 10, 21, 1, 0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `media`").
+		WithArgs(1, 10, 20, 1, 0).
+		WillReturnResult(sqlmock.NewResult(0, 0)) // attached by another post
+	mock.ExpectRollback()
+
+	// Act
+	created, err := dao.CreatePost(context.Background(), post)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, created)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestPostDAO_GetPostsByUserID(t *testing.T) {
+	// Assume
+	dao, mock := newMockPostDAO(t)
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "content", "created_timestamp", "removed"}).
+		AddRow(9, 1, "second", 1700000100, false).
+		AddRow(8, 1, "first", 1700000000, false)
+	mock.ExpectQuery("SELECT \\* FROM `posts` WHERE \\(user_id = \\? AND removed = \\? AND visibility IN \\(\\?,\\?\\)\\) AND id < \\? ORDER BY id DESC LIMIT \\?").
+		WithArgs(1, false, model.VisibilityPublic, model.VisibilityFollowers, 10, 2).
+		WillReturnRows(rows)
+	mediaRows := sqlmock.NewRows([]string{"id", "user_id", "post_id", "position", "blob_key"}).
+		AddRow(21, 1, 9, 0, "1/b.png").
+		AddRow(20, 1, 9, 1, "1/a.png")
+	mock.ExpectQuery("SELECT \\* FROM `media` WHERE post_id IN \\(\\?,\\?\\) ORDER BY post_id, position").
+		WithArgs(9, 8).
+		WillReturnRows(mediaRows)
+
+	// Act
+	visibilities := []string{model.VisibilityPublic, model.VisibilityFollowers}
+	posts, err := dao.GetPostsByUserID(context.Background(), 1, visibilities, &model.Paging{Cursor: &cursor.Cursor{ID: 10}, Limit: 2})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, posts, 2)
+	assert.Equal(t, int64(9), posts[0].ID)
+	assert.Equal(t, []int64{21, 20}, posts[0].MediaIDs)
+	assert.Equal(t, int64(8), posts[1].ID)
+	assert.Empty(t, posts[1].MediaIDs)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestPostDAO_DeletePost(t *testing.T) {
+	// Assume
+	dao, mock := newMockPostDAO(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `posts` SET `removed`=\\? WHERE id = \\? AND removed = \\?").
+		WithArgs(true, 10, false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `users` SET `post_count`=post_count \\+ \\? WHERE id = \\?").
+		WithArgs(-1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Act
+	err := dao.DeletePost(context.Background(), &model.Post{ID: 10, UserID: 1})
+
+	// Assert
+	assert.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
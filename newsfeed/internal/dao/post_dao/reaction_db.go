@@ -0,0 +1,69 @@
+package post_dao
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func (d *PostDAO) GetReactionsByPostID(ctx context.Context, postId int64) ([]*model.Reaction, error) {
+	dbReactions := make([]*PostReactionDbModel, 0)
+	err := d.db.WithContext(ctx).Where("post_id = ?", postId).Find(&dbReactions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	reactions := make([]*model.Reaction, len(dbReactions))
+	for i, r := range dbReactions {
+		reactions[i] = &model.Reaction{
+			PostID:           r.PostID,
+			UserID:           r.UserID,
+			Type:             r.ReactionType,
+			UpdatedTimestamp: r.UpdatedTimestamp,
+		}
+	}
+	return reactions, nil
+}
+
+// SaveReactions upserts reactions in 1 transaction, reactions with empty Type are deleted
+func (d *PostDAO) SaveReactions(ctx context.Context, reactions []*model.Reaction) error {
+	if len(reactions) == 0 {
+		return nil
+	}
+
+	upserts := make([]*PostReactionDbModel, 0, len(reactions))
+	deletes := make([][]any, 0)
+	for _, r := range reactions {
+		if len(r.Type) == 0 {
+			deletes = append(deletes, []any{r.PostID, r.UserID})
+			continue
+		}
+		upserts = append(upserts, &PostReactionDbModel{
+			PostID:           r.PostID,
+			UserID:           r.UserID,
+			ReactionType:     r.Type,
+			UpdatedTimestamp: r.UpdatedTimestamp,
+		})
+	}
+
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(upserts) > 0 {
+			err := tx.Clauses(clause.OnConflict{
+				DoUpdates: clause.AssignmentColumns([]string{"reaction_type", "updated_timestamp"}),
+			}).Create(&upserts).Error
+			if err != nil {
+				return err
+			}
+		}
+		if len(deletes) > 0 {
+			err := tx.Where("(post_id, user_id) IN ?", deletes).Delete(&PostReactionDbModel{}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
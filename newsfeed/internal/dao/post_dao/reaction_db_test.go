@@ -0,0 +1,39 @@
+package post_dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func TestPostDAO_SaveReactions(t *testing.T) {
+	// Assume
+	dao, mock := newMockPostDAO(t)
+
+	reactions := []*model.Reaction{
+		{PostID: 10, UserID: 1, Type: model.ReactionLike, UpdatedTimestamp: 1700000000},
+		{PostID: 10, UserID: 2, UpdatedTimestamp: 1700000000}, // removed
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `post_reactions` .* ON DUPLICATE KEY UPDATE `reaction_type`=VALUES\\(`reaction_type`\\),`updated_timestamp`=VALUES\\(`updated_timestamp`\\)").
+		WithArgs(10, 1, model.ReactionLike, 1700000000).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM `post_reactions` WHERE \\(post_id, user_id\\) IN \\(\\(\\?,\\?\\)\\)").
+		WithArgs(10, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Act
+	err := dao.SaveReactions(context.Background(), reactions)
+
+	// Assert
+	assert.NoError(t, err)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,86 @@
+package post_dao
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+// UpdatePost changes content of a post and keeps its previous content as a revision in the same transaction.
+// It returns nil if the post does not exist.
+func (d *PostDAO) UpdatePost(ctx context.Context, post *model.Post) (*model.Post, error) {
+	dbPost := &PostDbModel{}
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// lock the row, so concurrent edits do not lose revisions
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id=? and removed=false", post.ID).First(dbPost).Error
+		if err != nil {
+			return err
+		}
+
+		prevTs := dbPost.CreatedTimestamp
+		if dbPost.EditedTimestamp > 0 {
+			prevTs = dbPost.EditedTimestamp
+		}
+		revision := &PostRevisionDbModel{
+			PostID:           dbPost.ID,
+			Content:          dbPost.Content,
+			CreatedTimestamp: prevTs,
+		}
+		if err := tx.Create(revision).Error; err != nil {
+			return err
+		}
+
+		dbPost.Content = post.Content
+		dbPost.EditedTimestamp = post.EditedTimestamp
+		return tx.Model(&PostDbModel{}).Where("id = ?", dbPost.ID).Updates(map[string]any{
+			"content":          dbPost.Content,
+			"edited_timestamp": dbPost.EditedTimestamp,
+		}).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	updatedPost := toPostModel(dbPost)
+	if err := d.loadPostMedia(ctx, []*model.Post{updatedPost}); err != nil {
+		return nil, err
+	}
+	return updatedPost, nil
+}
+
+// GetPostRevisions returns previous versions of a post sorted by id desc (newest first).
+// paging.Cursor holds the last revision id of the previous page, nil means the first page.
+func (d *PostDAO) GetPostRevisions(ctx context.Context, postId int64, paging *model.Paging) ([]*model.PostRevision, error) {
+	query := d.db.WithContext(ctx).Model(&PostRevisionDbModel{}).Where("post_id = ?", postId)
+	if paging.Cursor != nil {
+		query = query.Where("id < ?", paging.Cursor.ID)
+	}
+
+	dbRevisions := make([]*PostRevisionDbModel, 0, paging.Limit)
+	result := query.
+		Order("id DESC").
+		Limit(int(paging.Limit)).
+		Find(&dbRevisions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	revisions := make([]*model.PostRevision, len(dbRevisions))
+	for i, r := range dbRevisions {
+		revisions[i] = &model.PostRevision{
+			ID:               r.ID,
+			PostID:           r.PostID,
+			Content:          r.Content,
+			CreatedTimestamp: r.CreatedTimestamp,
+		}
+	}
+	return revisions, nil
+}
@@ -0,0 +1,44 @@
+package post_dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func TestPostDAO_UpdatePost(t *testing.T) {
+	// Assume
+	dao, mock := newMockPostDAO(t)
+
+	post := &model.Post{ID: 10, UserID: 1, Content: "hello again", EditedTimestamp: 1700000100}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT \\* FROM `posts` WHERE .* FOR UPDATE").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content", "created_timestamp", "removed", "comment_count", "edited_timestamp"}).
+			AddRow(10, 1, "hello world", 1700000000, false, 0, 0))
+	mock.ExpectExec("INSERT INTO `post_revisions`").
+		WithArgs(10, "hello world", 1700000000).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.ExpectExec("UPDATE `posts` SET").
+		WithArgs("hello again", 1700000100, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT \\* FROM `media` WHERE post_id IN").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "post_id"}))
+
+	// Act
+	res, err := dao.UpdatePost(context.Background(), post)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "hello again", res.Content)
+	assert.Equal(t, int64(1700000100), res.EditedTimestamp)
+	assert.Equal(t, int64(1700000000), res.CreatedTimestamp)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
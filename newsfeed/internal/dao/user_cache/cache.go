@@ -2,9 +2,12 @@ package user_cache
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -25,6 +28,34 @@ const (
 	UserKeyFormat       = "grpc:%d"            // grpc:<userid>
 	FollowingsKeyFormat = "grpc:%d:followings" // grpc:<userid>:followings
 	FollowersKeyFormat  = "grpc:%d:followers"  // grpc:<userid>:follower
+
+	FollowingsCompleteKeyFormat = "grpc:%d:followings:complete" // set when followings sorted set holds all followings
+	FollowersCompleteKeyFormat  = "grpc:%d:followers:complete"  // set when followers sorted set holds all followers
+	FollowingsLockKeyFormat     = "grpc:%d:followings:lock"     // held while followings sorted set is rebuilt from db
+	FollowersLockKeyFormat      = "grpc:%d:followers:lock"      // held while followers sorted set is rebuilt from db
+	AudienceCompleteKeyFormat   = "grpc:%d:audience:complete"   // set when audience sets of a user hold all members
+	AudienceLockKeyFormat       = "grpc:%d:audience:lock"       // held while audience sets of a user are rebuilt from db
+
+	CloseFriendsKeyFormat = "grpc:%d:close_friends" // grpc:<userid>:close_friends, set of member ids
+	BlockedKeyFormat      = "grpc:%d:blocked"       // grpc:<userid>:blocked, set of ids of users blocked by the user
+	BlockedByKeyFormat    = "grpc:%d:blocked_by"    // grpc:<userid>:blocked_by, set of ids of users blocking the user
+	MutedKeyFormat        = "grpc:%d:muted"         // grpc:<userid>:muted, set of ids of users muted by the user
+	MutedByKeyFormat      = "grpc:%d:muted_by"      // grpc:<userid>:muted_by, set of ids of users muting the user
+	SuggestionsKeyFormat  = "grpc:%d:suggestions"   // grpc:<userid>:suggestions, json of precomputed suggestions
+	CountsKeyFormat       = "grpc:%d:counts"        // grpc:<userid>:counts, hash of follower, following and post counts
+
+	HighFollowerUsersKey = "grpc:high_follower_users" // set of user ids whose posts are pulled instead of pushed
+)
+
+const rebuildLockTTL = 30 * time.Second
+
+var errLockLost = errors.New("lock is lost")
+
+// fields of counts hash
+const (
+	countsFieldFollowers  = "followers"
+	countsFieldFollowings = "followings"
+	countsFieldPosts      = "posts"
 )
 
 type (
@@ -94,93 +125,753 @@ func (dao *CacheDao) GetCachedUserByID(ctx context.Context, userId int64) (*mode
 	return user, nil
 }
 
-// AddCachedFollow add following to a sorted set: value=follower_id, score=timestamp
+// AddCachedFollow adds a follow to both sorted sets, followings of follower and followers of following:
+// value=peer id, score=follow timestamp
 func (dao *CacheDao) AddCachedFollow(ctx context.Context, follow *model.Follow) error {
 	logger.Debug("", logger.F("follow", follow))
 	if follow == nil || follow.Follower == nil || follow.Following == nil {
 		return errors.New("invalid follow data to cache")
 	}
 
-	// add to following key
-	followingKey := getUserFollowingsKey(follow.Follower.ID)
 	score := float64(follow.FollowTs)
 
-	err := dao.redisCli.ZAdd(ctx, followingKey, redis.Z{
+	// both sets are updated together, so they do not disagree about the follow,
+	// counts of both users are dropped to be reloaded from db
+	pipe := dao.redisCli.TxPipeline()
+	pipe.ZAdd(ctx, getUserFollowingsKey(follow.Follower.ID), redis.Z{
 		Score:  score,
 		Member: follow.Following.ID,
-	}).Err()
+	})
+	pipe.ZAdd(ctx, getUserFollowersKey(follow.Following.ID), redis.Z{
+		Score:  score,
+		Member: follow.Follower.ID,
+	})
+	pipe.Del(ctx, getCountsKey(follow.Follower.ID), getCountsKey(follow.Following.ID))
+	pipe.Del(ctx, getFollowingsLockKey(follow.Follower.ID), getFollowersLockKey(follow.Following.ID)) // abort running rebuilds
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// AddCachedFollows adds a batch of follows to follow sorted sets in one transaction, same as AddCachedFollow
+func (dao *CacheDao) AddCachedFollows(ctx context.Context, follows []*model.Follow) error {
+	if len(follows) == 0 {
+		return nil
+	}
+
+	pipe := dao.redisCli.TxPipeline()
+	for _, follow := range follows {
+		if follow == nil || follow.Follower == nil || follow.Following == nil {
+			return errors.New("invalid follow data to cache")
+		}
+
+		score := float64(follow.FollowTs)
+		pipe.ZAdd(ctx, getUserFollowingsKey(follow.Follower.ID), redis.Z{
+			Score:  score,
+			Member: follow.Following.ID,
+		})
+		pipe.ZAdd(ctx, getUserFollowersKey(follow.Following.ID), redis.Z{
+			Score:  score,
+			Member: follow.Follower.ID,
+		})
+		pipe.Del(ctx, getCountsKey(follow.Follower.ID), getCountsKey(follow.Following.ID))
+		pipe.Del(ctx, getFollowingsLockKey(follow.Follower.ID), getFollowersLockKey(follow.Following.ID)) // abort running rebuilds
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RemoveCachedFollow removes a follow from both sorted sets, followings of follower and followers of following
+func (dao *CacheDao) RemoveCachedFollow(ctx context.Context, userId, peerId int64) error {
+	pipe := dao.redisCli.TxPipeline()
+	pipe.ZRem(ctx, getUserFollowingsKey(userId), peerId)
+	pipe.ZRem(ctx, getUserFollowersKey(peerId), userId)
+	pipe.Del(ctx, getCountsKey(userId), getCountsKey(peerId))
+	pipe.Del(ctx, getFollowingsLockKey(userId), getFollowersLockKey(peerId)) // abort running rebuilds
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetFollowings returns followings of a user sorted by (follow timestamp, following id) desc, same as db.
+// paging.Cursor holds the follow timestamp and id of the last following of the previous page, nil means the first page.
+// Followings whose data is not cached only have their id set, and their ids are returned as missingIds to be backfilled.
+// The result is authoritative only if IsFollowingsCached is true.
+func (dao *CacheDao) GetFollowings(ctx context.Context, userId int64, paging *model.Paging) (followings []*model.Follow, missingIds []int64, err error) {
+	follows, err := dao.getFollowPage(ctx, getUserFollowingsKey(userId), paging)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	// TODO: add to followers key also
-	return nil
+	users, missingIds, err := dao.getCachedUsers(ctx, follows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	followings = make([]*model.Follow, len(follows))
+	for i := range follows {
+		followings[i] = &model.Follow{
+			Following: users[i],
+			FollowTs:  follows[i].Timestamp,
+		}
+	}
+	return followings, missingIds, nil
+}
+
+// GetFollowers returns followers of a user sorted by (follow timestamp, follower id) desc, same as db.
+// paging.Cursor holds the follow timestamp and id of the last follower of the previous page, nil means the first page.
+// Followers whose data is not cached only have their id set, and their ids are returned as missingIds to be backfilled.
+// The result is authoritative only if IsFollowersCached is true.
+func (dao *CacheDao) GetFollowers(ctx context.Context, userId int64, paging *model.Paging) (followers []*model.Follow, missingIds []int64, err error) {
+	follows, err := dao.getFollowPage(ctx, getUserFollowersKey(userId), paging)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users, missingIds, err := dao.getCachedUsers(ctx, follows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	followers = make([]*model.Follow, len(follows))
+	for i := range follows {
+		followers[i] = &model.Follow{
+			Follower: users[i],
+			FollowTs: follows[i].Timestamp,
+		}
+	}
+	return followers, missingIds, nil
+}
+
+// getCachedUsers loads data of users of follows in one MGET, users not cached only have their id set
+func (dao *CacheDao) getCachedUsers(ctx context.Context, follows []*CachedFollow) ([]*model.User, []int64, error) {
+	if len(follows) == 0 {
+		return []*model.User{}, nil, nil
+	}
+
+	userKeys := make([]string, len(follows))
+	for i := range follows {
+		userKeys[i] = getUserKey(follows[i].ID)
+	}
+	datas, err := dao.redisCli.MGet(ctx, userKeys...).Result()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users := make([]*model.User, len(follows))
+	missingIds := make([]int64, 0)
+	for i := range follows {
+		userData, ok := datas[i].(string)
+		if !ok {
+			users[i] = &model.User{ID: follows[i].ID}
+			missingIds = append(missingIds, follows[i].ID)
+			continue
+		}
+		users[i] = &model.User{}
+		if err := json.Unmarshal([]byte(userData), users[i]); err != nil {
+			return nil, nil, err
+		}
+	}
+	return users, missingIds, nil
+}
+
+// IsFollowingsCached checks if followings sorted set of a user holds all followings,
+// a set built only by AddCachedFollow may miss older follows
+func (dao *CacheDao) IsFollowingsCached(ctx context.Context, userId int64) (bool, error) {
+	n, err := dao.redisCli.Exists(ctx, getFollowingsCompleteKey(userId)).Result()
+	return n > 0, err
+}
+
+// IsFollowersCached checks if followers sorted set of a user holds all followers
+func (dao *CacheDao) IsFollowersCached(ctx context.Context, userId int64) (bool, error) {
+	n, err := dao.redisCli.Exists(ctx, getFollowersCompleteKey(userId)).Result()
+	return n > 0, err
+}
+
+// LockFollowingsRebuild takes the lock to rebuild followings sorted set of a user from db.
+// It returns the token to pass to SetCachedFollowings, or empty if another rebuild holds the lock.
+func (dao *CacheDao) LockFollowingsRebuild(ctx context.Context, userId int64) (string, error) {
+	return dao.lockRebuild(ctx, getFollowingsLockKey(userId))
+}
+
+// LockFollowersRebuild takes the lock to rebuild followers sorted set of a user from db, same as LockFollowingsRebuild
+func (dao *CacheDao) LockFollowersRebuild(ctx context.Context, userId int64) (string, error) {
+	return dao.lockRebuild(ctx, getFollowersLockKey(userId))
+}
+
+// SetCachedFollowings replaces followings sorted set of a user with followTsById loaded from db and marks it complete.
+// It returns false without writing if the lock of token is lost, the set may then miss follows made during the rebuild.
+func (dao *CacheDao) SetCachedFollowings(ctx context.Context, userId int64, token string, followTsById map[int64]int64) (bool, error) {
+	return dao.setCachedFollows(ctx, getUserFollowingsKey(userId), getFollowingsCompleteKey(userId), getFollowingsLockKey(userId), token, followTsById)
+}
+
+// SetCachedFollowers replaces followers sorted set of a user with followTsById loaded from db, same as SetCachedFollowings
+func (dao *CacheDao) SetCachedFollowers(ctx context.Context, userId int64, token string, followTsById map[int64]int64) (bool, error) {
+	return dao.setCachedFollows(ctx, getUserFollowersKey(userId), getFollowersCompleteKey(userId), getFollowersLockKey(userId), token, followTsById)
+}
+
+// lockRebuild sets the lock key if it does not exist, the lock expires in case the rebuild never finishes
+func (dao *CacheDao) lockRebuild(ctx context.Context, lockKey string) (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+
+	ok, err := dao.redisCli.SetNX(ctx, lockKey, token, rebuildLockTTL).Result()
+	if err != nil || !ok {
+		return "", err
+	}
+	return token, nil
 }
 
-func (dao *CacheDao) GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error) {
-	key := getUserFollowingsKey(userId)
-	lastTs, ok := paging.LastValue.(int64)
-	if !ok {
-		return nil, errors.New("invalid last value")
+// setCachedFollows writes a rebuilt follow sorted set if the lock still holds token.
+// AddCachedFollow and RemoveCachedFollow delete the lock, so a rebuild racing them is dropped instead of losing their change.
+func (dao *CacheDao) setCachedFollows(ctx context.Context, key, completeKey, lockKey, token string, followTsById map[int64]int64) (bool, error) {
+	members := make([]redis.Z, 0, len(followTsById))
+	for id, ts := range followTsById {
+		members = append(members, redis.Z{Score: float64(ts), Member: id})
 	}
 
-	// ZRevRangeByScore sort by score desc, means timestamp desc
-	zs, err := dao.redisCli.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
-		Max:    strconv.Itoa(int(lastTs)),
-		Offset: 0,
-		Count:  paging.Limit,
+	err := dao.redisCli.Watch(ctx, func(tx *redis.Tx) error {
+		current, err := tx.Get(ctx, lockKey).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+		if current != token {
+			return errLockLost
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(ctx, key)
+			if len(members) > 0 {
+				pipe.ZAdd(ctx, key, members...)
+			}
+			pipe.Set(ctx, completeKey, 1, 0)
+			pipe.Del(ctx, lockKey)
+			return nil
+		})
+		return err
+	}, lockKey)
+	if errors.Is(err, errLockLost) || errors.Is(err, redis.TxFailedErr) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// getFollowPage returns a page of a follow sorted set sorted by (timestamp, user id) desc.
+// Redis sorts members of the same score as strings instead of numbers, so follows sharing the timestamp
+// of the cursor or of the end of the page are loaded together and sorted here.
+func (dao *CacheDao) getFollowPage(ctx context.Context, key string, paging *model.Paging) ([]*CachedFollow, error) {
+	follows := make([]*CachedFollow, 0, paging.Limit)
+	max := "+inf"
+	if paging.Cursor != nil {
+		ties, err := dao.getFollowsByScore(ctx, key, paging.Cursor.SortKey)
+		if err != nil {
+			return nil, err
+		}
+		for _, follow := range ties {
+			if follow.ID < paging.Cursor.ID {
+				follows = append(follows, follow)
+			}
+		}
+		max = "(" + strconv.FormatInt(paging.Cursor.SortKey, 10) // exclusive, ties are loaded above
+	}
+
+	if int64(len(follows)) < paging.Limit {
+		// ZRevRangeByScore sort by score desc, means timestamp desc
+		zs, err := dao.redisCli.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+			Min:   "-inf",
+			Max:   max,
+			Count: paging.Limit - int64(len(follows)),
+		}).Result()
+		if err != nil {
+			return nil, err
+		}
+		next, err := toCachedFollows(zs)
+		if err != nil {
+			return nil, err
+		}
+
+		// the page may end in the middle of follows of the same timestamp, load all of them
+		if len(next) > 0 && int64(len(follows)+len(next)) == paging.Limit {
+			lastTs := next[len(next)-1].Timestamp
+			for len(next) > 0 && next[len(next)-1].Timestamp == lastTs {
+				next = next[:len(next)-1]
+			}
+			ties, err := dao.getFollowsByScore(ctx, key, lastTs)
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, ties...)
+		}
+		follows = append(follows, next...)
+	}
+
+	sort.Slice(follows, func(i, j int) bool {
+		if follows[i].Timestamp != follows[j].Timestamp {
+			return follows[i].Timestamp > follows[j].Timestamp
+		}
+		return follows[i].ID > follows[j].ID
+	})
+	if int64(len(follows)) > paging.Limit {
+		follows = follows[:paging.Limit]
+	}
+	return follows, nil
+}
+
+// getFollowsByScore returns all follows of a sorted set at a timestamp
+func (dao *CacheDao) getFollowsByScore(ctx context.Context, key string, ts int64) ([]*CachedFollow, error) {
+	score := strconv.FormatInt(ts, 10)
+	zs, err := dao.redisCli.ZRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+		Min: score,
+		Max: score,
 	}).Result()
 	if err != nil {
 		return nil, err
 	}
+	return toCachedFollows(zs)
+}
 
-	// get ids and timestamps
-	follows := make([]*CachedFollow, 0)
+func toCachedFollows(zs []redis.Z) ([]*CachedFollow, error) {
+	follows := make([]*CachedFollow, 0, len(zs))
 	for _, entry := range zs {
 		member := entry.Member.(string)
 		id, err := strconv.ParseInt(member, 10, 64)
 		if err != nil {
-			return nil, errors.New("failed to parse following id from cached")
+			return nil, errors.New("failed to parse follow id from cached")
 		}
-		ts := int64(entry.Score)
 		follows = append(follows, &CachedFollow{
 			ID:        id,
-			Timestamp: ts,
+			Timestamp: int64(entry.Score),
 		})
 	}
+	return follows, nil
+}
 
-	// get grpc data by ids
-	userKeys := make([]string, len(follows))
-	for i := range follows {
-		userKeys[i] = fmt.Sprintf(UserKeyFormat, follows[i].ID)
-	}
-	datas, err := dao.redisCli.MGet(ctx, userKeys...).Result()
+// GetFollowerIDs returns follower ids of a user by rank offset, used to iterate through all followers by batch
+func (dao *CacheDao) GetFollowerIDs(ctx context.Context, userId int64, offset, limit int64) ([]int64, error) {
+	key := getUserFollowersKey(userId)
+
+	members, err := dao.redisCli.ZRange(ctx, key, offset, offset+limit-1).Result()
 	if err != nil {
 		return nil, err
 	}
 
-	followings := make([]*model.Follow, 0, len(follows))
-	for i := range follows {
-		userData, ok := datas[i].(string)
-		if !ok {
-			return nil, errors.New("failed to parse grpc data from cached")
+	ids := make([]int64, 0, len(members))
+	for _, member := range members {
+		id, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return nil, errors.New("failed to parse follower id from cached")
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// GetFollowTimestamps returns follow timestamps of given followings of a user, not followed ids are not returned
+func (dao *CacheDao) GetFollowTimestamps(ctx context.Context, userId int64, followingIds []int64) (map[int64]int64, error) {
+	res := make(map[int64]int64)
+	if len(followingIds) == 0 {
+		return res, nil
+	}
+
+	members := make([]string, len(followingIds))
+	for i := range followingIds {
+		members[i] = strconv.FormatInt(followingIds[i], 10)
+	}
+
+	// score of following sorted set is follow timestamp, score is nil if not followed
+	scores, err := dao.redisCli.ZMScore(ctx, getUserFollowingsKey(userId), members...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i := range scores {
+		if scores[i] == 0 {
+			continue
 		}
-		user := &model.User{}
-		err = json.Unmarshal([]byte(userData), user)
+		res[followingIds[i]] = int64(scores[i])
+	}
+	return res, nil
+}
+
+// GetRelationships returns relationships between viewer and given users in the same order, looked up in one pipeline.
+// followsCached is false if followings or followers of viewer are not completely cached, then follows of relationships are unknown.
+func (dao *CacheDao) GetRelationships(ctx context.Context, viewerId int64, userIds []int64) (relationships []*model.Relationship, followsCached bool, err error) {
+	relationships = make([]*model.Relationship, len(userIds))
+	for i := range userIds {
+		relationships[i] = &model.Relationship{UserID: userIds[i]}
+	}
+	if len(userIds) == 0 {
+		return relationships, true, nil
+	}
+
+	members := make([]string, len(userIds))
+	setMembers := make([]any, len(userIds))
+	for i := range userIds {
+		members[i] = strconv.FormatInt(userIds[i], 10)
+		setMembers[i] = userIds[i]
+	}
+
+	followingsKey, followersKey := getUserFollowingsKey(viewerId), getUserFollowersKey(viewerId)
+	pipe := dao.redisCli.Pipeline()
+	existsCmd := pipe.Exists(ctx, getFollowingsCompleteKey(viewerId), getFollowersCompleteKey(viewerId))
+	followingScoresCmd := pipe.ZMScore(ctx, followingsKey, members...)
+	followerScoresCmd := pipe.ZMScore(ctx, followersKey, members...)
+	blockingCmd := pipe.SMIsMember(ctx, getBlockedKey(viewerId), setMembers...)
+	blockedByCmd := pipe.SMIsMember(ctx, getBlockedByKey(viewerId), setMembers...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, false, err
+	}
+
+	// score of follow sorted sets is follow timestamp, score is 0 if not followed
+	followingScores, followerScores := followingScoresCmd.Val(), followerScoresCmd.Val()
+	blocking, blockedBy := blockingCmd.Val(), blockedByCmd.Val()
+	for i, relationship := range relationships {
+		relationship.Following = followingScores[i] != 0
+		relationship.FollowedBy = followerScores[i] != 0
+		relationship.Blocking = blocking[i]
+		relationship.BlockedBy = blockedBy[i]
+	}
+	return relationships, existsCmd.Val() == 2, nil
+}
+
+func (dao *CacheDao) CountFollowers(ctx context.Context, userId int64) (int64, error) {
+	return dao.redisCli.ZCard(ctx, getUserFollowersKey(userId)).Result()
+}
+
+func (dao *CacheDao) AddHighFollowerUser(ctx context.Context, userId int64) error {
+	return dao.redisCli.SAdd(ctx, HighFollowerUsersKey, userId).Err()
+}
+
+func (dao *CacheDao) IsHighFollowerUser(ctx context.Context, userId int64) (bool, error) {
+	return dao.redisCli.SIsMember(ctx, HighFollowerUsersKey, userId).Result()
+}
+
+// GetHighFollowerIDs returns ids of all high-follower users
+func (dao *CacheDao) GetHighFollowerIDs(ctx context.Context) ([]int64, error) {
+	members, err := dao.redisCli.SMembers(ctx, HighFollowerUsersKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(members))
+	for i, member := range members {
+		ids[i], err = strconv.ParseInt(member, 10, 64)
 		if err != nil {
+			return nil, errors.New("failed to parse high follower id from cached")
+		}
+	}
+	return ids, nil
+}
+
+// GetFollowedHighFollowerIDs returns ids of high-follower users that are followed by the user.
+// Followings set of the user must be complete, see IsFollowingsCached.
+func (dao *CacheDao) GetFollowedHighFollowerIDs(ctx context.Context, userId int64) ([]int64, error) {
+	members, err := dao.redisCli.SMembers(ctx, HighFollowerUsersKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	// high-follower users are few, so check them against followings of the user in 1 round trip
+	followingsKey := getUserFollowingsKey(userId)
+	pipe := dao.redisCli.Pipeline()
+	cmds := make([]*redis.FloatCmd, len(members))
+	for i, member := range members {
+		cmds[i] = pipe.ZScore(ctx, followingsKey, member)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	ids := make([]int64, 0)
+	for i, cmd := range cmds {
+		if err := cmd.Err(); err != nil {
+			if errors.Is(err, redis.Nil) { // not followed
+				continue
+			}
 			return nil, err
 		}
+		id, err := strconv.ParseInt(members[i], 10, 64)
+		if err != nil {
+			return nil, errors.New("failed to parse high follower id from cached")
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
 
-		followings = append(followings, &model.Follow{
-			ID:        0, // we dont store in cache now ...
-			Follower:  nil,
-			Following: user,
-			FollowTs:  follows[i].Timestamp,
+func (dao *CacheDao) AddCloseFriend(ctx context.Context, ownerId, memberId int64) error {
+	pipe := dao.redisCli.TxPipeline()
+	pipe.SAdd(ctx, getCloseFriendsKey(ownerId), memberId)
+	pipe.Del(ctx, getAudienceLockKey(ownerId)) // abort running rebuild
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (dao *CacheDao) RemoveCloseFriend(ctx context.Context, ownerId, memberId int64) error {
+	pipe := dao.redisCli.TxPipeline()
+	pipe.SRem(ctx, getCloseFriendsKey(ownerId), memberId)
+	pipe.Del(ctx, getAudienceLockKey(ownerId)) // abort running rebuild
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// IsAudienceCached checks if close friends, blocked, blocked by, muted and muted by sets of a user hold all members,
+// sets built only by AddBlock and the like may miss older members
+func (dao *CacheDao) IsAudienceCached(ctx context.Context, userId int64) (bool, error) {
+	n, err := dao.redisCli.Exists(ctx, getAudienceCompleteKey(userId)).Result()
+	return n > 0, err
+}
+
+// LockAudienceRebuild takes the lock to rebuild audience sets of a user from db.
+// It returns the token to pass to SetCachedAudience, or empty if another rebuild holds the lock.
+func (dao *CacheDao) LockAudienceRebuild(ctx context.Context, userId int64) (string, error) {
+	return dao.lockRebuild(ctx, getAudienceLockKey(userId))
+}
+
+// SetCachedAudience replaces audience sets of a user with members loaded from db and marks them complete.
+// members are rows of lists owned by user and rows of blocked and muted lists of others having user as member.
+// It returns false without writing if the lock of token is lost, same as SetCachedFollowings.
+func (dao *CacheDao) SetCachedAudience(ctx context.Context, userId int64, token string, members []*model.AudienceMember) (bool, error) {
+	idsByKey := map[string][]any{
+		getCloseFriendsKey(userId): nil,
+		getBlockedKey(userId):      nil,
+		getBlockedByKey(userId):    nil,
+		getMutedKey(userId):        nil,
+		getMutedByKey(userId):      nil,
+	}
+	for _, member := range members {
+		var key string
+		var id int64
+		switch {
+		case member.OwnerID == userId && member.List == model.AudienceListCloseFriends:
+			key, id = getCloseFriendsKey(userId), member.MemberID
+		case member.OwnerID == userId && member.List == model.AudienceListBlocked:
+			key, id = getBlockedKey(userId), member.MemberID
+		case member.OwnerID == userId && member.List == model.AudienceListMuted:
+			key, id = getMutedKey(userId), member.MemberID
+		case member.MemberID == userId && member.List == model.AudienceListBlocked:
+			key, id = getBlockedByKey(userId), member.OwnerID
+		case member.MemberID == userId && member.List == model.AudienceListMuted:
+			key, id = getMutedByKey(userId), member.OwnerID
+		default:
+			continue
+		}
+		idsByKey[key] = append(idsByKey[key], id)
+	}
+
+	lockKey := getAudienceLockKey(userId)
+	err := dao.redisCli.Watch(ctx, func(tx *redis.Tx) error {
+		current, err := tx.Get(ctx, lockKey).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+		if current != token {
+			return errLockLost
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			for key, ids := range idsByKey {
+				pipe.Del(ctx, key)
+				if len(ids) > 0 {
+					pipe.SAdd(ctx, key, ids...)
+				}
+			}
+			pipe.Set(ctx, getAudienceCompleteKey(userId), 1, 0)
+			pipe.Del(ctx, lockKey)
+			return nil
 		})
+		return err
+	}, lockKey)
+	if errors.Is(err, errLockLost) || errors.Is(err, redis.TxFailedErr) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// FilterCloseFriends returns the given users that are in close friends list of owner, keeping their order
+func (dao *CacheDao) FilterCloseFriends(ctx context.Context, ownerId int64, userIds []int64) ([]int64, error) {
+	return dao.filterSetMembers(ctx, getCloseFriendsKey(ownerId), userIds)
+}
+
+// AddBlock saves the block in both directions, so it can be checked from either user
+func (dao *CacheDao) AddBlock(ctx context.Context, userId, peerId int64) error {
+	pipe := dao.redisCli.TxPipeline()
+	pipe.SAdd(ctx, getBlockedKey(userId), peerId)
+	pipe.SAdd(ctx, getBlockedByKey(peerId), userId)
+	pipe.Del(ctx, getAudienceLockKey(userId), getAudienceLockKey(peerId)) // abort running rebuilds
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (dao *CacheDao) RemoveBlock(ctx context.Context, userId, peerId int64) error {
+	pipe := dao.redisCli.TxPipeline()
+	pipe.SRem(ctx, getBlockedKey(userId), peerId)
+	pipe.SRem(ctx, getBlockedByKey(peerId), userId)
+	pipe.Del(ctx, getAudienceLockKey(userId), getAudienceLockKey(peerId)) // abort running rebuilds
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// FilterBlocked returns the given users that are blocked by user or blocking user, keeping their order
+func (dao *CacheDao) FilterBlocked(ctx context.Context, userId int64, userIds []int64) ([]int64, error) {
+	blockedIds, err := dao.filterSetMembers(ctx, getBlockedKey(userId), userIds)
+	if err != nil {
+		return nil, err
+	}
+	blockingIds, err := dao.filterSetMembers(ctx, getBlockedByKey(userId), userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	isBlocked := make(map[int64]bool)
+	for _, id := range blockedIds {
+		isBlocked[id] = true
+	}
+	for _, id := range blockingIds {
+		isBlocked[id] = true
 	}
 
-	return followings, nil
+	ids := make([]int64, 0)
+	for _, id := range userIds {
+		if isBlocked[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (dao *CacheDao) AddMute(ctx context.Context, userId, peerId int64) error {
+	pipe := dao.redisCli.TxPipeline()
+	pipe.SAdd(ctx, getMutedKey(userId), peerId)
+	pipe.SAdd(ctx, getMutedByKey(peerId), userId)
+	pipe.Del(ctx, getAudienceLockKey(userId), getAudienceLockKey(peerId)) // abort running rebuilds
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (dao *CacheDao) RemoveMute(ctx context.Context, userId, peerId int64) error {
+	pipe := dao.redisCli.TxPipeline()
+	pipe.SRem(ctx, getMutedKey(userId), peerId)
+	pipe.SRem(ctx, getMutedByKey(peerId), userId)
+	pipe.Del(ctx, getAudienceLockKey(userId), getAudienceLockKey(peerId)) // abort running rebuilds
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// FilterMuted returns the given users that are muted by user, keeping their order
+func (dao *CacheDao) FilterMuted(ctx context.Context, userId int64, userIds []int64) ([]int64, error) {
+	return dao.filterSetMembers(ctx, getMutedKey(userId), userIds)
+}
 
+// FilterMutedBy returns the given users that are muting user, keeping their order
+func (dao *CacheDao) FilterMutedBy(ctx context.Context, userId int64, userIds []int64) ([]int64, error) {
+	return dao.filterSetMembers(ctx, getMutedByKey(userId), userIds)
+}
+
+// SetSuggestions replaces precomputed suggestions of a user, they expire after ttl if they are not refreshed
+func (dao *CacheDao) SetSuggestions(ctx context.Context, userId int64, suggestions []*model.Suggestion, ttl time.Duration) error {
+	data, err := json.Marshal(suggestions)
+	if err != nil {
+		return err
+	}
+	return dao.redisCli.Set(ctx, getSuggestionsKey(userId), string(data), ttl).Err()
+}
+
+// GetSuggestions returns precomputed suggestions of a user sorted by score desc, nil if they are not computed
+func (dao *CacheDao) GetSuggestions(ctx context.Context, userId int64) ([]*model.Suggestion, error) {
+	data, err := dao.redisCli.Get(ctx, getSuggestionsKey(userId)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]*model.Suggestion, 0)
+	if err := json.Unmarshal([]byte(data), &suggestions); err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
+
+// SetCachedCounts caches counts of a user, they are kept until a follow or post of the user changes them
+func (dao *CacheDao) SetCachedCounts(ctx context.Context, userId int64, counts *model.UserCounts) error {
+	if counts == nil {
+		return nil
+	}
+	return dao.redisCli.HSet(ctx, getCountsKey(userId),
+		countsFieldFollowers, counts.Followers,
+		countsFieldFollowings, counts.Followings,
+		countsFieldPosts, counts.Posts,
+	).Err()
+}
+
+// GetCachedCounts returns cached counts of a user, nil if they are not cached
+func (dao *CacheDao) GetCachedCounts(ctx context.Context, userId int64) (*model.UserCounts, error) {
+	values, err := dao.redisCli.HGetAll(ctx, getCountsKey(userId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	counts := &model.UserCounts{}
+	for field, dst := range map[string]*int64{
+		countsFieldFollowers:  &counts.Followers,
+		countsFieldFollowings: &counts.Followings,
+		countsFieldPosts:      &counts.Posts,
+	} {
+		if *dst, err = strconv.ParseInt(values[field], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid cached %s count: %s", field, err)
+		}
+	}
+	return counts, nil
+}
+
+// DeleteCachedCounts drops cached counts of users, so they are reloaded from db on next read
+func (dao *CacheDao) DeleteCachedCounts(ctx context.Context, userIds ...int64) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+	keys := make([]string, len(userIds))
+	for i := range userIds {
+		keys[i] = getCountsKey(userIds[i])
+	}
+	return dao.redisCli.Del(ctx, keys...).Err()
+}
+
+// filterSetMembers returns the given users that are members of the set at key, keeping their order
+func (dao *CacheDao) filterSetMembers(ctx context.Context, key string, userIds []int64) ([]int64, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+
+	members := make([]any, len(userIds))
+	for i := range userIds {
+		members[i] = userIds[i]
+	}
+	isMembers, err := dao.redisCli.SMIsMember(ctx, key, members...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0)
+	for i := range isMembers {
+		if isMembers[i] {
+			ids = append(ids, userIds[i])
+		}
+	}
+	return ids, nil
 }
 
 func getUserKey(userId int64) string {
@@ -194,3 +885,55 @@ func getUserFollowingsKey(userId int64) string {
 func getUserFollowersKey(userId int64) string {
 	return fmt.Sprintf(FollowersKeyFormat, userId)
 }
+
+func getFollowingsCompleteKey(userId int64) string {
+	return fmt.Sprintf(FollowingsCompleteKeyFormat, userId)
+}
+
+func getFollowersCompleteKey(userId int64) string {
+	return fmt.Sprintf(FollowersCompleteKeyFormat, userId)
+}
+
+func getFollowingsLockKey(userId int64) string {
+	return fmt.Sprintf(FollowingsLockKeyFormat, userId)
+}
+
+func getFollowersLockKey(userId int64) string {
+	return fmt.Sprintf(FollowersLockKeyFormat, userId)
+}
+
+func getAudienceCompleteKey(userId int64) string {
+	return fmt.Sprintf(AudienceCompleteKeyFormat, userId)
+}
+
+func getAudienceLockKey(userId int64) string {
+	return fmt.Sprintf(AudienceLockKeyFormat, userId)
+}
+
+func getCloseFriendsKey(userId int64) string {
+	return fmt.Sprintf(CloseFriendsKeyFormat, userId)
+}
+
+func getBlockedKey(userId int64) string {
+	return fmt.Sprintf(BlockedKeyFormat, userId)
+}
+
+func getBlockedByKey(userId int64) string {
+	return fmt.Sprintf(BlockedByKeyFormat, userId)
+}
+
+func getMutedKey(userId int64) string {
+	return fmt.Sprintf(MutedKeyFormat, userId)
+}
+
+func getMutedByKey(userId int64) string {
+	return fmt.Sprintf(MutedByKeyFormat, userId)
+}
+
+func getSuggestionsKey(userId int64) string {
+	return fmt.Sprintf(SuggestionsKeyFormat, userId)
+}
+
+func getCountsKey(userId int64) string {
+	return fmt.Sprintf(CountsKeyFormat, userId)
+}
@@ -0,0 +1,60 @@
+package user_dao
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+// updateFollowCounts adds delta to following count of follower and follower count of following, db should be a transaction
+func updateFollowCounts(db *gorm.DB, followerId, followingId int64, delta int64) error {
+	err := db.Model(&UserDbModel{}).Where("id = ?", followerId).
+		Update("following_count", gorm.Expr("following_count + ?", delta)).Error
+	if err != nil {
+		return err
+	}
+	return db.Model(&UserDbModel{}).Where("id = ?", followingId).
+		Update("follower_count", gorm.Expr("follower_count + ?", delta)).Error
+}
+
+// GetCounts returns counts of a user, nil if user does not exist
+func (d *UserDAI) GetCounts(ctx context.Context, userId int64) (*model.UserCounts, error) {
+	dbUser := &UserDbModel{}
+	err := d.db.WithContext(ctx).
+		Select("id, follower_count, following_count, post_count").
+		Where("id = ? AND removed = ?", userId, false).
+		First(dbUser).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toUserCountsModel(dbUser), nil
+}
+
+// ReconcileCounts recounts follows and posts of given users and overwrites their counts,
+// it fixes counts drifted by writes bypassing the dao (e.g. manual fixes, partial restores)
+func (d *UserDAI) ReconcileCounts(ctx context.Context, userIds []int64) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+	return d.db.WithContext(ctx).Model(&UserDbModel{}).
+		Where("id IN ?", userIds).
+		Updates(map[string]interface{}{
+			"follower_count":  gorm.Expr("(SELECT COUNT(*) FROM user_users uu WHERE uu.following_id = users.id AND uu.removed = ?)", false),
+			"following_count": gorm.Expr("(SELECT COUNT(*) FROM user_users uu WHERE uu.follower_id = users.id AND uu.removed = ?)", false),
+			"post_count":      gorm.Expr("(SELECT COUNT(*) FROM posts p WHERE p.user_id = users.id AND p.removed = ?)", false),
+		}).Error
+}
+
+func toUserCountsModel(user *UserDbModel) *model.UserCounts {
+	return &model.UserCounts{
+		Followers:  user.FollowerCount,
+		Followings: user.FollowingCount,
+		Posts:      user.PostCount,
+	}
+}
@@ -0,0 +1,61 @@
+package user_dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func (d *UserDAI) CreateEmailVerificationToken(ctx context.Context, token *model.EmailVerificationToken) error {
+	return d.db.WithContext(ctx).Create(&EmailVerificationTokenDbModel{
+		UserID:           token.UserID,
+		TokenHash:        token.TokenHash,
+		CreatedTimestamp: token.CreatedTimestamp,
+		ExpiresTimestamp: token.ExpiresTimestamp,
+	}).Error
+}
+
+// VerifyEmail uses a verification token to mark the email of its user verified in one transaction,
+// and returns the user id. Other verification tokens of the user are used up with it.
+// It returns 0 if the token does not exist, is used or is expired.
+func (d *UserDAI) VerifyEmail(ctx context.Context, tokenHash string) (int64, error) {
+	var userId int64
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		dbToken := &EmailVerificationTokenDbModel{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ? AND used = ? AND expires_timestamp > ?", tokenHash, false, time.Now().Unix()).
+			First(dbToken).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&EmailVerificationTokenDbModel{}).
+			Where("user_id = ? AND used = ?", dbToken.UserID, false).
+			Update("used", true).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&UserDbModel{}).
+			Where("id = ?", dbToken.UserID).
+			Update("email_verified", true).Error
+		if err != nil {
+			return err
+		}
+
+		userId = dbToken.UserID
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return userId, nil
+}
@@ -0,0 +1,104 @@
+package user_dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+// CreateFollowRequest creates a pending follow request, the existing one is returned if user already requested
+func (d *UserDAI) CreateFollowRequest(ctx context.Context, requesterId, targetId int64) (*model.FollowRequest, error) {
+	dbRequest := &FollowRequestDbModel{}
+	err := d.db.WithContext(ctx).
+		Where("requester_id = ? AND target_id = ?", requesterId, targetId).
+		First(dbRequest).Error
+	if err == nil {
+		return toFollowRequestModel(dbRequest), nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	dbRequest = &FollowRequestDbModel{
+		RequesterID:      requesterId,
+		TargetID:         targetId,
+		CreatedTimestamp: time.Now().Unix(),
+	}
+	if err := d.db.WithContext(ctx).Create(dbRequest).Error; err != nil {
+		return nil, err
+	}
+	return toFollowRequestModel(dbRequest), nil
+}
+
+// DeleteFollowRequest removes a pending follow request, it returns false if there is no such request
+func (d *UserDAI) DeleteFollowRequest(ctx context.Context, requesterId, targetId int64) (bool, error) {
+	result := d.db.WithContext(ctx).
+		Where("requester_id = ? AND target_id = ?", requesterId, targetId).
+		Delete(&FollowRequestDbModel{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ApproveFollowRequest removes a pending follow request and creates the follow in the same transaction.
+// It returns nil if there is no such request, or if the users block each other, then the request is removed only.
+func (d *UserDAI) ApproveFollowRequest(ctx context.Context, requesterId, targetId int64) (*model.Follow, error) {
+	var f *model.Follow
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("requester_id = ? AND target_id = ?", requesterId, targetId).
+			Delete(&FollowRequestDbModel{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		var err error
+		f, err = follow(tx, requesterId, targetId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// GetFollowRequests returns pending follow requests to a user sorted by id desc (latest first).
+// paging.Cursor holds the last id of the previous page, nil means the first page.
+func (d *UserDAI) GetFollowRequests(ctx context.Context, targetId int64, paging *model.Paging) ([]*model.FollowRequest, error) {
+	query := d.db.WithContext(ctx).Model(&FollowRequestDbModel{}).
+		Where("target_id = ?", targetId)
+	if paging.Cursor != nil {
+		query = query.Where("id < ?", paging.Cursor.ID)
+	}
+
+	dbRequests := make([]*FollowRequestDbModel, 0, paging.Limit)
+	result := query.
+		Order("id DESC").
+		Limit(int(paging.Limit)).
+		Find(&dbRequests)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	requests := make([]*model.FollowRequest, len(dbRequests))
+	for i := range dbRequests {
+		requests[i] = toFollowRequestModel(dbRequests[i])
+	}
+	return requests, nil
+}
+
+func toFollowRequestModel(request *FollowRequestDbModel) *model.FollowRequest {
+	return &model.FollowRequest{
+		ID:               request.ID,
+		RequesterID:      request.RequesterID,
+		TargetID:         request.TargetID,
+		CreatedTimestamp: request.CreatedTimestamp,
+	}
+}
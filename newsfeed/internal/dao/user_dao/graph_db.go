@@ -0,0 +1,129 @@
+package user_dao
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+type followPair struct {
+	followerId  int64
+	followingId int64
+}
+
+// ImportFollows creates a batch of follows in one transaction and returns the follows created or restored.
+// Active follows are kept as is and unfollowed ones are restored with the imported timestamp,
+// so importing the same batch again changes nothing. Counts are not updated, see ReconcileCounts.
+func (d *UserDAI) ImportFollows(ctx context.Context, follows []*model.Follow) ([]*model.Follow, error) {
+	if len(follows) == 0 {
+		return []*model.Follow{}, nil
+	}
+
+	// the last follow of a pair wins if the batch has duplicates
+	followerIds := make([]int64, 0, len(follows))
+	followingIds := make([]int64, 0, len(follows))
+	tsByPair := make(map[followPair]int64, len(follows))
+	pairs := make([]followPair, 0, len(follows))
+	for _, f := range follows {
+		pair := followPair{followerId: f.Follower.ID, followingId: f.Following.ID}
+		if _, ok := tsByPair[pair]; !ok {
+			pairs = append(pairs, pair)
+			followerIds = append(followerIds, pair.followerId)
+			followingIds = append(followingIds, pair.followingId)
+		}
+		tsByPair[pair] = f.FollowTs
+	}
+
+	imported := make([]*model.Follow, 0, len(pairs))
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// rows of other pairs of these users are loaded too, they are skipped below
+		existing := make([]*UserUserDbModel, 0)
+		err := tx.Where("follower_id IN ? AND following_id IN ?", followerIds, followingIds).
+			Find(&existing).Error
+		if err != nil {
+			return err
+		}
+		existingByPair := make(map[followPair]*UserUserDbModel, len(existing))
+		for _, userUser := range existing {
+			existingByPair[followPair{followerId: userUser.FollowerID, followingId: userUser.FollowingID}] = userUser
+		}
+
+		created := make([]*UserUserDbModel, 0, len(pairs))
+		for _, pair := range pairs {
+			userUser, ok := existingByPair[pair]
+			if !ok {
+				created = append(created, &UserUserDbModel{
+					FollowerID:      pair.followerId,
+					FollowingID:     pair.followingId,
+					FollowTimestamp: tsByPair[pair],
+				})
+				continue
+			}
+			if !userUser.Removed {
+				continue
+			}
+
+			userUser.FollowTimestamp = tsByPair[pair]
+			userUser.Removed = false
+			if err := tx.Save(userUser).Error; err != nil {
+				return err
+			}
+			imported = append(imported, toFollowModel(userUser, &UserDbModel{ID: pair.followerId}, &UserDbModel{ID: pair.followingId}))
+		}
+
+		if len(created) > 0 {
+			if err := tx.Create(&created).Error; err != nil {
+				return err
+			}
+		}
+		for _, userUser := range created {
+			imported = append(imported, toFollowModel(userUser, &UserDbModel{ID: userUser.FollowerID}, &UserDbModel{ID: userUser.FollowingID}))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return imported, nil
+}
+
+// GetExistingUserIDs returns the given user ids that belong to users not removed, used to skip imported follows of unknown users
+func (d *UserDAI) GetExistingUserIDs(ctx context.Context, userIds []int64) ([]int64, error) {
+	ids := make([]int64, 0, len(userIds))
+	if len(userIds) == 0 {
+		return ids, nil
+	}
+
+	err := d.db.WithContext(ctx).Model(&UserDbModel{}).
+		Where("id IN ? AND removed = ?", userIds, false).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ExportFollows returns active follows having id greater than afterId sorted by id, used to export the graph batch by batch.
+// userId limits follows to those having the user as follower or following, 0 means all users.
+func (d *UserDAI) ExportFollows(ctx context.Context, userId, afterId int64, limit int) ([]*model.Follow, error) {
+	query := d.db.WithContext(ctx).
+		Select("id, follower_id, following_id, follow_timestamp").
+		Where("id > ? AND removed = ?", afterId, false)
+	if userId > 0 {
+		query = query.Where("follower_id = ? OR following_id = ?", userId, userId)
+	}
+
+	userUsers := make([]*UserUserDbModel, 0, limit)
+	err := query.Order("id").Limit(limit).Find(&userUsers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	follows := make([]*model.Follow, len(userUsers))
+	for i, userUser := range userUsers {
+		follows[i] = toFollowModel(userUser, &UserDbModel{ID: userUser.FollowerID}, &UserDbModel{ID: userUser.FollowingID})
+	}
+	return follows, nil
+}
@@ -0,0 +1,96 @@
+package user_dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func TestUserDAI_ImportFollows(t *testing.T) {
+	// Assume
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	dai := &UserDAI{db: gormDB}
+
+	follows := []*model.Follow{
+		{Follower: &model.User{ID: 1}, Following: &model.User{ID: 2}, FollowTs: 100},
+		{Follower: &model.User{ID: 1}, Following: &model.User{ID: 3}, FollowTs: 200},
+		{Follower: &model.User{ID: 4}, Following: &model.User{ID: 2}, FollowTs: 300},
+		{Follower: &model.User{ID: 4}, Following: &model.User{ID: 2}, FollowTs: 400}, // duplicate, last wins
+	}
+
+	mock.ExpectBegin()
+	// 1->2 is active, 1->3 was unfollowed, 4->2 is new
+	rows := sqlmock.NewRows([]string{"id", "follower_id", "following_id", "follow_timestamp", "removed"}).
+		AddRow(10, 1, 2, 50, false).
+		AddRow(11, 1, 3, 60, true)
+	mock.ExpectQuery("SELECT \\* FROM `user_users` WHERE follower_id IN \\(\\?,\\?,\\?\\) AND following_id IN \\(\\?,\\?,\\?\\)").
+		WithArgs(1, 1, 4, 2, 3, 2).
+		WillReturnRows(rows)
+	mock.ExpectExec("UPDATE `user_users` SET").
+		WithArgs(1, 3, 200, false, 11).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO `user_users`").
+		WithArgs(4, 2, 400, false).
+		WillReturnResult(sqlmock.NewResult(12, 1))
+	mock.ExpectCommit()
+
+	// Act
+	imported, err := dai.ImportFollows(context.Background(), follows)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, imported, 2)
+	assert.Equal(t, int64(11), imported[0].ID)
+	assert.Equal(t, int64(200), imported[0].FollowTs)
+	assert.Equal(t, int64(12), imported[1].ID)
+	assert.Equal(t, int64(4), imported[1].Follower.ID)
+	assert.Equal(t, int64(400), imported[1].FollowTs)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestUserDAI_GetExistingUserIDs(t *testing.T) {
+	// Assume
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	dai := &UserDAI{db: gormDB}
+
+	rows := sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(3)
+	mock.ExpectQuery("SELECT `id` FROM `users` WHERE id IN \\(\\?,\\?,\\?\\) AND removed = \\?").
+		WithArgs(1, 2, 3, false).
+		WillReturnRows(rows)
+
+	// Act
+	ids, err := dai.GetExistingUserIDs(context.Background(), []int64{1, 2, 3})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, ids)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
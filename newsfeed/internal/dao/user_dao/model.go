@@ -1,13 +1,20 @@
 package user_dao
 
 type UserDbModel struct {
-	ID           int64  `gorm:"column:id"`
-	Username     string `gorm:"column:user_name"`
-	HashPassword string `gorm:"column:hash_password"`
-	Email        string `gorm:"column:email"`
-	DisplayName  string `gorm:"column:display_name"`
-	Dob          string `gorm:"column:dob"`
-	Removed      bool   `gorm:"column:removed"`
+	ID            int64  `gorm:"column:id"`
+	Username      string `gorm:"column:user_name"`
+	HashPassword  string `gorm:"column:hash_password"`
+	Email         string `gorm:"column:email"`
+	DisplayName   string `gorm:"column:display_name"`
+	Dob           string `gorm:"column:dob"`
+	IsPrivate     bool   `gorm:"column:is_private"`
+	EmailVerified bool   `gorm:"column:email_verified"`
+	Removed       bool   `gorm:"column:removed"`
+
+	// counts are not inserted with users, they are only increased or decreased by follows and posts
+	FollowerCount  int64 `gorm:"column:follower_count;<-:update"`
+	FollowingCount int64 `gorm:"column:following_count;<-:update"`
+	PostCount      int64 `gorm:"column:post_count;<-:update"`
 }
 
 func (UserDbModel) TableName() string {
@@ -25,3 +32,63 @@ type UserUserDbModel struct {
 func (UserUserDbModel) TableName() string {
 	return "user_users"
 }
+
+type FollowRequestDbModel struct {
+	ID               int64 `gorm:"column:id"`
+	RequesterID      int64 `gorm:"column:requester_id"`
+	TargetID         int64 `gorm:"column:target_id"`
+	CreatedTimestamp int64 `gorm:"column:created_timestamp"`
+}
+
+func (FollowRequestDbModel) TableName() string {
+	return "follow_requests"
+}
+
+type SessionDbModel struct {
+	ID               string `gorm:"column:id"`
+	UserID           int64  `gorm:"column:user_id"`
+	CreatedTimestamp int64  `gorm:"column:created_timestamp"`
+	Revoked          bool   `gorm:"column:revoked"`
+}
+
+func (SessionDbModel) TableName() string {
+	return "sessions"
+}
+
+type RefreshTokenDbModel struct {
+	ID               int64  `gorm:"column:id"`
+	SessionID        string `gorm:"column:session_id"`
+	TokenHash        string `gorm:"column:token_hash"`
+	ExpiresTimestamp int64  `gorm:"column:expires_timestamp"`
+	Used             bool   `gorm:"column:used"`
+}
+
+func (RefreshTokenDbModel) TableName() string {
+	return "refresh_tokens"
+}
+
+type PasswordResetTokenDbModel struct {
+	ID               int64  `gorm:"column:id"`
+	UserID           int64  `gorm:"column:user_id"`
+	TokenHash        string `gorm:"column:token_hash"`
+	CreatedTimestamp int64  `gorm:"column:created_timestamp"`
+	ExpiresTimestamp int64  `gorm:"column:expires_timestamp"`
+	Used             bool   `gorm:"column:used"`
+}
+
+func (PasswordResetTokenDbModel) TableName() string {
+	return "password_reset_tokens"
+}
+
+type EmailVerificationTokenDbModel struct {
+	ID               int64  `gorm:"column:id"`
+	UserID           int64  `gorm:"column:user_id"`
+	TokenHash        string `gorm:"column:token_hash"`
+	CreatedTimestamp int64  `gorm:"column:created_timestamp"`
+	ExpiresTimestamp int64  `gorm:"column:expires_timestamp"`
+	Used             bool   `gorm:"column:used"`
+}
+
+func (EmailVerificationTokenDbModel) TableName() string {
+	return "email_verification_tokens"
+}
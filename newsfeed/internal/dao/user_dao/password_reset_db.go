@@ -0,0 +1,84 @@
+package user_dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+// GetByEmail returns users having an email, emails are not unique so there may be several
+func (d *UserDAI) GetByEmail(ctx context.Context, email string) ([]*model.User, error) {
+	dbUsers := make([]*UserDbModel, 0)
+	err := d.db.WithContext(ctx).Where("email=? and removed=false", email).Find(&dbUsers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*model.User, len(dbUsers))
+	for i, dbUser := range dbUsers {
+		users[i] = toUserModel(dbUser, false)
+	}
+	return users, nil
+}
+
+func (d *UserDAI) CreatePasswordResetToken(ctx context.Context, token *model.PasswordResetToken) error {
+	return d.db.WithContext(ctx).Create(&PasswordResetTokenDbModel{
+		UserID:           token.UserID,
+		TokenHash:        token.TokenHash,
+		CreatedTimestamp: token.CreatedTimestamp,
+		ExpiresTimestamp: token.ExpiresTimestamp,
+	}).Error
+}
+
+// ResetPassword uses a reset token to set the password of its user in one transaction, and returns the user id.
+// Other reset tokens of the user are used up and all sessions of the user are revoked with it.
+// It returns 0 if the token does not exist, is used or is expired.
+func (d *UserDAI) ResetPassword(ctx context.Context, tokenHash string, hashedPassword string) (int64, error) {
+	var userId int64
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// lock the token, so a concurrent reset with the same token waits and then finds it used
+		dbToken := &PasswordResetTokenDbModel{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ? AND used = ? AND expires_timestamp > ?", tokenHash, false, time.Now().Unix()).
+			First(dbToken).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&PasswordResetTokenDbModel{}).
+			Where("user_id = ? AND used = ?", dbToken.UserID, false).
+			Update("used", true).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&UserDbModel{}).
+			Where("id = ?", dbToken.UserID).
+			Update("hash_password", hashedPassword).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&SessionDbModel{}).
+			Where("user_id = ? AND revoked = ?", dbToken.UserID, false).
+			Update("revoked", true).Error
+		if err != nil {
+			return err
+		}
+
+		userId = dbToken.UserID
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return userId, nil
+}
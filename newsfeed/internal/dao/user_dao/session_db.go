@@ -0,0 +1,119 @@
+package user_dao
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+// CreateSession creates a session together with its first refresh token in one transaction
+func (d *UserDAI) CreateSession(ctx context.Context, session *model.Session, token *model.RefreshToken) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		dbSession := &SessionDbModel{
+			ID:               session.ID,
+			UserID:           session.UserID,
+			CreatedTimestamp: session.CreatedTimestamp,
+		}
+		if err := tx.Create(dbSession).Error; err != nil {
+			return err
+		}
+		return tx.Create(toRefreshTokenDbModel(token)).Error
+	})
+}
+
+// GetSession returns a session by id, nil if it does not exist
+func (d *UserDAI) GetSession(ctx context.Context, sessionId string) (*model.Session, error) {
+	dbSession := &SessionDbModel{}
+	err := d.db.WithContext(ctx).Where("id = ?", sessionId).First(dbSession).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toSessionModel(dbSession), nil
+}
+
+// GetRefreshToken returns a refresh token by its hash, nil if it does not exist
+func (d *UserDAI) GetRefreshToken(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	dbToken := &RefreshTokenDbModel{}
+	err := d.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(dbToken).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toRefreshTokenModel(dbToken), nil
+}
+
+// RotateRefreshToken marks a refresh token used and creates the next token of its session in one transaction.
+// It returns false without creating the next token if the token was used already, e.g. by a concurrent refresh.
+func (d *UserDAI) RotateRefreshToken(ctx context.Context, usedTokenHash string, next *model.RefreshToken) (bool, error) {
+	rotated := false
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&RefreshTokenDbModel{}).
+			Where("token_hash = ? AND used = ?", usedTokenHash, false).
+			Update("used", true)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.Create(toRefreshTokenDbModel(next)).Error; err != nil {
+			return err
+		}
+		rotated = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return rotated, nil
+}
+
+// RevokeSession revokes a session of a user, refresh tokens and access tokens of it stop working
+func (d *UserDAI) RevokeSession(ctx context.Context, userId int64, sessionId string) error {
+	return d.db.WithContext(ctx).Model(&SessionDbModel{}).
+		Where("id = ? AND user_id = ?", sessionId, userId).
+		Update("revoked", true).Error
+}
+
+// RevokeSessions revokes all sessions of a user
+func (d *UserDAI) RevokeSessions(ctx context.Context, userId int64) error {
+	return d.db.WithContext(ctx).Model(&SessionDbModel{}).
+		Where("user_id = ? AND revoked = ?", userId, false).
+		Update("revoked", true).Error
+}
+
+func toSessionModel(session *SessionDbModel) *model.Session {
+	return &model.Session{
+		ID:               session.ID,
+		UserID:           session.UserID,
+		CreatedTimestamp: session.CreatedTimestamp,
+		Revoked:          session.Revoked,
+	}
+}
+
+func toRefreshTokenModel(token *RefreshTokenDbModel) *model.RefreshToken {
+	return &model.RefreshToken{
+		SessionID:        token.SessionID,
+		TokenHash:        token.TokenHash,
+		ExpiresTimestamp: token.ExpiresTimestamp,
+		Used:             token.Used,
+	}
+}
+
+func toRefreshTokenDbModel(token *model.RefreshToken) *RefreshTokenDbModel {
+	return &RefreshTokenDbModel{
+		SessionID:        token.SessionID,
+		TokenHash:        token.TokenHash,
+		ExpiresTimestamp: token.ExpiresTimestamp,
+		Used:             token.Used,
+	}
+}
@@ -0,0 +1,63 @@
+package user_dao
+
+import (
+	"context"
+	"fmt"
+)
+
+type candidateCount struct {
+	UserID int64 `gorm:"column:user_id"`
+	Count  int64 `gorm:"column:count"`
+}
+
+// candidateQueryFormat counts, for each user followed by a neighbor of a user, the distinct neighbors following it.
+// Neighbors are followings or followers of the user depending on the columns, the user and its followings are excluded.
+const candidateQueryFormat = `SELECT f2.following_id AS user_id, COUNT(DISTINCT f1.%[1]s) AS count
+FROM user_users f1
+JOIN user_users f2 ON f2.follower_id = f1.%[1]s AND f2.removed = false
+JOIN users u ON u.id = f2.following_id AND u.removed = false
+WHERE f1.%[2]s = ? AND f1.removed = false AND f2.following_id <> ?
+AND f2.following_id NOT IN (SELECT following_id FROM user_users WHERE follower_id = ? AND removed = false)
+GROUP BY f2.following_id
+ORDER BY count DESC
+LIMIT ?`
+
+// GetUserIDs returns ids of active users after afterId, sorted by id asc
+func (d *UserDAI) GetUserIDs(ctx context.Context, afterId int64, limit int) ([]int64, error) {
+	ids := make([]int64, 0, limit)
+	err := d.db.WithContext(ctx).Model(&UserDbModel{}).
+		Where("id > ? AND removed = ?", afterId, false).
+		Order("id ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// CountFriendsOfFriends returns users followed by followings of a user, and for each of them
+// the number of followings of the user following it. Only the top limit users by count are returned.
+func (d *UserDAI) CountFriendsOfFriends(ctx context.Context, userId int64, limit int) (map[int64]int64, error) {
+	return d.countCandidates(ctx, fmt.Sprintf(candidateQueryFormat, "following_id", "follower_id"), userId, limit)
+}
+
+// CountCommonFollowers returns users followed by followers of a user, and for each of them
+// the number of users following both. Only the top limit users by count are returned.
+func (d *UserDAI) CountCommonFollowers(ctx context.Context, userId int64, limit int) (map[int64]int64, error) {
+	return d.countCandidates(ctx, fmt.Sprintf(candidateQueryFormat, "follower_id", "following_id"), userId, limit)
+}
+
+func (d *UserDAI) countCandidates(ctx context.Context, query string, userId int64, limit int) (map[int64]int64, error) {
+	rows := make([]*candidateCount, 0, limit)
+	err := d.db.WithContext(ctx).Raw(query, userId, userId, userId, limit).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.UserID] = row.Count
+	}
+	return counts, nil
+}
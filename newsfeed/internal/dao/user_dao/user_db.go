@@ -8,9 +8,10 @@ import (
 
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
-	"ep.k16/newsfeed/internal/common"
 	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
 	"ep.k16/newsfeed/pkg/logger"
 )
 
@@ -56,12 +57,14 @@ func (d *UserDAI) Stop() error {
 
 func (d *UserDAI) Create(ctx context.Context, user *model.User) (*model.User, error) {
 	dbUser := &UserDbModel{
-		Username:     user.Username,
-		HashPassword: user.HashedPassword,
-		Email:        user.Email,
-		DisplayName:  user.DisplayName,
-		Dob:          user.Dob,
-		Removed:      false,
+		Username:      user.Username,
+		HashPassword:  user.HashedPassword,
+		Email:         user.Email,
+		DisplayName:   user.DisplayName,
+		Dob:           user.Dob,
+		IsPrivate:     user.IsPrivate,
+		EmailVerified: user.EmailVerified,
+		Removed:       false,
 	}
 
 	result := d.db.WithContext(ctx).Create(dbUser)
@@ -97,99 +100,276 @@ func (d *UserDAI) GetByID(ctx context.Context, userId int64) (*model.User, error
 	return toUserModel(dbUser, false), nil
 }
 
+func (d *UserDAI) SetPrivate(ctx context.Context, userId int64, isPrivate bool) error {
+	return d.db.WithContext(ctx).Model(&UserDbModel{}).
+		Where("id = ? AND removed = ?", userId, false).
+		Update("is_private", isPrivate).Error
+}
+
+// Follow creates the follow, see follow. It returns nil if user and peer block each other.
 func (d *UserDAI) Follow(ctx context.Context, userId int64, peerId int64) (*model.Follow, error) {
-	dbUserUser := &UserUserDbModel{}
+	var f *model.Follow
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		f, err = follow(tx, userId, peerId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
 
-	err := d.db.WithContext(ctx).Where("follower_id=? and following_id=?", userId, peerId).First(dbUserUser).Error
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+// follow creates the follow or restores it if it was unfollowed, db should be a transaction
+// because counts of both users are updated together with the follow.
+// Concurrent follows of the same pair meet in one row by the unique (follower_id, following_id) index,
+// only the statement which made the follow active updates counts.
+// It returns nil without following if user and peer block each other.
+func follow(db *gorm.DB, userId int64, peerId int64) (*model.Follow, error) {
+	blocked, err := isBlockedForUpdate(db, userId, peerId)
+	if err != nil {
 		return nil, err
 	}
+	if blocked {
+		return nil, nil
+	}
 
-	// if not found, create
-	isNotFound := err != nil && errors.Is(err, gorm.ErrRecordNotFound)
-	isUnfollowed := dbUserUser.Removed
-	if isNotFound {
-		dbUserUser.FollowerID = userId
-		dbUserUser.FollowingID = peerId
-		dbUserUser.FollowTimestamp = time.Now().Unix()
-		dbUserUser.Removed = false
+	dbUserUser := &UserUserDbModel{
+		FollowerID:      userId,
+		FollowingID:     peerId,
+		FollowTimestamp: time.Now().Unix(),
+		Removed:         false,
+	}
 
-		result := d.db.WithContext(ctx).Create(dbUserUser)
-		if err := result.Error; err != nil {
+	// mysql affects 1 row on insert, 2 rows on restoring an unfollowed row and 0 rows if the follow is active already.
+	// follow_timestamp is set before removed so it still sees whether the row was unfollowed
+	result := db.Clauses(clause.OnConflict{
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "follow_timestamp"}, Value: gorm.Expr("IF(removed, VALUES(follow_timestamp), follow_timestamp)")},
+			{Column: clause.Column{Name: "removed"}, Value: false},
+		},
+	}).Create(dbUserUser)
+	if err := result.Error; err != nil {
+		return nil, err
+	}
+	if result.RowsAffected > 0 {
+		if err := updateFollowCounts(db, userId, peerId, 1); err != nil {
 			return nil, err
 		}
-		return toFollowModel(dbUserUser, nil, nil), nil
 	}
 
-	if isUnfollowed {
-		// reset follow timestamp and removed
-		dbUserUser.FollowTimestamp = time.Now().Unix()
-		dbUserUser.Removed = false
+	// id is not returned on conflict, so the row is read back
+	dbUserUser = &UserUserDbModel{}
+	err = db.Where("follower_id = ? AND following_id = ?", userId, peerId).First(dbUserUser).Error
+	if err != nil {
+		return nil, err
+	}
+	return toFollowModel(dbUserUser, &UserDbModel{ID: userId}, &UserDbModel{ID: peerId}), nil
+}
 
-		result := d.db.WithContext(ctx).Save(dbUserUser)
-		if err := result.Error; err != nil {
-			return nil, err
-		}
+// isBlockedForUpdate checks blocks between user and peer in both directions with a shared lock, db must be a transaction.
+// Without a block, the lock covers the gap where a block would be inserted, so a concurrent Block waits until
+// the follow is committed and then finds the follow to remove. A block committed first is seen and stops the follow.
+func isBlockedForUpdate(db *gorm.DB, userId int64, peerId int64) (bool, error) {
+	var count int64
+	err := db.Table("audience_members").
+		Clauses(clause.Locking{Strength: "SHARE"}).
+		Where("list_name = ?", model.AudienceListBlocked).
+		Where(db.Where("owner_id = ? AND member_id = ?", userId, peerId).
+			Or("owner_id = ? AND member_id = ?", peerId, userId)).
+		Count(&count).Error
+	if err != nil {
+		return false, err
 	}
+	return count > 0, nil
+}
 
+// GetFollow returns the active follow from user to peer, nil if user does not follow peer
+func (d *UserDAI) GetFollow(ctx context.Context, userId int64, peerId int64) (*model.Follow, error) {
+	dbUserUser := &UserUserDbModel{}
+	err := d.db.WithContext(ctx).
+		Where("follower_id = ? AND following_id = ? AND removed = ?", userId, peerId, false).
+		First(dbUserUser).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
 	return toFollowModel(dbUserUser, &UserDbModel{ID: userId}, &UserDbModel{ID: peerId}), nil
 }
 
-func (d *UserDAI) Unfollow(ctx context.Context, userId int64, followingId int64) error {
-	dbUserUser := &UserUserDbModel{}
+// GetFollowsBetween returns active follows between user and given peers in both directions,
+// followings of user among peers and followers of user among peers
+func (d *UserDAI) GetFollowsBetween(ctx context.Context, userId int64, peerIds []int64) ([]*model.Follow, error) {
+	if len(peerIds) == 0 {
+		return []*model.Follow{}, nil
+	}
 
-	result := d.db.WithContext(ctx).Model(dbUserUser).
-		Where("follower_id=? and following_id=?", userId, followingId).
-		Update("removed", true)
-	if result.Error != nil {
-		return result.Error
+	userUsers := make([]*UserUserDbModel, 0)
+	err := d.db.WithContext(ctx).
+		Where("removed = ?", false).
+		Where(d.db.Where("follower_id = ? AND following_id IN ?", userId, peerIds).
+			Or("following_id = ? AND follower_id IN ?", userId, peerIds)).
+		Find(&userUsers).Error
+	if err != nil {
+		return nil, err
 	}
-	return nil
+
+	follows := make([]*model.Follow, len(userUsers))
+	for i, userUser := range userUsers {
+		follows[i] = toFollowModel(userUser, &UserDbModel{ID: userUser.FollowerID}, &UserDbModel{ID: userUser.FollowingID})
+	}
+	return follows, nil
+}
+
+// Unfollow removes the follow and decreases counts of both users, unfollowing a removed follow does nothing
+func (d *UserDAI) Unfollow(ctx context.Context, userId int64, followingId int64) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&UserUserDbModel{}).
+			Where("follower_id = ? AND following_id = ? AND removed = ?", userId, followingId, false).
+			Update("removed", true)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return updateFollowCounts(tx, userId, followingId, -1)
+	})
 }
 
-func (d *UserDAI) GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error) {
-	lastTs, ok := paging.LastValue.(int64)
-	if !ok {
-		return nil, errors.New("invalid last value")
+// GetFollowings returns followings of a user sorted by (follow timestamp, following id) desc.
+// paging.Cursor holds the follow timestamp and id of the last following of the previous page, nil means the first page.
+// The next cursor points to the last follow read, nil if fewer follows than the limit are left. Follows of removed users are skipped
+// after the cursor is taken, so a page may be short while there are more pages.
+func (d *UserDAI) GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error) {
+	query := d.db.WithContext(ctx).Model(&UserUserDbModel{}).
+		Select("id, following_id, follow_timestamp").
+		Where("follower_id = ? AND removed = ?", userId, false)
+	if paging.Cursor != nil {
+		query = query.Where("follow_timestamp < ? OR (follow_timestamp = ? AND following_id < ?)",
+			paging.Cursor.SortKey, paging.Cursor.SortKey, paging.Cursor.ID)
 	}
 
 	userUsers := make([]*UserUserDbModel, 0, paging.Limit)
-	result := d.db.WithContext(ctx).Model(&UserUserDbModel{}).
-		Select("id, following_id, follow_timestamp").
-		Where("follower_id = ? AND removed = ? AND follow_timestamp < ?", userId, false, lastTs).
-		Order("follow_timestamp DESC"). // sort by follow_ts desc
+	result := query.
+		Order("follow_timestamp DESC, following_id DESC"). // following id breaks ties of follows in the same second
 		Limit(int(paging.Limit)).
 		Find(&userUsers)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, nil, result.Error
+	}
+	if len(userUsers) == 0 {
+		return []*model.Follow{}, nil, nil
+	}
+	var next *cursor.Cursor
+	if int64(len(userUsers)) == paging.Limit {
+		last := userUsers[len(userUsers)-1]
+		next = &cursor.Cursor{SortKey: last.FollowTimestamp, ID: last.FollowingID}
 	}
 
 	logger.Debug("query user_users", logger.F("user_users", userUsers))
 
-	followings := make([]*UserDbModel, len(userUsers))
 	followingIDs := make([]int64, len(userUsers))
 	for i := range userUsers {
 		followingIDs[i] = userUsers[i].FollowingID
 	}
+	followings := make([]*UserDbModel, 0, len(userUsers))
 	err := d.db.WithContext(ctx).Where("id IN ?", followingIDs).
 		Select("id, user_name, display_name, email, dob").
 		Where("removed = ?", false).
 		Find(&followings).Error
+	if err != nil {
+		return nil, nil, err
+	}
 
 	logger.Debug("query users", logger.F("followings", followings))
 
+	// join data with follows and keep the order of follows
+	return joinFollowings(userUsers, followings), next, nil
+}
+
+// GetFollowers returns followers of a user sorted by (follow timestamp, follower id) desc.
+// paging.Cursor holds the follow timestamp and id of the last follower of the previous page, nil means the first page.
+// The next cursor is the same as GetFollowings.
+func (d *UserDAI) GetFollowers(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error) {
+	query := d.db.WithContext(ctx).Model(&UserUserDbModel{}).
+		Select("id, follower_id, follow_timestamp").
+		Where("following_id = ? AND removed = ?", userId, false)
+	if paging.Cursor != nil {
+		query = query.Where("follow_timestamp < ? OR (follow_timestamp = ? AND follower_id < ?)",
+			paging.Cursor.SortKey, paging.Cursor.SortKey, paging.Cursor.ID)
+	}
+
+	userUsers := make([]*UserUserDbModel, 0, paging.Limit)
+	result := query.
+		Order("follow_timestamp DESC, follower_id DESC"). // follower id breaks ties of follows in the same second
+		Limit(int(paging.Limit)).
+		Find(&userUsers)
+	if result.Error != nil {
+		return nil, nil, result.Error
+	}
+	if len(userUsers) == 0 {
+		return []*model.Follow{}, nil, nil
+	}
+	var next *cursor.Cursor
+	if int64(len(userUsers)) == paging.Limit {
+		last := userUsers[len(userUsers)-1]
+		next = &cursor.Cursor{SortKey: last.FollowTimestamp, ID: last.FollowerID}
+	}
+
+	followerIDs := make([]int64, len(userUsers))
+	for i := range userUsers {
+		followerIDs[i] = userUsers[i].FollowerID
+	}
+	followers := make([]*UserDbModel, 0, len(userUsers))
+	err := d.db.WithContext(ctx).Where("id IN ?", followerIDs).
+		Select("id, user_name, display_name, email, dob").
+		Where("removed = ?", false).
+		Find(&followers).Error
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// join data with follows and keep the order of follows
+	return joinFollowers(userUsers, followers), next, nil
+}
+
+// GetFollowingTimestamps returns follow timestamps of all followings of a user by following id, used to rebuild cache
+func (d *UserDAI) GetFollowingTimestamps(ctx context.Context, userId int64) (map[int64]int64, error) {
+	userUsers := make([]*UserUserDbModel, 0)
+	err := d.db.WithContext(ctx).Model(&UserUserDbModel{}).
+		Select("following_id, follow_timestamp").
+		Where("follower_id = ? AND removed = ?", userId, false).
+		Find(&userUsers).Error
 	if err != nil {
 		return nil, err
 	}
 
-	// join data wiht follows and re-sort by the correct order
-	followModels := joinFollowings(userUsers, followings)
-	return followModels, nil
+	res := make(map[int64]int64, len(userUsers))
+	for _, userUser := range userUsers {
+		res[userUser.FollowingID] = userUser.FollowTimestamp
+	}
+	return res, nil
 }
 
-func (d *UserDAI) GetFollowers(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error) {
-	// TODO: implement me
-	return nil, common.NewError(common.CodeNotImplemented, "DAI GetFollowers not implemented")
+// GetFollowerTimestamps returns follow timestamps of all followers of a user by follower id, used to rebuild cache
+func (d *UserDAI) GetFollowerTimestamps(ctx context.Context, userId int64) (map[int64]int64, error) {
+	userUsers := make([]*UserUserDbModel, 0)
+	err := d.db.WithContext(ctx).Model(&UserUserDbModel{}).
+		Select("follower_id, follow_timestamp").
+		Where("following_id = ? AND removed = ?", userId, false).
+		Find(&userUsers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int64]int64, len(userUsers))
+	for _, userUser := range userUsers {
+		res[userUser.FollowerID] = userUser.FollowTimestamp
+	}
+	return res, nil
 }
 
 func toUserModel(user *UserDbModel, withHashedPassword bool) *model.User {
@@ -201,6 +381,8 @@ func toUserModel(user *UserDbModel, withHashedPassword bool) *model.User {
 		DisplayName:    user.DisplayName,
 		Email:          user.Email,
 		Dob:            user.Dob,
+		IsPrivate:      user.IsPrivate,
+		EmailVerified:  user.EmailVerified,
 	}
 	if withHashedPassword {
 		res.HashedPassword = user.HashPassword
@@ -223,15 +405,39 @@ func toFollowModel(userUser *UserUserDbModel, follower *UserDbModel, following *
 	return follow
 }
 
+// joinFollowers joins follows with follower data, follows of removed users are skipped
+func joinFollowers(userUsers []*UserUserDbModel, followers []*UserDbModel) []*model.Follow {
+	userByIdMap := make(map[int64]*UserDbModel)
+	for _, follower := range followers {
+		userByIdMap[follower.ID] = follower
+	}
+
+	followModels := make([]*model.Follow, 0, len(userUsers))
+	for _, userUser := range userUsers {
+		follower, ok := userByIdMap[userUser.FollowerID]
+		if !ok {
+			continue
+		}
+		followModels = append(followModels, toFollowModel(userUser, follower, nil))
+	}
+
+	return followModels
+}
+
+// joinFollowings joins follows with following data, follows of removed users are skipped
 func joinFollowings(userUsers []*UserUserDbModel, followings []*UserDbModel) []*model.Follow {
 	userByIdMap := make(map[int64]*UserDbModel)
 	for _, following := range followings {
 		userByIdMap[following.ID] = following
 	}
 
-	followModels := make([]*model.Follow, len(followings))
-	for i, userUser := range userUsers {
-		followModels[i] = toFollowModel(userUser, nil, userByIdMap[userUser.FollowingID])
+	followModels := make([]*model.Follow, 0, len(userUsers))
+	for _, userUser := range userUsers {
+		following, ok := userByIdMap[userUser.FollowingID]
+		if !ok {
+			continue
+		}
+		followModels = append(followModels, toFollowModel(userUser, nil, following))
 	}
 
 	return followModels
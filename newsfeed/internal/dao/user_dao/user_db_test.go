@@ -10,6 +10,7 @@ import (
 	"gorm.io/gorm"
 
 	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
 )
 
 func TestUserDAI_Create(t *testing.T) {
@@ -43,6 +44,8 @@ func TestUserDAI_Create(t *testing.T) {
 			user.Email,
 			user.DisplayName,
 			user.Dob,
+			false, // is_private
+			false, // email_verified
 			false, // removed
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
@@ -59,3 +62,434 @@ func TestUserDAI_Create(t *testing.T) {
 	err = mock.ExpectationsWereMet()
 	assert.NoError(t, err)
 }
+
+func TestUserDAI_GetFollowers(t *testing.T) {
+	// Assume
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	dai := &UserDAI{db: gormDB}
+
+	followRows := sqlmock.NewRows([]string{"id", "follower_id", "follow_timestamp"}).
+		AddRow(7, 3, 1700000200).
+		AddRow(5, 4, 1700000100). // user 4 is removed
+		AddRow(4, 2, 1700000000)
+	mock.ExpectQuery("SELECT id, follower_id, follow_timestamp FROM `user_users` WHERE \\(following_id = \\? AND removed = \\?\\) AND \\(follow_timestamp < \\? OR \\(follow_timestamp = \\? AND follower_id < \\?\\)\\) ORDER BY follow_timestamp DESC, follower_id DESC LIMIT \\?").
+		WithArgs(1, false, 1700000300, 1700000300, 9, 3).
+		WillReturnRows(followRows)
+	userRows := sqlmock.NewRows([]string{"id", "user_name"}).
+		AddRow(2, "second").
+		AddRow(3, "third")
+	mock.ExpectQuery("SELECT id, user_name, display_name, email, dob FROM `users` WHERE id IN \\(\\?,\\?,\\?\\) AND removed = \\?").
+		WithArgs(3, 4, 2, false).
+		WillReturnRows(userRows)
+
+	// Act
+	followers, next, err := dai.GetFollowers(context.Background(), 1, &model.Paging{Cursor: &cursor.Cursor{SortKey: 1700000300, ID: 9}, Limit: 3})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, followers, 2)
+	assert.Equal(t, &cursor.Cursor{SortKey: 1700000000, ID: 2}, next) // removed user does not end the list
+	assert.Equal(t, int64(3), followers[0].Follower.ID)
+	assert.Equal(t, int64(1700000200), followers[0].FollowTs)
+	assert.Equal(t, int64(2), followers[1].Follower.ID)
+	assert.Nil(t, followers[1].Following)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestUserDAI_ApproveFollowRequest(t *testing.T) {
+	// Assume
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	dai := &UserDAI{db: gormDB}
+
+	t.Run("request not found", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM `follow_requests` WHERE requester_id = \\? AND target_id = \\?").
+			WithArgs(2, 1).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		f, err := dai.ApproveFollowRequest(context.Background(), 2, 1)
+
+		assert.NoError(t, err)
+		assert.Nil(t, f)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("delete request and create follow", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM `follow_requests` WHERE requester_id = \\? AND target_id = \\?").
+			WithArgs(2, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `audience_members` WHERE list_name = \\? AND .* FOR SHARE").
+			WithArgs(model.AudienceListBlocked, 2, 1, 1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(0))
+		mock.ExpectExec("INSERT INTO `user_users` .* ON DUPLICATE KEY UPDATE").
+			WithArgs(2, 1, sqlmock.AnyArg(), false, false).
+			WillReturnResult(sqlmock.NewResult(9, 1))
+		mock.ExpectExec("UPDATE `users` SET `following_count`=following_count \\+ \\? WHERE id = \\?").
+			WithArgs(1, 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE `users` SET `follower_count`=follower_count \\+ \\? WHERE id = \\?").
+			WithArgs(1, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT \\* FROM `user_users` WHERE follower_id = \\? AND following_id = \\?").
+			WithArgs(2, 1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "follower_id", "following_id", "follow_timestamp", "removed"}).
+				AddRow(9, 2, 1, 1700000000, false))
+		mock.ExpectCommit()
+
+		f, err := dai.ApproveFollowRequest(context.Background(), 2, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), f.ID)
+		assert.Equal(t, int64(2), f.Follower.ID)
+		assert.Equal(t, int64(1), f.Following.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUserDAI_Follow(t *testing.T) {
+	// Assume
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	dai := &UserDAI{db: gormDB}
+
+	t.Run("active follow does not update counts", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `audience_members` WHERE list_name = \\? AND .* FOR SHARE").
+			WithArgs(model.AudienceListBlocked, 1, 2, 2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(0))
+		mock.ExpectExec("INSERT INTO `user_users` .* ON DUPLICATE KEY UPDATE").
+			WithArgs(1, 2, sqlmock.AnyArg(), false, false).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT \\* FROM `user_users` WHERE follower_id = \\? AND following_id = \\?").
+			WithArgs(1, 2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "follower_id", "following_id", "follow_timestamp", "removed"}).
+				AddRow(5, 1, 2, 1700000000, false))
+		mock.ExpectCommit()
+
+		f, err := dai.Follow(context.Background(), 1, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), f.ID)
+		assert.Equal(t, int64(1700000000), f.FollowTs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("block committed before the follow stops it", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `audience_members` WHERE list_name = \\? AND .* FOR SHARE").
+			WithArgs(model.AudienceListBlocked, 1, 2, 2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(1))
+		mock.ExpectCommit()
+
+		f, err := dai.Follow(context.Background(), 1, 2)
+
+		assert.NoError(t, err)
+		assert.Nil(t, f)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("restored follow updates counts", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT count\\(\\*\\) FROM `audience_members` WHERE list_name = \\? AND .* FOR SHARE").
+			WithArgs(model.AudienceListBlocked, 1, 2, 2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(0))
+		mock.ExpectExec("INSERT INTO `user_users` .* ON DUPLICATE KEY UPDATE").
+			WithArgs(1, 2, sqlmock.AnyArg(), false, false).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("UPDATE `users` SET `following_count`=following_count \\+ \\? WHERE id = \\?").
+			WithArgs(1, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE `users` SET `follower_count`=follower_count \\+ \\? WHERE id = \\?").
+			WithArgs(1, 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT \\* FROM `user_users` WHERE follower_id = \\? AND following_id = \\?").
+			WithArgs(1, 2, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "follower_id", "following_id", "follow_timestamp", "removed"}).
+				AddRow(5, 1, 2, 1800000000, false))
+		mock.ExpectCommit()
+
+		f, err := dai.Follow(context.Background(), 1, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), f.ID)
+		assert.Equal(t, int64(1800000000), f.FollowTs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUserDAI_Unfollow(t *testing.T) {
+	// Assume
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	dai := &UserDAI{db: gormDB}
+
+	t.Run("not following", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `user_users` SET `removed`=\\? WHERE follower_id = \\? AND following_id = \\? AND removed = \\?").
+			WithArgs(true, 1, 2, false).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := dai.Unfollow(context.Background(), 1, 2)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("remove follow and decrease counts", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `user_users` SET `removed`=\\? WHERE follower_id = \\? AND following_id = \\? AND removed = \\?").
+			WithArgs(true, 1, 2, false).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE `users` SET `following_count`=following_count \\+ \\? WHERE id = \\?").
+			WithArgs(-1, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE `users` SET `follower_count`=follower_count \\+ \\? WHERE id = \\?").
+			WithArgs(-1, 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := dai.Unfollow(context.Background(), 1, 2)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUserDAI_GetFollowsBetween(t *testing.T) {
+	// Assume
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	dai := &UserDAI{db: gormDB}
+
+	rows := sqlmock.NewRows([]string{"id", "follower_id", "following_id", "follow_timestamp", "removed"}).
+		AddRow(5, 1, 2, 1700000000, false).
+		AddRow(7, 3, 1, 1700000100, false)
+	mock.ExpectQuery("SELECT \\* FROM `user_users` WHERE removed = \\? AND \\(\\(follower_id = \\? AND following_id IN \\(\\?,\\?\\)\\) OR \\(following_id = \\? AND follower_id IN \\(\\?,\\?\\)\\)\\)").
+		WithArgs(false, 1, 2, 3, 1, 2, 3).
+		WillReturnRows(rows)
+
+	// Act
+	follows, err := dai.GetFollowsBetween(context.Background(), 1, []int64{2, 3})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, follows, 2)
+	assert.Equal(t, int64(2), follows[0].Following.ID)
+	assert.Equal(t, int64(3), follows[1].Follower.ID)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestUserDAI_GetFollowerTimestamps(t *testing.T) {
+	// Assume
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	dai := &UserDAI{db: gormDB}
+
+	rows := sqlmock.NewRows([]string{"follower_id", "follow_timestamp"}).
+		AddRow(3, 1700000200).
+		AddRow(2, 1700000000)
+	mock.ExpectQuery("SELECT follower_id, follow_timestamp FROM `user_users` WHERE following_id = \\? AND removed = \\?").
+		WithArgs(1, false).
+		WillReturnRows(rows)
+
+	// Act
+	followTsById, err := dai.GetFollowerTimestamps(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]int64{3: 1700000200, 2: 1700000000}, followTsById)
+
+	err = mock.ExpectationsWereMet()
+	assert.NoError(t, err)
+}
+
+func TestUserDAI_RotateRefreshToken(t *testing.T) {
+	// Assume
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	dai := &UserDAI{db: gormDB}
+	next := &model.RefreshToken{SessionID: "s1", TokenHash: "next", ExpiresTimestamp: 1700000000}
+
+	t.Run("token used already", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `refresh_tokens` SET `used`=\\? WHERE token_hash = \\? AND used = \\?").
+			WithArgs(true, "used", false).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		rotated, err := dai.RotateRefreshToken(context.Background(), "used", next)
+
+		assert.NoError(t, err)
+		assert.False(t, rotated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("mark token used and create next token", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `refresh_tokens` SET `used`=\\? WHERE token_hash = \\? AND used = \\?").
+			WithArgs(true, "current", false).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO `refresh_tokens`").
+			WithArgs("s1", "next", 1700000000, false).
+			WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectCommit()
+
+		rotated, err := dai.RotateRefreshToken(context.Background(), "current", next)
+
+		assert.NoError(t, err)
+		assert.True(t, rotated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUserDAI_ResetPassword(t *testing.T) {
+	// Assume
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	dai := &UserDAI{db: gormDB}
+
+	t.Run("invalid token", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT \\* FROM `password_reset_tokens` WHERE .* FOR UPDATE").
+			WithArgs("unknown", false, sqlmock.AnyArg(), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectCommit()
+
+		userId, err := dai.ResetPassword(context.Background(), "unknown", "hashed")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), userId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("set password and revoke sessions", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT \\* FROM `password_reset_tokens` WHERE .* FOR UPDATE").
+			WithArgs("current", false, sqlmock.AnyArg(), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "token_hash"}).AddRow(1, 7, "current"))
+		mock.ExpectExec("UPDATE `password_reset_tokens` SET `used`=\\? WHERE user_id = \\? AND used = \\?").
+			WithArgs(true, 7, false).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("UPDATE `users` SET `hash_password`=\\? WHERE id = \\?").
+			WithArgs("hashed", 7).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE `sessions` SET `revoked`=\\? WHERE user_id = \\? AND revoked = \\?").
+			WithArgs(true, 7, false).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectCommit()
+
+		userId, err := dai.ResetPassword(context.Background(), "current", "hashed")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), userId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUserDAI_VerifyEmail(t *testing.T) {
+	// Assume
+	sqlDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer sqlDB.Close()
+
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	dai := &UserDAI{db: gormDB}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT \\* FROM `email_verification_tokens` WHERE .* FOR UPDATE").
+		WithArgs("current", false, sqlmock.AnyArg(), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "token_hash"}).AddRow(1, 7, "current"))
+	mock.ExpectExec("UPDATE `email_verification_tokens` SET `used`=\\? WHERE user_id = \\? AND used = \\?").
+		WithArgs(true, 7, false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `users` SET `email_verified`=\\? WHERE id = \\?").
+		WithArgs(true, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Act
+	userId, err := dai.VerifyEmail(context.Background(), "current")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), userId)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,104 @@
+package count_reconciler
+
+import (
+	"context"
+	"time"
+
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+const (
+	defaultInterval  = 6 * time.Hour
+	defaultBatchSize = 500
+)
+
+type UserService interface {
+	ReconcileCounts(ctx context.Context, afterId int64, batchSize int) (int64, int, error)
+}
+
+type Config struct {
+	Interval  time.Duration // time between 2 reconciliations of all users
+	BatchSize int           // number of users reconciled per batch
+}
+
+// CountReconciler periodically recounts follows and posts of all users from db,
+// so denormalized counts do not drift forever if a write bypasses them
+type CountReconciler struct {
+	cfg Config
+
+	userService UserService
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func New(cfg Config, userService UserService) (*CountReconciler, error) {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+
+	return &CountReconciler{
+		cfg:         cfg,
+		userService: userService,
+		stopCh:      make(chan struct{}),
+		doneCh:      make(chan struct{}),
+	}, nil
+}
+
+// Start reconciles counts until Stop is called, it is blocking
+func (r *CountReconciler) Start() {
+	defer close(r.doneCh)
+
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.C:
+			r.reconcile()
+		}
+	}
+}
+
+// Stop stops reconciling and waits for the running reconciliation to stop at its next batch
+func (r *CountReconciler) Stop() {
+	close(r.stopCh)
+	<-r.doneCh
+}
+
+// reconcile visits all users batch by batch, it stops early if Stop is called
+func (r *CountReconciler) reconcile() {
+	ctx := context.Background()
+	start := time.Now()
+
+	var (
+		afterId int64
+		total   int
+	)
+	for {
+		select {
+		case <-r.stopCh:
+			logger.Info("count reconciliation is stopped", logger.F("users", total))
+			return
+		default:
+		}
+
+		lastId, n, err := r.userService.ReconcileCounts(ctx, afterId, r.cfg.BatchSize)
+		if err != nil {
+			logger.Error("failed to reconcile counts", logger.E(err), logger.F("after_id", afterId))
+			return
+		}
+		total += n
+		if n < r.cfg.BatchSize {
+			break
+		}
+		afterId = lastId
+	}
+
+	logger.Info("reconciled counts", logger.F("users", total), logger.F("duration", time.Since(start).String()))
+}
@@ -0,0 +1,208 @@
+package follow_graph
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+
+	maxNDJSONLineSize = 1 << 20
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown format, expected csv or ndjson")
+	ErrInvalidEdge   = errors.New("invalid edge")
+)
+
+// csvHeader is the optional first row of CSV, follow_timestamp column may be omitted
+var csvHeader = []string{"follower_id", "following_id", "follow_timestamp"}
+
+// edgeRecord is an edge of NDJSON, follow_timestamp may be omitted
+type edgeRecord struct {
+	FollowerID      int64 `json:"follower_id"`
+	FollowingID     int64 `json:"following_id"`
+	FollowTimestamp int64 `json:"follow_timestamp,omitempty"`
+}
+
+// EdgeReader reads follow edges one by one from CSV or NDJSON
+type EdgeReader struct {
+	format    string
+	csvReader *csv.Reader
+	scanner   *bufio.Scanner
+
+	records int64 // records read so far, including invalid ones
+}
+
+func NewEdgeReader(r io.Reader, format string) (*EdgeReader, error) {
+	reader := &EdgeReader{format: format}
+	switch format {
+	case FormatCSV:
+		reader.csvReader = csv.NewReader(r)
+		reader.csvReader.FieldsPerRecord = -1 // checked by parseCSVRecord
+		reader.csvReader.TrimLeadingSpace = true
+	case FormatNDJSON:
+		reader.scanner = bufio.NewScanner(r)
+		reader.scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLineSize)
+	default:
+		return nil, ErrUnknownFormat
+	}
+	return reader, nil
+}
+
+// Read returns the next edge as a follow having only user ids, or io.EOF at the end.
+// A malformed record returns an error wrapping ErrInvalidEdge, reading can go on with the next record.
+// Follow timestamp is 0 if the record does not have it.
+func (r *EdgeReader) Read() (*model.Follow, error) {
+	var (
+		record *edgeRecord
+		err    error
+	)
+	if r.format == FormatCSV {
+		record, err = r.readCSV()
+	} else {
+		record, err = r.readNDJSON()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if record.FollowerID <= 0 || record.FollowingID <= 0 || record.FollowerID == record.FollowingID || record.FollowTimestamp < 0 {
+		return nil, fmt.Errorf("record %d: %w", r.records, ErrInvalidEdge)
+	}
+	return &model.Follow{
+		Follower:  &model.User{ID: record.FollowerID},
+		Following: &model.User{ID: record.FollowingID},
+		FollowTs:  record.FollowTimestamp,
+	}, nil
+}
+
+// Records returns the number of records read so far, it is the checkpoint to skip them when resuming
+func (r *EdgeReader) Records() int64 {
+	return r.records
+}
+
+func (r *EdgeReader) readCSV() (*edgeRecord, error) {
+	for {
+		fields, err := r.csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				r.records++
+				return nil, fmt.Errorf("record %d: %w: %s", r.records, ErrInvalidEdge, parseErr.Err)
+			}
+			return nil, err
+		}
+
+		// header is not a record
+		if r.records == 0 && len(fields) > 0 && fields[0] == csvHeader[0] {
+			continue
+		}
+
+		r.records++
+		return r.parseCSVRecord(fields)
+	}
+}
+
+func (r *EdgeReader) parseCSVRecord(fields []string) (*edgeRecord, error) {
+	if len(fields) != 2 && len(fields) != 3 {
+		return nil, fmt.Errorf("record %d: %w: expected 2 or 3 fields", r.records, ErrInvalidEdge)
+	}
+
+	values := make([]int64, 3)
+	for i := range fields {
+		value, err := strconv.ParseInt(fields[i], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w: invalid %s", r.records, ErrInvalidEdge, csvHeader[i])
+		}
+		values[i] = value
+	}
+	return &edgeRecord{
+		FollowerID:      values[0],
+		FollowingID:     values[1],
+		FollowTimestamp: values[2],
+	}, nil
+}
+
+func (r *EdgeReader) readNDJSON() (*edgeRecord, error) {
+	for r.scanner.Scan() {
+		line := r.scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		r.records++
+		record := &edgeRecord{}
+		if err := json.Unmarshal(line, record); err != nil {
+			return nil, fmt.Errorf("record %d: %w: %s", r.records, ErrInvalidEdge, err)
+		}
+		return record, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// EdgeWriter writes follow edges to CSV or NDJSON, Flush must be called after the last edge
+type EdgeWriter struct {
+	format    string
+	csvWriter *csv.Writer
+	bufWriter *bufio.Writer
+	encoder   *json.Encoder
+}
+
+// NewEdgeWriter creates a writer, withHeader writes the CSV header first, it is ignored for NDJSON
+func NewEdgeWriter(w io.Writer, format string, withHeader bool) (*EdgeWriter, error) {
+	writer := &EdgeWriter{format: format}
+	switch format {
+	case FormatCSV:
+		writer.csvWriter = csv.NewWriter(w)
+		if withHeader {
+			if err := writer.csvWriter.Write(csvHeader); err != nil {
+				return nil, err
+			}
+		}
+	case FormatNDJSON:
+		writer.bufWriter = bufio.NewWriter(w)
+		writer.encoder = json.NewEncoder(writer.bufWriter)
+	default:
+		return nil, ErrUnknownFormat
+	}
+	return writer, nil
+}
+
+func (w *EdgeWriter) Write(f *model.Follow) error {
+	if w.format == FormatCSV {
+		return w.csvWriter.Write([]string{
+			strconv.FormatInt(f.Follower.ID, 10),
+			strconv.FormatInt(f.Following.ID, 10),
+			strconv.FormatInt(f.FollowTs, 10),
+		})
+	}
+	return w.encoder.Encode(&edgeRecord{
+		FollowerID:      f.Follower.ID,
+		FollowingID:     f.Following.ID,
+		FollowTimestamp: f.FollowTs,
+	})
+}
+
+func (w *EdgeWriter) Flush() error {
+	if w.format == FormatCSV {
+		w.csvWriter.Flush()
+		return w.csvWriter.Error()
+	}
+	return w.bufWriter.Flush()
+}
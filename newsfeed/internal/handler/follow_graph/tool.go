@@ -0,0 +1,223 @@
+package follow_graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+const defaultBatchSize = 1000
+
+type UserService interface {
+	ImportFollows(ctx context.Context, follows []*model.Follow) (int, int, error)
+	ExportFollows(ctx context.Context, userId, afterId int64, batchSize int) ([]*model.Follow, error)
+}
+
+type Config struct {
+	BatchSize int // number of edges per transaction when importing, per query when exporting
+
+	// CheckpointFile keeps the progress committed so far, a run stopped in the middle continues from it.
+	// It holds the number of records read for import and the id of the last exported follow for export.
+	// Empty means starting from the beginning without saving progress.
+	CheckpointFile string
+}
+
+// Tool imports follow edges from another system into db and cache, or exports them back
+type Tool struct {
+	cfg Config
+
+	userService UserService
+}
+
+type Stats struct {
+	Records  int64 // records read by import including skipped ones, or written by export
+	Invalid  int64 // malformed records skipped by import
+	Skipped  int64 // follows of missing users or between users blocking each other, skipped by import
+	Imported int64 // follows created or restored by import
+}
+
+func New(cfg Config, userService UserService) (*Tool, error) {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+
+	return &Tool{
+		cfg:         cfg,
+		userService: userService,
+	}, nil
+}
+
+// Import reads all edges of reader and imports them batch by batch, each batch in one transaction.
+// Records before the checkpoint are skipped, the checkpoint is saved after each batch.
+// It stops after the running batch if ctx is canceled, then running it again with the same checkpoint resumes it.
+// Edges without timestamp are imported with the current time.
+func (t *Tool) Import(ctx context.Context, reader *EdgeReader) (*Stats, error) {
+	checkpoint, err := LoadCheckpoint(t.cfg.CheckpointFile)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{}
+	start := time.Now()
+	batch := make([]*model.Follow, 0, t.cfg.BatchSize)
+
+	flush := func() error {
+		if len(batch) > 0 {
+			n, skipped, err := t.userService.ImportFollows(ctx, batch)
+			if err != nil {
+				return fmt.Errorf("failed to import batch ending at record %d: %w", reader.Records(), err)
+			}
+			stats.Imported += int64(n)
+			stats.Skipped += int64(skipped)
+			batch = batch[:0]
+		}
+		stats.Records = reader.Records()
+		if err := t.saveCheckpoint(reader.Records()); err != nil {
+			return err
+		}
+
+		logger.Info("imported follows",
+			logger.F("records", reader.Records()),
+			logger.F("imported", stats.Imported),
+			logger.F("invalid", stats.Invalid),
+			logger.F("skipped", stats.Skipped),
+			logger.F("duration", time.Since(start).String()),
+		)
+		return nil
+	}
+
+	now := time.Now().Unix()
+	for {
+		f, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, ErrInvalidEdge) {
+			return stats, err
+		}
+		if reader.Records() <= checkpoint { // committed by a previous run
+			continue
+		}
+		if err != nil {
+			stats.Invalid++
+			logger.Error("skip invalid edge", logger.E(err))
+			continue
+		}
+
+		if f.FollowTs == 0 {
+			f.FollowTs = now
+		}
+		batch = append(batch, f)
+		if len(batch) < t.cfg.BatchSize {
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return stats, err
+		}
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+	}
+
+	if err := flush(); err != nil {
+		return stats, err
+	}
+	return stats, nil
+}
+
+// Export writes active follows sorted by follow id, userId limits them to follows of the user, 0 means all users.
+// Follows up to the checkpoint are skipped, so writer should append to the output of the stopped run.
+// It stops after the running batch if ctx is canceled.
+func (t *Tool) Export(ctx context.Context, writer *EdgeWriter, userId int64) (*Stats, error) {
+	afterId, err := LoadCheckpoint(t.cfg.CheckpointFile)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{}
+	start := time.Now()
+	for {
+		follows, err := t.userService.ExportFollows(ctx, userId, afterId, t.cfg.BatchSize)
+		if err != nil {
+			return stats, fmt.Errorf("failed to export batch after follow %d: %w", afterId, err)
+		}
+		if len(follows) == 0 {
+			break
+		}
+
+		for _, f := range follows {
+			if err := writer.Write(f); err != nil {
+				return stats, err
+			}
+		}
+		// edges must be written before the checkpoint moves past them
+		if err := writer.Flush(); err != nil {
+			return stats, err
+		}
+		afterId = follows[len(follows)-1].ID
+		stats.Records += int64(len(follows))
+		if err := t.saveCheckpoint(afterId); err != nil {
+			return stats, err
+		}
+
+		logger.Info("exported follows",
+			logger.F("records", stats.Records),
+			logger.F("last_follow_id", afterId),
+			logger.F("duration", time.Since(start).String()),
+		)
+
+		if len(follows) < t.cfg.BatchSize {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+	}
+	return stats, nil
+}
+
+func (t *Tool) saveCheckpoint(value int64) error {
+	if len(t.cfg.CheckpointFile) == 0 {
+		return nil
+	}
+
+	// write to a temp file then rename it, so a crash does not leave a partial checkpoint
+	tmpFile := t.cfg.CheckpointFile + ".tmp"
+	if err := os.WriteFile(tmpFile, []byte(strconv.FormatInt(value, 10)), 0o644); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	if err := os.Rename(tmpFile, t.cfg.CheckpointFile); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	return nil
+}
+
+// LoadCheckpoint returns the value saved in a checkpoint file, 0 if path is empty or the file does not exist
+func LoadCheckpoint(path string) (int64, error) {
+	if len(path) == 0 {
+		return 0, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+
+	value, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid checkpoint %s: %w", path, err)
+	}
+	return value, nil
+}
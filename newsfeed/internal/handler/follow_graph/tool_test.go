@@ -0,0 +1,135 @@
+package follow_graph
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+type fakeUserService struct {
+	batches    [][]*model.Follow
+	missingIds map[int64]bool  // follows of these users are skipped by ImportFollows
+	follows    []*model.Follow // sorted by id, returned by ExportFollows
+}
+
+func (s *fakeUserService) ImportFollows(ctx context.Context, follows []*model.Follow) (int, int, error) {
+	s.batches = append(s.batches, append([]*model.Follow{}, follows...))
+	skipped := 0
+	for _, f := range follows {
+		if s.missingIds[f.Follower.ID] || s.missingIds[f.Following.ID] {
+			skipped++
+		}
+	}
+	return len(follows) - skipped, skipped, nil
+}
+
+func (s *fakeUserService) ExportFollows(ctx context.Context, userId, afterId int64, batchSize int) ([]*model.Follow, error) {
+	result := make([]*model.Follow, 0, batchSize)
+	for _, f := range s.follows {
+		if f.ID > afterId && len(result) < batchSize {
+			result = append(result, f)
+		}
+	}
+	return result, nil
+}
+
+func TestEdgeReader_Read(t *testing.T) {
+	t.Run("csv", func(t *testing.T) {
+		input := "follower_id,following_id,follow_timestamp\n1,2,100\n3,4\n5,5,100\nx,1\n"
+		reader, err := NewEdgeReader(strings.NewReader(input), FormatCSV)
+		assert.NoError(t, err)
+
+		f, err := reader.Read()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), f.Follower.ID)
+		assert.Equal(t, int64(2), f.Following.ID)
+		assert.Equal(t, int64(100), f.FollowTs)
+
+		f, err = reader.Read()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), f.FollowTs)
+
+		_, err = reader.Read() // self follow
+		assert.ErrorIs(t, err, ErrInvalidEdge)
+		_, err = reader.Read() // not a number
+		assert.ErrorIs(t, err, ErrInvalidEdge)
+		assert.Equal(t, int64(4), reader.Records())
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		input := "{\"follower_id\":1,\"following_id\":2}\n\n{bad\n"
+		reader, err := NewEdgeReader(strings.NewReader(input), FormatNDJSON)
+		assert.NoError(t, err)
+
+		f, err := reader.Read()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), f.Following.ID)
+
+		_, err = reader.Read()
+		assert.ErrorIs(t, err, ErrInvalidEdge)
+		_, err = reader.Read()
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, int64(2), reader.Records())
+	})
+}
+
+func TestTool_Import(t *testing.T) {
+	checkpointFile := filepath.Join(t.TempDir(), "import.ckpt")
+	// first 2 records were imported by a stopped run
+	assert.NoError(t, os.WriteFile(checkpointFile, []byte("2"), 0o644))
+
+	userService := &fakeUserService{missingIds: map[int64]bool{5: true}}
+	tool, err := New(Config{BatchSize: 2, CheckpointFile: checkpointFile}, userService)
+	assert.NoError(t, err)
+
+	reader, err := NewEdgeReader(strings.NewReader("1,2,10\n1,3,20\n1,4,30\n2,2,40\n1,5,50\n1,6\n"), FormatCSV)
+	assert.NoError(t, err)
+
+	stats, err := tool.Import(context.Background(), reader)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Stats{Records: 6, Invalid: 1, Skipped: 1, Imported: 2}, stats)
+	assert.Len(t, userService.batches, 2)
+	assert.Equal(t, int64(4), userService.batches[0][0].Following.ID)
+	assert.Equal(t, int64(5), userService.batches[0][1].Following.ID)
+	assert.NotZero(t, userService.batches[1][0].FollowTs) // defaults to now
+
+	checkpoint, err := LoadCheckpoint(checkpointFile)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), checkpoint)
+}
+
+func TestTool_Export(t *testing.T) {
+	checkpointFile := filepath.Join(t.TempDir(), "export.ckpt")
+	assert.NoError(t, os.WriteFile(checkpointFile, []byte("7"), 0o644))
+
+	userService := &fakeUserService{follows: []*model.Follow{
+		{ID: 7, Follower: &model.User{ID: 1}, Following: &model.User{ID: 2}, FollowTs: 10},
+		{ID: 8, Follower: &model.User{ID: 1}, Following: &model.User{ID: 3}, FollowTs: 20},
+		{ID: 9, Follower: &model.User{ID: 2}, Following: &model.User{ID: 3}, FollowTs: 30},
+	}}
+	tool, err := New(Config{BatchSize: 1, CheckpointFile: checkpointFile}, userService)
+	assert.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	writer, err := NewEdgeWriter(buf, FormatCSV, false)
+	assert.NoError(t, err)
+
+	stats, err := tool.Export(context.Background(), writer, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), stats.Records)
+	assert.Equal(t, "1,3,20\n2,3,30\n", buf.String())
+
+	checkpoint, err := LoadCheckpoint(checkpointFile)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), checkpoint)
+}
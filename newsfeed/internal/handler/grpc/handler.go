@@ -8,29 +8,76 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 
+	"ep.k16/newsfeed/internal/common"
 	grpc_pb "ep.k16/newsfeed/internal/handler/proto/grpc"
 	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
 	"ep.k16/newsfeed/pkg/logger"
 )
 
 type UserService interface {
 	Signup(ctx context.Context, user *model.User) (*model.User, error)
-	Login(ctx context.Context, user *model.User) (*model.User, error)
+	Login(ctx context.Context, user *model.User) (*model.Session, error)
+	RefreshSession(ctx context.Context, refreshToken string) (*model.Session, error)
+	Logout(ctx context.Context, userId int64, sessionId string) error
+	LogoutAll(ctx context.Context, userId int64) error
+	CheckSession(ctx context.Context, userId int64, sessionId string) (bool, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ConfirmPasswordReset(ctx context.Context, resetToken string, newPassword string) error
+	VerifyEmail(ctx context.Context, verificationToken string) (*model.User, error)
+	ResendEmailVerification(ctx context.Context, userId int64) error
+	CheckVerified(ctx context.Context, userId int64, action string) error
+	SetPrivate(ctx context.Context, userId int64, isPrivate bool) (*model.User, error)
+	GetProfile(ctx context.Context, viewerId, userId int64) (*model.User, error)
 
 	Follow(ctx context.Context, userId, peerId int64) (*model.Follow, error)
 	Unfollow(ctx context.Context, userId, peerId int64) error
-	GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error)
+	GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error)
+	GetFollowers(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error)
+
+	GetFollowRequests(ctx context.Context, userId int64, paging *model.Paging) ([]*model.FollowRequest, error)
+	ApproveFollowRequest(ctx context.Context, userId, requesterId int64) (*model.Follow, error)
+	RejectFollowRequest(ctx context.Context, userId, requesterId int64) error
+	CancelFollowRequest(ctx context.Context, userId, peerId int64) error
+
+	AddCloseFriend(ctx context.Context, userId, peerId int64) (*model.AudienceMember, error)
+	RemoveCloseFriend(ctx context.Context, userId, peerId int64) error
+	GetCloseFriends(ctx context.Context, userId int64, paging *model.Paging) ([]*model.AudienceMember, error)
+
+	Block(ctx context.Context, userId, peerId int64) error
+	Unblock(ctx context.Context, userId, peerId int64) error
+	Mute(ctx context.Context, userId, peerId int64) error
+	Unmute(ctx context.Context, userId, peerId int64) error
+
+	GetRelationships(ctx context.Context, viewerId int64, userIds []int64) ([]*model.Relationship, error)
 }
 
 type PostService interface {
+	UploadMedia(ctx context.Context, userId int64, data []byte) (*model.Media, error)
 	CreatePost(ctx context.Context, post *model.Post) (*model.Post, error)
-	GetPostByUserID(ctx context.Context, userId int, paging model.Paging) ([]*model.Post, error)
-	GetNewsfeed(ctx context.Context, userId int, paging model.Paging) ([]*model.Post, error)
+	UpdatePost(ctx context.Context, post *model.Post) (*model.Post, error)
+	ListPostRevisions(ctx context.Context, userId, postId int64, paging *model.Paging) ([]*model.PostRevision, error)
+	DeletePost(ctx context.Context, userId, postId int64) error
+	GetPostByUserID(ctx context.Context, viewerId, userId int64, paging *model.Paging) ([]*model.Post, error)
+	GetNewsfeed(ctx context.Context, userId int64, paging *model.Paging) ([]*model.RankedPost, *cursor.Cursor, error)
+	ReactPost(ctx context.Context, userId, postId int64, reactionType string) (*model.PostReactions, error)
+
+	CreateComment(ctx context.Context, comment *model.Comment) (*model.Comment, error)
+	ListComments(ctx context.Context, viewerId, postId, parentId int64, paging *model.Paging) ([]*model.Comment, error)
+	DeleteComment(ctx context.Context, userId, postId, commentId int64) error
+}
+
+type SuggestionService interface {
+	GetSuggestions(ctx context.Context, userId int64, limit int64) ([]*model.Suggestion, error)
 }
 
 type Config struct {
 	Host string
 	Port int
+
+	MaxRecvMsgSize int // max size in bytes of a request, 0 means grpc default
+
+	CursorSecret string // key signing pagination cursors, cursors are invalidated when it changes
 }
 
 type GrpcServer struct {
@@ -39,21 +86,32 @@ type GrpcServer struct {
 	grpcServer *grpc.Server
 }
 
-func New(cfg Config, userService UserService, postService PostService) (*GrpcServer, error) {
+func New(cfg Config, userService UserService, postService PostService, suggestionService SuggestionService) (*GrpcServer, error) {
 	s := &GrpcServer{
 		cfg: cfg,
 	}
 
+	cursorCodec, err := cursor.NewCodec(cfg.CursorSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init cursor codec: %w", err)
+	}
+
 	// init handler
 	userHandler := &userGrpcHandler{
-		userService: userService,
-		postService: postService,
+		userService:       userService,
+		postService:       postService,
+		suggestionService: suggestionService,
+		cursorCodec:       cursorCodec,
 	}
 
 	// register handler into grpc server
-	grpcServer := grpc.NewServer(
+	opts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(CustomizedInterceptor()),
-	)
+	}
+	if cfg.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize))
+	}
+	grpcServer := grpc.NewServer(opts...)
 	grpc_pb.RegisterServiceServer(grpcServer, userHandler)
 
 	s.grpcServer = grpcServer
@@ -88,8 +146,11 @@ func (s *GrpcServer) Stop() {
 type userGrpcHandler struct {
 	grpc_pb.UnimplementedServiceServer
 
-	userService UserService
-	postService PostService
+	userService       UserService
+	postService       PostService
+	suggestionService SuggestionService
+
+	cursorCodec *cursor.Codec
 }
 
 func (h *userGrpcHandler) Signup(ctx context.Context, req *grpc_pb.SignupRequest) (*grpc_pb.SignupResponse, error) {
@@ -112,7 +173,7 @@ func (h *userGrpcHandler) Signup(ctx context.Context, req *grpc_pb.SignupRequest
 }
 
 func (h *userGrpcHandler) Login(ctx context.Context, req *grpc_pb.LoginRequest) (*grpc_pb.LoginResponse, error) {
-	loginUser, err := h.userService.Login(ctx, &model.User{
+	session, err := h.userService.Login(ctx, &model.User{
 		Username: req.GetUserName(),
 		Password: req.GetPassword(),
 	})
@@ -121,18 +182,144 @@ func (h *userGrpcHandler) Login(ctx context.Context, req *grpc_pb.LoginRequest)
 	}
 
 	resp := &grpc_pb.LoginResponse{
-		User: toUserPb(loginUser),
+		User:    toUserPb(session.User),
+		Session: toSessionPb(session),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) RefreshSession(ctx context.Context, req *grpc_pb.RefreshSessionRequest) (*grpc_pb.RefreshSessionResponse, error) {
+	session, err := h.userService.RefreshSession(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.RefreshSessionResponse{
+		User:    toUserPb(session.User),
+		Session: toSessionPb(session),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) Logout(ctx context.Context, req *grpc_pb.LogoutRequest) (*grpc_pb.LogoutResponse, error) {
+	err := h.userService.Logout(ctx, req.GetUserId(), req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.LogoutResponse{
+		IsLoggedOut: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) LogoutAll(ctx context.Context, req *grpc_pb.LogoutAllRequest) (*grpc_pb.LogoutAllResponse, error) {
+	err := h.userService.LogoutAll(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.LogoutAllResponse{
+		IsLoggedOut: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) CheckSession(ctx context.Context, req *grpc_pb.CheckSessionRequest) (*grpc_pb.CheckSessionResponse, error) {
+	active, err := h.userService.CheckSession(ctx, req.GetUserId(), req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.CheckSessionResponse{
+		Active: proto.Bool(active),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) RequestPasswordReset(ctx context.Context, req *grpc_pb.RequestPasswordResetRequest) (*grpc_pb.RequestPasswordResetResponse, error) {
+	err := h.userService.RequestPasswordReset(ctx, req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.RequestPasswordResetResponse{
+		IsRequested: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) ConfirmPasswordReset(ctx context.Context, req *grpc_pb.ConfirmPasswordResetRequest) (*grpc_pb.ConfirmPasswordResetResponse, error) {
+	err := h.userService.ConfirmPasswordReset(ctx, req.GetToken(), req.GetNewPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.ConfirmPasswordResetResponse{
+		IsReset: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) VerifyEmail(ctx context.Context, req *grpc_pb.VerifyEmailRequest) (*grpc_pb.VerifyEmailResponse, error) {
+	user, err := h.userService.VerifyEmail(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.VerifyEmailResponse{
+		User: toUserPb(user),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) ResendEmailVerification(ctx context.Context, req *grpc_pb.ResendEmailVerificationRequest) (*grpc_pb.ResendEmailVerificationResponse, error) {
+	err := h.userService.ResendEmailVerification(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.ResendEmailVerificationResponse{
+		IsSent: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) SetPrivate(ctx context.Context, req *grpc_pb.SetPrivateRequest) (*grpc_pb.SetPrivateResponse, error) {
+	user, err := h.userService.SetPrivate(ctx, req.GetUserId(), req.GetIsPrivate())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.SetPrivateResponse{
+		User: toUserPb(user),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) GetProfile(ctx context.Context, req *grpc_pb.GetProfileRequest) (*grpc_pb.GetProfileResponse, error) {
+	user, err := h.userService.GetProfile(ctx, req.GetViewerId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.GetProfileResponse{
+		User: toUserPb(user),
 	}
 	return resp, nil
 }
 
 func (h *userGrpcHandler) Follow(ctx context.Context, req *grpc_pb.FollowRequest) (*grpc_pb.FollowResponse, error) {
+	if err := h.userService.CheckVerified(ctx, req.GetUserId(), model.ActionFollow); err != nil {
+		return nil, err
+	}
+
 	followData, err := h.userService.Follow(ctx, req.GetUserId(), req.GetPeerId())
 	if err != nil {
 		return nil, err
 	}
 	resp := &grpc_pb.FollowResponse{
-		IsFollowed: proto.Bool(true),
+		IsFollowed: proto.Bool(!followData.Pending),
 		Pair: &grpc_pb.UserUserData{
 			Id:          proto.Int64(followData.ID),
 			FollowerId:  proto.Int64(followData.Follower.ID),
@@ -155,16 +342,33 @@ func (h *userGrpcHandler) Unfollow(ctx context.Context, req *grpc_pb.UnfollowReq
 	return resp, nil
 }
 
-func (h *userGrpcHandler) GetFollowers(context.Context, *grpc_pb.GetFollowersRequest) (*grpc_pb.GetFollowersResponse, error) {
-	// TODO: implement
-	return nil, nil
+func (h *userGrpcHandler) GetFollowers(ctx context.Context, req *grpc_pb.GetFollowersRequest) (*grpc_pb.GetFollowersResponse, error) {
+	paging, err := h.toPaging(req.GetLimit(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	followers, next, err := h.userService.GetFollowers(ctx, req.GetUserId(), paging)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.GetFollowersResponse{}
+	for _, f := range followers {
+		resp.Followers = append(resp.Followers, toFollowPb(f))
+	}
+	// the page may be short of follows of removed users, so the service tells if there are more
+	if next != nil {
+		resp.NextCursor = h.encodeCursor(*next)
+	}
+	return resp, nil
 }
 
 func (h *userGrpcHandler) GetFollowings(ctx context.Context, req *grpc_pb.GetFollowingsRequest) (*grpc_pb.GetFollowingsResponse, error) {
-	followings, err := h.userService.GetFollowings(ctx, req.GetUserId(), &model.Paging{
-		LastValue: req.GetPaging().GetLastValue(),
-		Limit:     req.GetPaging().GetLimit(),
-	})
+	paging, err := h.toPaging(req.GetLimit(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	followings, next, err := h.userService.GetFollowings(ctx, req.GetUserId(), paging)
 	if err != nil {
 		return nil, err
 	}
@@ -173,6 +377,201 @@ func (h *userGrpcHandler) GetFollowings(ctx context.Context, req *grpc_pb.GetFol
 	for _, f := range followings {
 		resp.Followings = append(resp.Followings, toFollowPb(f))
 	}
+	// the page may be short of follows of removed users, so the service tells if there are more
+	if next != nil {
+		resp.NextCursor = h.encodeCursor(*next)
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) GetFollowRequests(ctx context.Context, req *grpc_pb.GetFollowRequestsRequest) (*grpc_pb.GetFollowRequestsResponse, error) {
+	paging, err := h.toPaging(req.GetLimit(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	requests, err := h.userService.GetFollowRequests(ctx, req.GetUserId(), paging)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.GetFollowRequestsResponse{}
+	for _, request := range requests {
+		resp.Requests = append(resp.Requests, &grpc_pb.FollowRequestData{
+			Id:               proto.Int64(request.ID),
+			Requester:        toUserPb(request.Requester),
+			CreatedTimestamp: proto.Int64(request.CreatedTimestamp),
+		})
+	}
+	if int64(len(requests)) == paging.Limit {
+		resp.NextCursor = h.encodeCursor(cursor.Cursor{ID: requests[len(requests)-1].ID})
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) ApproveFollowRequest(ctx context.Context, req *grpc_pb.ApproveFollowRequestRequest) (*grpc_pb.ApproveFollowRequestResponse, error) {
+	follow, err := h.userService.ApproveFollowRequest(ctx, req.GetUserId(), req.GetRequesterId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.ApproveFollowRequestResponse{
+		Follow: toFollowPb(follow),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) RejectFollowRequest(ctx context.Context, req *grpc_pb.RejectFollowRequestRequest) (*grpc_pb.RejectFollowRequestResponse, error) {
+	err := h.userService.RejectFollowRequest(ctx, req.GetUserId(), req.GetRequesterId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.RejectFollowRequestResponse{
+		IsRejected: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) CancelFollowRequest(ctx context.Context, req *grpc_pb.CancelFollowRequestRequest) (*grpc_pb.CancelFollowRequestResponse, error) {
+	err := h.userService.CancelFollowRequest(ctx, req.GetUserId(), req.GetPeerId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.CancelFollowRequestResponse{
+		IsCancelled: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) AddCloseFriend(ctx context.Context, req *grpc_pb.AddCloseFriendRequest) (*grpc_pb.AddCloseFriendResponse, error) {
+	member, err := h.userService.AddCloseFriend(ctx, req.GetUserId(), req.GetPeerId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.AddCloseFriendResponse{
+		Friend: toUserPb(member.Member),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) RemoveCloseFriend(ctx context.Context, req *grpc_pb.RemoveCloseFriendRequest) (*grpc_pb.RemoveCloseFriendResponse, error) {
+	err := h.userService.RemoveCloseFriend(ctx, req.GetUserId(), req.GetPeerId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.RemoveCloseFriendResponse{
+		IsRemoved: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) GetCloseFriends(ctx context.Context, req *grpc_pb.GetCloseFriendsRequest) (*grpc_pb.GetCloseFriendsResponse, error) {
+	paging, err := h.toPaging(req.GetLimit(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	members, err := h.userService.GetCloseFriends(ctx, req.GetUserId(), paging)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.GetCloseFriendsResponse{}
+	for _, member := range members {
+		resp.Friends = append(resp.Friends, toUserPb(member.Member))
+	}
+	if int64(len(members)) == paging.Limit {
+		resp.NextCursor = h.encodeCursor(cursor.Cursor{ID: members[len(members)-1].ID})
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) Block(ctx context.Context, req *grpc_pb.BlockRequest) (*grpc_pb.BlockResponse, error) {
+	err := h.userService.Block(ctx, req.GetUserId(), req.GetPeerId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.BlockResponse{
+		IsBlocked: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) Unblock(ctx context.Context, req *grpc_pb.UnblockRequest) (*grpc_pb.UnblockResponse, error) {
+	err := h.userService.Unblock(ctx, req.GetUserId(), req.GetPeerId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.UnblockResponse{
+		IsUnblocked: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) Mute(ctx context.Context, req *grpc_pb.MuteRequest) (*grpc_pb.MuteResponse, error) {
+	err := h.userService.Mute(ctx, req.GetUserId(), req.GetPeerId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.MuteResponse{
+		IsMuted: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) Unmute(ctx context.Context, req *grpc_pb.UnmuteRequest) (*grpc_pb.UnmuteResponse, error) {
+	err := h.userService.Unmute(ctx, req.GetUserId(), req.GetPeerId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.UnmuteResponse{
+		IsUnmuted: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) GetRelationships(ctx context.Context, req *grpc_pb.GetRelationshipsRequest) (*grpc_pb.GetRelationshipsResponse, error) {
+	relationships, err := h.userService.GetRelationships(ctx, req.GetViewerId(), req.GetUserIds())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.GetRelationshipsResponse{
+		Relationships: make([]*grpc_pb.RelationshipData, len(relationships)),
+	}
+	for i, relationship := range relationships {
+		resp.Relationships[i] = &grpc_pb.RelationshipData{
+			UserId:     proto.Int64(relationship.UserID),
+			Following:  proto.Bool(relationship.Following),
+			FollowedBy: proto.Bool(relationship.FollowedBy),
+			Blocking:   proto.Bool(relationship.Blocking),
+			BlockedBy:  proto.Bool(relationship.BlockedBy),
+		}
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) GetSuggestions(ctx context.Context, req *grpc_pb.GetSuggestionsRequest) (*grpc_pb.GetSuggestionsResponse, error) {
+	suggestions, err := h.suggestionService.GetSuggestions(ctx, req.GetUserId(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.GetSuggestionsResponse{}
+	for _, suggestion := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, &grpc_pb.SuggestionData{
+			User:             toUserPb(suggestion.User),
+			Score:            proto.Float64(suggestion.Score),
+			MutualFollowings: proto.Int64(suggestion.MutualFollowings),
+			MutualFollowers:  proto.Int64(suggestion.MutualFollowers),
+		})
+	}
 	return resp, nil
 }
 
@@ -180,12 +579,30 @@ func toUserPb(user *model.User) *grpc_pb.UserData {
 	if user == nil {
 		return nil
 	}
-	return &grpc_pb.UserData{
-		Id:          proto.Int64(user.ID),
-		UserName:    proto.String(user.Username),
-		DisplayName: proto.String(user.DisplayName),
-		Email:       proto.String(user.Email),
-		Dob:         proto.String(user.Dob),
+	userPb := &grpc_pb.UserData{
+		Id:            proto.Int64(user.ID),
+		UserName:      proto.String(user.Username),
+		DisplayName:   proto.String(user.DisplayName),
+		Email:         proto.String(user.Email),
+		Dob:           proto.String(user.Dob),
+		IsPrivate:     proto.Bool(user.IsPrivate),
+		EmailVerified: proto.Bool(user.EmailVerified),
+	}
+	if user.Counts != nil {
+		userPb.Counts = &grpc_pb.UserCountsData{
+			Followers:  proto.Int64(user.Counts.Followers),
+			Followings: proto.Int64(user.Counts.Followings),
+			Posts:      proto.Int64(user.Counts.Posts),
+		}
+	}
+	return userPb
+}
+
+func toSessionPb(session *model.Session) *grpc_pb.SessionData {
+	return &grpc_pb.SessionData{
+		Id:              proto.String(session.ID),
+		RefreshToken:    proto.String(session.RefreshToken),
+		RefreshExpireTs: proto.Int64(session.RefreshExpireTs),
 	}
 }
 
@@ -197,19 +614,286 @@ func toFollowPb(follow *model.Follow) *grpc_pb.FollowData {
 	}
 }
 
+func (h *userGrpcHandler) UploadMedia(ctx context.Context, req *grpc_pb.UploadMediaRequest) (*grpc_pb.UploadMediaResponse, error) {
+	if err := h.userService.CheckVerified(ctx, req.GetUserId(), model.ActionPost); err != nil {
+		return nil, err
+	}
+
+	media, err := h.postService.UploadMedia(ctx, req.GetUserId(), req.GetData())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.UploadMediaResponse{
+		Media: toMediaPb(media),
+	}
+	return resp, nil
+}
+
 func (h *userGrpcHandler) CreatePost(ctx context.Context, req *grpc_pb.CreatePostRequest) (*grpc_pb.CreatePostResponse, error) {
-	// TODO implement me
-	h.postService.CreatePost(ctx, nil) // test
+	if err := h.userService.CheckVerified(ctx, req.GetUserId(), model.ActionPost); err != nil {
+		return nil, err
+	}
+
+	createdPost, err := h.postService.CreatePost(ctx, &model.Post{
+		UserID:     req.GetUserId(),
+		Content:    req.GetContent(),
+		MediaIDs:   req.GetMediaIds(),
+		Visibility: req.GetVisibility(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.CreatePostResponse{
+		Post: toPostPb(createdPost),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) UpdatePost(ctx context.Context, req *grpc_pb.UpdatePostRequest) (*grpc_pb.UpdatePostResponse, error) {
+	updatedPost, err := h.postService.UpdatePost(ctx, &model.Post{
+		ID:      req.GetPostId(),
+		UserID:  req.GetUserId(),
+		Content: req.GetContent(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.UpdatePostResponse{
+		Post: toPostPb(updatedPost),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) ListPostRevisions(ctx context.Context, req *grpc_pb.ListPostRevisionsRequest) (*grpc_pb.ListPostRevisionsResponse, error) {
+	paging, err := h.toPaging(req.GetLimit(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := h.postService.ListPostRevisions(ctx, req.GetUserId(), req.GetPostId(), paging)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.ListPostRevisionsResponse{}
+	for _, r := range revisions {
+		resp.Revisions = append(resp.Revisions, &grpc_pb.PostRevisionData{
+			Id:               proto.Int64(r.ID),
+			PostId:           proto.Int64(r.PostID),
+			Content:          proto.String(r.Content),
+			CreatedTimestamp: proto.Int64(r.CreatedTimestamp),
+		})
+	}
+	if int64(len(revisions)) == paging.Limit {
+		resp.NextCursor = h.encodeCursor(cursor.Cursor{ID: revisions[len(revisions)-1].ID})
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) DeletePost(ctx context.Context, req *grpc_pb.DeletePostRequest) (*grpc_pb.DeletePostResponse, error) {
+	err := h.postService.DeletePost(ctx, req.GetUserId(), req.GetPostId())
+	if err != nil {
+		return nil, err
+	}
 
-	return nil, nil
+	resp := &grpc_pb.DeletePostResponse{
+		IsDeleted: proto.Bool(true),
+	}
+	return resp, nil
 }
 
 func (h *userGrpcHandler) GetPosts(ctx context.Context, req *grpc_pb.GetPostsRequest) (*grpc_pb.GetPostsResponse, error) {
-	// TODO implement me
-	panic("implement me")
+	paging, err := h.toPaging(req.GetLimit(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	posts, err := h.postService.GetPostByUserID(ctx, req.GetViewerId(), req.GetUserId(), paging)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.GetPostsResponse{}
+	for _, p := range posts {
+		resp.Posts = append(resp.Posts, toPostPb(p))
+	}
+	if int64(len(posts)) == paging.Limit {
+		resp.NextCursor = h.encodeCursor(cursor.Cursor{ID: posts[len(posts)-1].ID})
+	}
+	return resp, nil
 }
 
 func (h *userGrpcHandler) GetNewsfeed(ctx context.Context, req *grpc_pb.GetNewsfeedRequest) (*grpc_pb.GetNewsfeedResponse, error) {
-	// TODO implement me
-	panic("implement me")
+	paging, err := h.toPaging(req.GetLimit(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	paging.OrderBy = req.GetRanking()
+
+	posts, nextCursor, err := h.postService.GetNewsfeed(ctx, req.GetUserId(), paging)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.GetNewsfeedResponse{}
+	for _, p := range posts {
+		resp.Posts = append(resp.Posts, toPostPb(p.Post))
+		resp.RankingScores = append(resp.RankingScores, p.Score)
+	}
+	if nextCursor != nil {
+		resp.NextCursor = h.encodeCursor(*nextCursor)
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) ReactPost(ctx context.Context, req *grpc_pb.ReactPostRequest) (*grpc_pb.ReactPostResponse, error) {
+	if err := h.userService.CheckVerified(ctx, req.GetUserId(), model.ActionReact); err != nil {
+		return nil, err
+	}
+
+	reactions, err := h.postService.ReactPost(ctx, req.GetUserId(), req.GetPostId(), req.GetReactionType())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.ReactPostResponse{
+		Reactions: toReactionsPb(reactions),
+	}
+	return resp, nil
+}
+
+func toPostPb(post *model.Post) *grpc_pb.PostData {
+	if post == nil {
+		return nil
+	}
+	postPb := &grpc_pb.PostData{
+		Id:               proto.Int64(post.ID),
+		UserId:           proto.Int64(post.UserID),
+		Content:          proto.String(post.Content),
+		CreatedTimestamp: proto.Int64(post.CreatedTimestamp),
+		CommentCount:     proto.Int64(post.CommentCount),
+		Reactions:        toReactionsPb(post.Reactions),
+		EditedTimestamp:  proto.Int64(post.EditedTimestamp),
+		Visibility:       proto.String(post.Visibility),
+	}
+	for _, media := range post.Media {
+		postPb.Media = append(postPb.Media, toMediaPb(media))
+	}
+	return postPb
+}
+
+func toReactionsPb(reactions *model.PostReactions) *grpc_pb.ReactionsData {
+	if reactions == nil {
+		return nil
+	}
+	reactionsPb := &grpc_pb.ReactionsData{
+		Total:          proto.Int64(reactions.Total()),
+		ViewerReaction: proto.String(reactions.ViewerReaction),
+	}
+	for _, reactionType := range model.ReactionTypes {
+		if count := reactions.Counts[reactionType]; count > 0 {
+			reactionsPb.Counts = append(reactionsPb.Counts, &grpc_pb.ReactionCountData{
+				ReactionType: proto.String(reactionType),
+				Count:        proto.Int64(count),
+			})
+		}
+	}
+	return reactionsPb
+}
+
+func toMediaPb(media *model.Media) *grpc_pb.MediaData {
+	return &grpc_pb.MediaData{
+		Id:           proto.Int64(media.ID),
+		ContentType:  proto.String(media.ContentType),
+		Size:         proto.Int64(media.Size),
+		Width:        proto.Int32(int32(media.Width)),
+		Height:       proto.Int32(int32(media.Height)),
+		Url:          proto.String(media.URL),
+		ThumbnailUrl: proto.String(media.ThumbnailURL),
+	}
+}
+
+func (h *userGrpcHandler) CreateComment(ctx context.Context, req *grpc_pb.CreateCommentRequest) (*grpc_pb.CreateCommentResponse, error) {
+	if err := h.userService.CheckVerified(ctx, req.GetUserId(), model.ActionComment); err != nil {
+		return nil, err
+	}
+
+	createdComment, err := h.postService.CreateComment(ctx, &model.Comment{
+		PostID:   req.GetPostId(),
+		UserID:   req.GetUserId(),
+		ParentID: req.GetParentId(),
+		Content:  req.GetContent(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.CreateCommentResponse{
+		Comment: toCommentPb(createdComment),
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) ListComments(ctx context.Context, req *grpc_pb.ListCommentsRequest) (*grpc_pb.ListCommentsResponse, error) {
+	paging, err := h.toPaging(req.GetLimit(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	comments, err := h.postService.ListComments(ctx, req.GetUserId(), req.GetPostId(), req.GetParentId(), paging)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.ListCommentsResponse{}
+	for _, comment := range comments {
+		resp.Comments = append(resp.Comments, toCommentPb(comment))
+	}
+	if int64(len(comments)) == paging.Limit {
+		resp.NextCursor = h.encodeCursor(cursor.Cursor{ID: comments[len(comments)-1].ID})
+	}
+	return resp, nil
+}
+
+func (h *userGrpcHandler) DeleteComment(ctx context.Context, req *grpc_pb.DeleteCommentRequest) (*grpc_pb.DeleteCommentResponse, error) {
+	err := h.postService.DeleteComment(ctx, req.GetUserId(), req.GetPostId(), req.GetCommentId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &grpc_pb.DeleteCommentResponse{
+		IsDeleted: proto.Bool(true),
+	}
+	return resp, nil
+}
+
+func toCommentPb(comment *model.Comment) *grpc_pb.CommentData {
+	return &grpc_pb.CommentData{
+		Id:               proto.Int64(comment.ID),
+		PostId:           proto.Int64(comment.PostID),
+		UserId:           proto.Int64(comment.UserID),
+		ParentId:         proto.Int64(comment.ParentID),
+		Content:          proto.String(comment.Content),
+		ReplyCount:       proto.Int64(comment.ReplyCount),
+		CreatedTimestamp: proto.Int64(comment.CreatedTimestamp),
+	}
+}
+
+// toPaging decodes the cursor of a list request, an empty cursor means the first page
+func (h *userGrpcHandler) toPaging(limit int64, token string) (*model.Paging, error) {
+	paging := &model.Paging{Limit: limit}
+	if len(token) == 0 {
+		return paging, nil
+	}
+
+	cur, err := h.cursorCodec.Decode(token)
+	if err != nil {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid cursor")
+	}
+	paging.Cursor = &cur
+	return paging, nil
+}
+
+func (h *userGrpcHandler) encodeCursor(cur cursor.Cursor) *string {
+	return proto.String(h.cursorCodec.Encode(cur))
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,48 +39,2315 @@ func (_m *MockUserService) EXPECT() *MockUserService_Expecter {
 	return &MockUserService_Expecter{mock: &_m.Mock}
 }
 
-// Follow provides a mock function for the type MockUserService
-func (_mock *MockUserService) Follow(ctx context.Context, userId int64, peerId int64) (*model.Follow, error) {
+// AddCloseFriend provides a mock function for the type MockUserService
+func (_mock *MockUserService) AddCloseFriend(ctx context.Context, userId int64, peerId int64) (*model.AudienceMember, error) {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCloseFriend")
+	}
+
+	var r0 *model.AudienceMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*model.AudienceMember, error)); ok {
+		return returnFunc(ctx, userId, peerId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *model.AudienceMember); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AudienceMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, userId, peerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_AddCloseFriend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCloseFriend'
+type MockUserService_AddCloseFriend_Call struct {
+	*mock.Call
+}
+
+// AddCloseFriend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserService_Expecter) AddCloseFriend(ctx interface{}, userId interface{}, peerId interface{}) *MockUserService_AddCloseFriend_Call {
+	return &MockUserService_AddCloseFriend_Call{Call: _e.mock.On("AddCloseFriend", ctx, userId, peerId)}
+}
+
+func (_c *MockUserService_AddCloseFriend_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserService_AddCloseFriend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_AddCloseFriend_Call) Return(audienceMember *model.AudienceMember, err error) *MockUserService_AddCloseFriend_Call {
+	_c.Call.Return(audienceMember, err)
+	return _c
+}
+
+func (_c *MockUserService_AddCloseFriend_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) (*model.AudienceMember, error)) *MockUserService_AddCloseFriend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApproveFollowRequest provides a mock function for the type MockUserService
+func (_mock *MockUserService) ApproveFollowRequest(ctx context.Context, userId int64, requesterId int64) (*model.Follow, error) {
+	ret := _mock.Called(ctx, userId, requesterId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveFollowRequest")
+	}
+
+	var r0 *model.Follow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*model.Follow, error)); ok {
+		return returnFunc(ctx, userId, requesterId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *model.Follow); ok {
+		r0 = returnFunc(ctx, userId, requesterId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, userId, requesterId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_ApproveFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveFollowRequest'
+type MockUserService_ApproveFollowRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - requesterId int64
+func (_e *MockUserService_Expecter) ApproveFollowRequest(ctx interface{}, userId interface{}, requesterId interface{}) *MockUserService_ApproveFollowRequest_Call {
+	return &MockUserService_ApproveFollowRequest_Call{Call: _e.mock.On("ApproveFollowRequest", ctx, userId, requesterId)}
+}
+
+func (_c *MockUserService_ApproveFollowRequest_Call) Run(run func(ctx context.Context, userId int64, requesterId int64)) *MockUserService_ApproveFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_ApproveFollowRequest_Call) Return(follow *model.Follow, err error) *MockUserService_ApproveFollowRequest_Call {
+	_c.Call.Return(follow, err)
+	return _c
+}
+
+func (_c *MockUserService_ApproveFollowRequest_Call) RunAndReturn(run func(ctx context.Context, userId int64, requesterId int64) (*model.Follow, error)) *MockUserService_ApproveFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Block provides a mock function for the type MockUserService
+func (_mock *MockUserService) Block(ctx context.Context, userId int64, peerId int64) error {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type MockUserService_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserService_Expecter) Block(ctx interface{}, userId interface{}, peerId interface{}) *MockUserService_Block_Call {
+	return &MockUserService_Block_Call{Call: _e.mock.On("Block", ctx, userId, peerId)}
+}
+
+func (_c *MockUserService_Block_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserService_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_Block_Call) Return(err error) *MockUserService_Block_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_Block_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserService_Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelFollowRequest provides a mock function for the type MockUserService
+func (_mock *MockUserService) CancelFollowRequest(ctx context.Context, userId int64, peerId int64) error {
 	ret := _mock.Called(ctx, userId, peerId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Follow")
+		panic("no return value specified for CancelFollowRequest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_CancelFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelFollowRequest'
+type MockUserService_CancelFollowRequest_Call struct {
+	*mock.Call
+}
+
+// CancelFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserService_Expecter) CancelFollowRequest(ctx interface{}, userId interface{}, peerId interface{}) *MockUserService_CancelFollowRequest_Call {
+	return &MockUserService_CancelFollowRequest_Call{Call: _e.mock.On("CancelFollowRequest", ctx, userId, peerId)}
+}
+
+func (_c *MockUserService_CancelFollowRequest_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserService_CancelFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_CancelFollowRequest_Call) Return(err error) *MockUserService_CancelFollowRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_CancelFollowRequest_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserService_CancelFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckSession provides a mock function for the type MockUserService
+func (_mock *MockUserService) CheckSession(ctx context.Context, userId int64, sessionId string) (bool, error) {
+	ret := _mock.Called(ctx, userId, sessionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckSession")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) (bool, error)); ok {
+		return returnFunc(ctx, userId, sessionId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) bool); ok {
+		r0 = returnFunc(ctx, userId, sessionId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = returnFunc(ctx, userId, sessionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_CheckSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckSession'
+type MockUserService_CheckSession_Call struct {
+	*mock.Call
+}
+
+// CheckSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - sessionId string
+func (_e *MockUserService_Expecter) CheckSession(ctx interface{}, userId interface{}, sessionId interface{}) *MockUserService_CheckSession_Call {
+	return &MockUserService_CheckSession_Call{Call: _e.mock.On("CheckSession", ctx, userId, sessionId)}
+}
+
+func (_c *MockUserService_CheckSession_Call) Run(run func(ctx context.Context, userId int64, sessionId string)) *MockUserService_CheckSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_CheckSession_Call) Return(b bool, err error) *MockUserService_CheckSession_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserService_CheckSession_Call) RunAndReturn(run func(ctx context.Context, userId int64, sessionId string) (bool, error)) *MockUserService_CheckSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckVerified provides a mock function for the type MockUserService
+func (_mock *MockUserService) CheckVerified(ctx context.Context, userId int64, action string) error {
+	ret := _mock.Called(ctx, userId, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckVerified")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = returnFunc(ctx, userId, action)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_CheckVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckVerified'
+type MockUserService_CheckVerified_Call struct {
+	*mock.Call
+}
+
+// CheckVerified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - action string
+func (_e *MockUserService_Expecter) CheckVerified(ctx interface{}, userId interface{}, action interface{}) *MockUserService_CheckVerified_Call {
+	return &MockUserService_CheckVerified_Call{Call: _e.mock.On("CheckVerified", ctx, userId, action)}
+}
+
+func (_c *MockUserService_CheckVerified_Call) Run(run func(ctx context.Context, userId int64, action string)) *MockUserService_CheckVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_CheckVerified_Call) Return(err error) *MockUserService_CheckVerified_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_CheckVerified_Call) RunAndReturn(run func(ctx context.Context, userId int64, action string) error) *MockUserService_CheckVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmPasswordReset provides a mock function for the type MockUserService
+func (_mock *MockUserService) ConfirmPasswordReset(ctx context.Context, resetToken string, newPassword string) error {
+	ret := _mock.Called(ctx, resetToken, newPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmPasswordReset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, resetToken, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_ConfirmPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmPasswordReset'
+type MockUserService_ConfirmPasswordReset_Call struct {
+	*mock.Call
+}
+
+// ConfirmPasswordReset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resetToken string
+//   - newPassword string
+func (_e *MockUserService_Expecter) ConfirmPasswordReset(ctx interface{}, resetToken interface{}, newPassword interface{}) *MockUserService_ConfirmPasswordReset_Call {
+	return &MockUserService_ConfirmPasswordReset_Call{Call: _e.mock.On("ConfirmPasswordReset", ctx, resetToken, newPassword)}
+}
+
+func (_c *MockUserService_ConfirmPasswordReset_Call) Run(run func(ctx context.Context, resetToken string, newPassword string)) *MockUserService_ConfirmPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_ConfirmPasswordReset_Call) Return(err error) *MockUserService_ConfirmPasswordReset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_ConfirmPasswordReset_Call) RunAndReturn(run func(ctx context.Context, resetToken string, newPassword string) error) *MockUserService_ConfirmPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Follow provides a mock function for the type MockUserService
+func (_mock *MockUserService) Follow(ctx context.Context, userId int64, peerId int64) (*model.Follow, error) {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 *model.Follow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*model.Follow, error)); ok {
+		return returnFunc(ctx, userId, peerId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *model.Follow); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, userId, peerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockUserService_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserService_Expecter) Follow(ctx interface{}, userId interface{}, peerId interface{}) *MockUserService_Follow_Call {
+	return &MockUserService_Follow_Call{Call: _e.mock.On("Follow", ctx, userId, peerId)}
+}
+
+func (_c *MockUserService_Follow_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserService_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_Follow_Call) Return(follow *model.Follow, err error) *MockUserService_Follow_Call {
+	_c.Call.Return(follow, err)
+	return _c
+}
+
+func (_c *MockUserService_Follow_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) (*model.Follow, error)) *MockUserService_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCloseFriends provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetCloseFriends(ctx context.Context, userId int64, paging *model.Paging) ([]*model.AudienceMember, error) {
+	ret := _mock.Called(ctx, userId, paging)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCloseFriends")
+	}
+
+	var r0 []*model.AudienceMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.AudienceMember, error)); ok {
+		return returnFunc(ctx, userId, paging)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.AudienceMember); ok {
+		r0 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AudienceMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) error); ok {
+		r1 = returnFunc(ctx, userId, paging)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_GetCloseFriends_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCloseFriends'
+type MockUserService_GetCloseFriends_Call struct {
+	*mock.Call
+}
+
+// GetCloseFriends is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - paging *model.Paging
+func (_e *MockUserService_Expecter) GetCloseFriends(ctx interface{}, userId interface{}, paging interface{}) *MockUserService_GetCloseFriends_Call {
+	return &MockUserService_GetCloseFriends_Call{Call: _e.mock.On("GetCloseFriends", ctx, userId, paging)}
+}
+
+func (_c *MockUserService_GetCloseFriends_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockUserService_GetCloseFriends_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *model.Paging
+		if args[2] != nil {
+			arg2 = args[2].(*model.Paging)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetCloseFriends_Call) Return(audienceMembers []*model.AudienceMember, err error) *MockUserService_GetCloseFriends_Call {
+	_c.Call.Return(audienceMembers, err)
+	return _c
+}
+
+func (_c *MockUserService_GetCloseFriends_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.AudienceMember, error)) *MockUserService_GetCloseFriends_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowRequests provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetFollowRequests(ctx context.Context, userId int64, paging *model.Paging) ([]*model.FollowRequest, error) {
+	ret := _mock.Called(ctx, userId, paging)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowRequests")
+	}
+
+	var r0 []*model.FollowRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.FollowRequest, error)); ok {
+		return returnFunc(ctx, userId, paging)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.FollowRequest); ok {
+		r0 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FollowRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) error); ok {
+		r1 = returnFunc(ctx, userId, paging)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_GetFollowRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowRequests'
+type MockUserService_GetFollowRequests_Call struct {
+	*mock.Call
+}
+
+// GetFollowRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - paging *model.Paging
+func (_e *MockUserService_Expecter) GetFollowRequests(ctx interface{}, userId interface{}, paging interface{}) *MockUserService_GetFollowRequests_Call {
+	return &MockUserService_GetFollowRequests_Call{Call: _e.mock.On("GetFollowRequests", ctx, userId, paging)}
+}
+
+func (_c *MockUserService_GetFollowRequests_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockUserService_GetFollowRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *model.Paging
+		if args[2] != nil {
+			arg2 = args[2].(*model.Paging)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetFollowRequests_Call) Return(followRequests []*model.FollowRequest, err error) *MockUserService_GetFollowRequests_Call {
+	_c.Call.Return(followRequests, err)
+	return _c
+}
+
+func (_c *MockUserService_GetFollowRequests_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.FollowRequest, error)) *MockUserService_GetFollowRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetFollowers(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error) {
+	ret := _mock.Called(ctx, userId, paging)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 []*model.Follow
+	var r1 *cursor.Cursor
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.Follow, *cursor.Cursor, error)); ok {
+		return returnFunc(ctx, userId, paging)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.Follow); ok {
+		r0 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) *cursor.Cursor); ok {
+		r1 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cursor.Cursor)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, *model.Paging) error); ok {
+		r2 = returnFunc(ctx, userId, paging)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserService_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type MockUserService_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - paging *model.Paging
+func (_e *MockUserService_Expecter) GetFollowers(ctx interface{}, userId interface{}, paging interface{}) *MockUserService_GetFollowers_Call {
+	return &MockUserService_GetFollowers_Call{Call: _e.mock.On("GetFollowers", ctx, userId, paging)}
+}
+
+func (_c *MockUserService_GetFollowers_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockUserService_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *model.Paging
+		if args[2] != nil {
+			arg2 = args[2].(*model.Paging)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetFollowers_Call) Return(follows []*model.Follow, cursor *cursor.Cursor, err error) *MockUserService_GetFollowers_Call {
+	_c.Call.Return(follows, cursor, err)
+	return _c
+}
+
+func (_c *MockUserService_GetFollowers_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error)) *MockUserService_GetFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowings provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error) {
+	ret := _mock.Called(ctx, userId, paging)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowings")
+	}
+
+	var r0 []*model.Follow
+	var r1 *cursor.Cursor
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.Follow, *cursor.Cursor, error)); ok {
+		return returnFunc(ctx, userId, paging)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.Follow); ok {
+		r0 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) *cursor.Cursor); ok {
+		r1 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cursor.Cursor)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, *model.Paging) error); ok {
+		r2 = returnFunc(ctx, userId, paging)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserService_GetFollowings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowings'
+type MockUserService_GetFollowings_Call struct {
+	*mock.Call
+}
+
+// GetFollowings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - paging *model.Paging
+func (_e *MockUserService_Expecter) GetFollowings(ctx interface{}, userId interface{}, paging interface{}) *MockUserService_GetFollowings_Call {
+	return &MockUserService_GetFollowings_Call{Call: _e.mock.On("GetFollowings", ctx, userId, paging)}
+}
+
+func (_c *MockUserService_GetFollowings_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockUserService_GetFollowings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *model.Paging
+		if args[2] != nil {
+			arg2 = args[2].(*model.Paging)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetFollowings_Call) Return(follows []*model.Follow, cursor *cursor.Cursor, err error) *MockUserService_GetFollowings_Call {
+	_c.Call.Return(follows, cursor, err)
+	return _c
+}
+
+func (_c *MockUserService_GetFollowings_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error)) *MockUserService_GetFollowings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfile provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetProfile(ctx context.Context, viewerId int64, userId int64) (*model.User, error) {
+	ret := _mock.Called(ctx, viewerId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfile")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*model.User, error)); ok {
+		return returnFunc(ctx, viewerId, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *model.User); ok {
+		r0 = returnFunc(ctx, viewerId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, viewerId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
+type MockUserService_GetProfile_Call struct {
+	*mock.Call
+}
+
+// GetProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewerId int64
+//   - userId int64
+func (_e *MockUserService_Expecter) GetProfile(ctx interface{}, viewerId interface{}, userId interface{}) *MockUserService_GetProfile_Call {
+	return &MockUserService_GetProfile_Call{Call: _e.mock.On("GetProfile", ctx, viewerId, userId)}
+}
+
+func (_c *MockUserService_GetProfile_Call) Run(run func(ctx context.Context, viewerId int64, userId int64)) *MockUserService_GetProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetProfile_Call) Return(user *model.User, err error) *MockUserService_GetProfile_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserService_GetProfile_Call) RunAndReturn(run func(ctx context.Context, viewerId int64, userId int64) (*model.User, error)) *MockUserService_GetProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRelationships provides a mock function for the type MockUserService
+func (_mock *MockUserService) GetRelationships(ctx context.Context, viewerId int64, userIds []int64) ([]*model.Relationship, error) {
+	ret := _mock.Called(ctx, viewerId, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRelationships")
+	}
+
+	var r0 []*model.Relationship
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, []int64) ([]*model.Relationship, error)); ok {
+		return returnFunc(ctx, viewerId, userIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, []int64) []*model.Relationship); ok {
+		r0 = returnFunc(ctx, viewerId, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Relationship)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = returnFunc(ctx, viewerId, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_GetRelationships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRelationships'
+type MockUserService_GetRelationships_Call struct {
+	*mock.Call
+}
+
+// GetRelationships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewerId int64
+//   - userIds []int64
+func (_e *MockUserService_Expecter) GetRelationships(ctx interface{}, viewerId interface{}, userIds interface{}) *MockUserService_GetRelationships_Call {
+	return &MockUserService_GetRelationships_Call{Call: _e.mock.On("GetRelationships", ctx, viewerId, userIds)}
+}
+
+func (_c *MockUserService_GetRelationships_Call) Run(run func(ctx context.Context, viewerId int64, userIds []int64)) *MockUserService_GetRelationships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 []int64
+		if args[2] != nil {
+			arg2 = args[2].([]int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetRelationships_Call) Return(relationships []*model.Relationship, err error) *MockUserService_GetRelationships_Call {
+	_c.Call.Return(relationships, err)
+	return _c
+}
+
+func (_c *MockUserService_GetRelationships_Call) RunAndReturn(run func(ctx context.Context, viewerId int64, userIds []int64) ([]*model.Relationship, error)) *MockUserService_GetRelationships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function for the type MockUserService
+func (_mock *MockUserService) Login(ctx context.Context, user *model.User) (*model.Session, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *model.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User) (*model.Session, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User) *model.Session); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockUserService_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.User
+func (_e *MockUserService_Expecter) Login(ctx interface{}, user interface{}) *MockUserService_Login_Call {
+	return &MockUserService_Login_Call{Call: _e.mock.On("Login", ctx, user)}
+}
+
+func (_c *MockUserService_Login_Call) Run(run func(ctx context.Context, user *model.User)) *MockUserService_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.User
+		if args[1] != nil {
+			arg1 = args[1].(*model.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_Login_Call) Return(session *model.Session, err error) *MockUserService_Login_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *MockUserService_Login_Call) RunAndReturn(run func(ctx context.Context, user *model.User) (*model.Session, error)) *MockUserService_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function for the type MockUserService
+func (_mock *MockUserService) Logout(ctx context.Context, userId int64, sessionId string) error {
+	ret := _mock.Called(ctx, userId, sessionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = returnFunc(ctx, userId, sessionId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockUserService_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - sessionId string
+func (_e *MockUserService_Expecter) Logout(ctx interface{}, userId interface{}, sessionId interface{}) *MockUserService_Logout_Call {
+	return &MockUserService_Logout_Call{Call: _e.mock.On("Logout", ctx, userId, sessionId)}
+}
+
+func (_c *MockUserService_Logout_Call) Run(run func(ctx context.Context, userId int64, sessionId string)) *MockUserService_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_Logout_Call) Return(err error) *MockUserService_Logout_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_Logout_Call) RunAndReturn(run func(ctx context.Context, userId int64, sessionId string) error) *MockUserService_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogoutAll provides a mock function for the type MockUserService
+func (_mock *MockUserService) LogoutAll(ctx context.Context, userId int64) error {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogoutAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_LogoutAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogoutAll'
+type MockUserService_LogoutAll_Call struct {
+	*mock.Call
+}
+
+// LogoutAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockUserService_Expecter) LogoutAll(ctx interface{}, userId interface{}) *MockUserService_LogoutAll_Call {
+	return &MockUserService_LogoutAll_Call{Call: _e.mock.On("LogoutAll", ctx, userId)}
+}
+
+func (_c *MockUserService_LogoutAll_Call) Run(run func(ctx context.Context, userId int64)) *MockUserService_LogoutAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_LogoutAll_Call) Return(err error) *MockUserService_LogoutAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_LogoutAll_Call) RunAndReturn(run func(ctx context.Context, userId int64) error) *MockUserService_LogoutAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mute provides a mock function for the type MockUserService
+func (_mock *MockUserService) Mute(ctx context.Context, userId int64, peerId int64) error {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_Mute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mute'
+type MockUserService_Mute_Call struct {
+	*mock.Call
+}
+
+// Mute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserService_Expecter) Mute(ctx interface{}, userId interface{}, peerId interface{}) *MockUserService_Mute_Call {
+	return &MockUserService_Mute_Call{Call: _e.mock.On("Mute", ctx, userId, peerId)}
+}
+
+func (_c *MockUserService_Mute_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserService_Mute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_Mute_Call) Return(err error) *MockUserService_Mute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_Mute_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserService_Mute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshSession provides a mock function for the type MockUserService
+func (_mock *MockUserService) RefreshSession(ctx context.Context, refreshToken string) (*model.Session, error) {
+	ret := _mock.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshSession")
+	}
+
+	var r0 *model.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.Session, error)); ok {
+		return returnFunc(ctx, refreshToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.Session); ok {
+		r0 = returnFunc(ctx, refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_RefreshSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshSession'
+type MockUserService_RefreshSession_Call struct {
+	*mock.Call
+}
+
+// RefreshSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+func (_e *MockUserService_Expecter) RefreshSession(ctx interface{}, refreshToken interface{}) *MockUserService_RefreshSession_Call {
+	return &MockUserService_RefreshSession_Call{Call: _e.mock.On("RefreshSession", ctx, refreshToken)}
+}
+
+func (_c *MockUserService_RefreshSession_Call) Run(run func(ctx context.Context, refreshToken string)) *MockUserService_RefreshSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_RefreshSession_Call) Return(session *model.Session, err error) *MockUserService_RefreshSession_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *MockUserService_RefreshSession_Call) RunAndReturn(run func(ctx context.Context, refreshToken string) (*model.Session, error)) *MockUserService_RefreshSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectFollowRequest provides a mock function for the type MockUserService
+func (_mock *MockUserService) RejectFollowRequest(ctx context.Context, userId int64, requesterId int64) error {
+	ret := _mock.Called(ctx, userId, requesterId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectFollowRequest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, requesterId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_RejectFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectFollowRequest'
+type MockUserService_RejectFollowRequest_Call struct {
+	*mock.Call
+}
+
+// RejectFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - requesterId int64
+func (_e *MockUserService_Expecter) RejectFollowRequest(ctx interface{}, userId interface{}, requesterId interface{}) *MockUserService_RejectFollowRequest_Call {
+	return &MockUserService_RejectFollowRequest_Call{Call: _e.mock.On("RejectFollowRequest", ctx, userId, requesterId)}
+}
+
+func (_c *MockUserService_RejectFollowRequest_Call) Run(run func(ctx context.Context, userId int64, requesterId int64)) *MockUserService_RejectFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_RejectFollowRequest_Call) Return(err error) *MockUserService_RejectFollowRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_RejectFollowRequest_Call) RunAndReturn(run func(ctx context.Context, userId int64, requesterId int64) error) *MockUserService_RejectFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCloseFriend provides a mock function for the type MockUserService
+func (_mock *MockUserService) RemoveCloseFriend(ctx context.Context, userId int64, peerId int64) error {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCloseFriend")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_RemoveCloseFriend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCloseFriend'
+type MockUserService_RemoveCloseFriend_Call struct {
+	*mock.Call
+}
+
+// RemoveCloseFriend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserService_Expecter) RemoveCloseFriend(ctx interface{}, userId interface{}, peerId interface{}) *MockUserService_RemoveCloseFriend_Call {
+	return &MockUserService_RemoveCloseFriend_Call{Call: _e.mock.On("RemoveCloseFriend", ctx, userId, peerId)}
+}
+
+func (_c *MockUserService_RemoveCloseFriend_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserService_RemoveCloseFriend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_RemoveCloseFriend_Call) Return(err error) *MockUserService_RemoveCloseFriend_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_RemoveCloseFriend_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserService_RemoveCloseFriend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestPasswordReset provides a mock function for the type MockUserService
+func (_mock *MockUserService) RequestPasswordReset(ctx context.Context, email string) error {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestPasswordReset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_RequestPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestPasswordReset'
+type MockUserService_RequestPasswordReset_Call struct {
+	*mock.Call
+}
+
+// RequestPasswordReset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockUserService_Expecter) RequestPasswordReset(ctx interface{}, email interface{}) *MockUserService_RequestPasswordReset_Call {
+	return &MockUserService_RequestPasswordReset_Call{Call: _e.mock.On("RequestPasswordReset", ctx, email)}
+}
+
+func (_c *MockUserService_RequestPasswordReset_Call) Run(run func(ctx context.Context, email string)) *MockUserService_RequestPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_RequestPasswordReset_Call) Return(err error) *MockUserService_RequestPasswordReset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_RequestPasswordReset_Call) RunAndReturn(run func(ctx context.Context, email string) error) *MockUserService_RequestPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResendEmailVerification provides a mock function for the type MockUserService
+func (_mock *MockUserService) ResendEmailVerification(ctx context.Context, userId int64) error {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendEmailVerification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_ResendEmailVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResendEmailVerification'
+type MockUserService_ResendEmailVerification_Call struct {
+	*mock.Call
+}
+
+// ResendEmailVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockUserService_Expecter) ResendEmailVerification(ctx interface{}, userId interface{}) *MockUserService_ResendEmailVerification_Call {
+	return &MockUserService_ResendEmailVerification_Call{Call: _e.mock.On("ResendEmailVerification", ctx, userId)}
+}
+
+func (_c *MockUserService_ResendEmailVerification_Call) Run(run func(ctx context.Context, userId int64)) *MockUserService_ResendEmailVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_ResendEmailVerification_Call) Return(err error) *MockUserService_ResendEmailVerification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_ResendEmailVerification_Call) RunAndReturn(run func(ctx context.Context, userId int64) error) *MockUserService_ResendEmailVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPrivate provides a mock function for the type MockUserService
+func (_mock *MockUserService) SetPrivate(ctx context.Context, userId int64, isPrivate bool) (*model.User, error) {
+	ret := _mock.Called(ctx, userId, isPrivate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPrivate")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, bool) (*model.User, error)); ok {
+		return returnFunc(ctx, userId, isPrivate)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, bool) *model.User); ok {
+		r0 = returnFunc(ctx, userId, isPrivate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, bool) error); ok {
+		r1 = returnFunc(ctx, userId, isPrivate)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_SetPrivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPrivate'
+type MockUserService_SetPrivate_Call struct {
+	*mock.Call
+}
+
+// SetPrivate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - isPrivate bool
+func (_e *MockUserService_Expecter) SetPrivate(ctx interface{}, userId interface{}, isPrivate interface{}) *MockUserService_SetPrivate_Call {
+	return &MockUserService_SetPrivate_Call{Call: _e.mock.On("SetPrivate", ctx, userId, isPrivate)}
+}
+
+func (_c *MockUserService_SetPrivate_Call) Run(run func(ctx context.Context, userId int64, isPrivate bool)) *MockUserService_SetPrivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_SetPrivate_Call) Return(user *model.User, err error) *MockUserService_SetPrivate_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserService_SetPrivate_Call) RunAndReturn(run func(ctx context.Context, userId int64, isPrivate bool) (*model.User, error)) *MockUserService_SetPrivate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Signup provides a mock function for the type MockUserService
+func (_mock *MockUserService) Signup(ctx context.Context, user *model.User) (*model.User, error) {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Signup")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User) (*model.User, error)); ok {
+		return returnFunc(ctx, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User) *model.User); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.User) error); ok {
+		r1 = returnFunc(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_Signup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Signup'
+type MockUserService_Signup_Call struct {
+	*mock.Call
+}
+
+// Signup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.User
+func (_e *MockUserService_Expecter) Signup(ctx interface{}, user interface{}) *MockUserService_Signup_Call {
+	return &MockUserService_Signup_Call{Call: _e.mock.On("Signup", ctx, user)}
+}
+
+func (_c *MockUserService_Signup_Call) Run(run func(ctx context.Context, user *model.User)) *MockUserService_Signup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.User
+		if args[1] != nil {
+			arg1 = args[1].(*model.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_Signup_Call) Return(user1 *model.User, err error) *MockUserService_Signup_Call {
+	_c.Call.Return(user1, err)
+	return _c
+}
+
+func (_c *MockUserService_Signup_Call) RunAndReturn(run func(ctx context.Context, user *model.User) (*model.User, error)) *MockUserService_Signup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unblock provides a mock function for the type MockUserService
+func (_mock *MockUserService) Unblock(ctx context.Context, userId int64, peerId int64) error {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unblock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_Unblock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unblock'
+type MockUserService_Unblock_Call struct {
+	*mock.Call
+}
+
+// Unblock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserService_Expecter) Unblock(ctx interface{}, userId interface{}, peerId interface{}) *MockUserService_Unblock_Call {
+	return &MockUserService_Unblock_Call{Call: _e.mock.On("Unblock", ctx, userId, peerId)}
+}
+
+func (_c *MockUserService_Unblock_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserService_Unblock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_Unblock_Call) Return(err error) *MockUserService_Unblock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_Unblock_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserService_Unblock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unfollow provides a mock function for the type MockUserService
+func (_mock *MockUserService) Unfollow(ctx context.Context, userId int64, peerId int64) error {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockUserService_Unfollow_Call struct {
+	*mock.Call
+}
+
+// Unfollow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserService_Expecter) Unfollow(ctx interface{}, userId interface{}, peerId interface{}) *MockUserService_Unfollow_Call {
+	return &MockUserService_Unfollow_Call{Call: _e.mock.On("Unfollow", ctx, userId, peerId)}
+}
+
+func (_c *MockUserService_Unfollow_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserService_Unfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_Unfollow_Call) Return(err error) *MockUserService_Unfollow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_Unfollow_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserService_Unfollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unmute provides a mock function for the type MockUserService
+func (_mock *MockUserService) Unmute(ctx context.Context, userId int64, peerId int64) error {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserService_Unmute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmute'
+type MockUserService_Unmute_Call struct {
+	*mock.Call
+}
+
+// Unmute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserService_Expecter) Unmute(ctx interface{}, userId interface{}, peerId interface{}) *MockUserService_Unmute_Call {
+	return &MockUserService_Unmute_Call{Call: _e.mock.On("Unmute", ctx, userId, peerId)}
+}
+
+func (_c *MockUserService_Unmute_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserService_Unmute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_Unmute_Call) Return(err error) *MockUserService_Unmute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserService_Unmute_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserService_Unmute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyEmail provides a mock function for the type MockUserService
+func (_mock *MockUserService) VerifyEmail(ctx context.Context, verificationToken string) (*model.User, error) {
+	ret := _mock.Called(ctx, verificationToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmail")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.User, error)); ok {
+		return returnFunc(ctx, verificationToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.User); ok {
+		r0 = returnFunc(ctx, verificationToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, verificationToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserService_VerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmail'
+type MockUserService_VerifyEmail_Call struct {
+	*mock.Call
+}
+
+// VerifyEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - verificationToken string
+func (_e *MockUserService_Expecter) VerifyEmail(ctx interface{}, verificationToken interface{}) *MockUserService_VerifyEmail_Call {
+	return &MockUserService_VerifyEmail_Call{Call: _e.mock.On("VerifyEmail", ctx, verificationToken)}
+}
+
+func (_c *MockUserService_VerifyEmail_Call) Run(run func(ctx context.Context, verificationToken string)) *MockUserService_VerifyEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserService_VerifyEmail_Call) Return(user *model.User, err error) *MockUserService_VerifyEmail_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserService_VerifyEmail_Call) RunAndReturn(run func(ctx context.Context, verificationToken string) (*model.User, error)) *MockUserService_VerifyEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPostService creates a new instance of MockPostService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPostService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPostService {
+	mock := &MockPostService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPostService is an autogenerated mock type for the PostService type
+type MockPostService struct {
+	mock.Mock
+}
+
+type MockPostService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPostService) EXPECT() *MockPostService_Expecter {
+	return &MockPostService_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function for the type MockPostService
+func (_mock *MockPostService) CreateComment(ctx context.Context, comment *model.Comment) (*model.Comment, error) {
+	ret := _mock.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 *model.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Comment) (*model.Comment, error)); ok {
+		return returnFunc(ctx, comment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Comment) *model.Comment); ok {
+		r0 = returnFunc(ctx, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Comment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Comment) error); ok {
+		r1 = returnFunc(ctx, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostService_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type MockPostService_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment *model.Comment
+func (_e *MockPostService_Expecter) CreateComment(ctx interface{}, comment interface{}) *MockPostService_CreateComment_Call {
+	return &MockPostService_CreateComment_Call{Call: _e.mock.On("CreateComment", ctx, comment)}
+}
+
+func (_c *MockPostService_CreateComment_Call) Run(run func(ctx context.Context, comment *model.Comment)) *MockPostService_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Comment
+		if args[1] != nil {
+			arg1 = args[1].(*model.Comment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostService_CreateComment_Call) Return(comment1 *model.Comment, err error) *MockPostService_CreateComment_Call {
+	_c.Call.Return(comment1, err)
+	return _c
+}
+
+func (_c *MockPostService_CreateComment_Call) RunAndReturn(run func(ctx context.Context, comment *model.Comment) (*model.Comment, error)) *MockPostService_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePost provides a mock function for the type MockPostService
+func (_mock *MockPostService) CreatePost(ctx context.Context, post *model.Post) (*model.Post, error) {
+	ret := _mock.Called(ctx, post)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePost")
+	}
+
+	var r0 *model.Post
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Post) (*model.Post, error)); ok {
+		return returnFunc(ctx, post)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Post) *model.Post); ok {
+		r0 = returnFunc(ctx, post)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Post)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Post) error); ok {
+		r1 = returnFunc(ctx, post)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPostService_CreatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePost'
+type MockPostService_CreatePost_Call struct {
+	*mock.Call
+}
+
+// CreatePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - post *model.Post
+func (_e *MockPostService_Expecter) CreatePost(ctx interface{}, post interface{}) *MockPostService_CreatePost_Call {
+	return &MockPostService_CreatePost_Call{Call: _e.mock.On("CreatePost", ctx, post)}
+}
+
+func (_c *MockPostService_CreatePost_Call) Run(run func(ctx context.Context, post *model.Post)) *MockPostService_CreatePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Post
+		if args[1] != nil {
+			arg1 = args[1].(*model.Post)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostService_CreatePost_Call) Return(post1 *model.Post, err error) *MockPostService_CreatePost_Call {
+	_c.Call.Return(post1, err)
+	return _c
+}
+
+func (_c *MockPostService_CreatePost_Call) RunAndReturn(run func(ctx context.Context, post *model.Post) (*model.Post, error)) *MockPostService_CreatePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function for the type MockPostService
+func (_mock *MockPostService) DeleteComment(ctx context.Context, userId int64, postId int64, commentId int64) error {
+	ret := _mock.Called(ctx, userId, postId, commentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, postId, commentId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostService_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type MockPostService_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - postId int64
+//   - commentId int64
+func (_e *MockPostService_Expecter) DeleteComment(ctx interface{}, userId interface{}, postId interface{}, commentId interface{}) *MockPostService_DeleteComment_Call {
+	return &MockPostService_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, userId, postId, commentId)}
+}
+
+func (_c *MockPostService_DeleteComment_Call) Run(run func(ctx context.Context, userId int64, postId int64, commentId int64)) *MockPostService_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostService_DeleteComment_Call) Return(err error) *MockPostService_DeleteComment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostService_DeleteComment_Call) RunAndReturn(run func(ctx context.Context, userId int64, postId int64, commentId int64) error) *MockPostService_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePost provides a mock function for the type MockPostService
+func (_mock *MockPostService) DeletePost(ctx context.Context, userId int64, postId int64) error {
+	ret := _mock.Called(ctx, userId, postId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePost")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, postId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPostService_DeletePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePost'
+type MockPostService_DeletePost_Call struct {
+	*mock.Call
+}
+
+// DeletePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - postId int64
+func (_e *MockPostService_Expecter) DeletePost(ctx interface{}, userId interface{}, postId interface{}) *MockPostService_DeletePost_Call {
+	return &MockPostService_DeletePost_Call{Call: _e.mock.On("DeletePost", ctx, userId, postId)}
+}
+
+func (_c *MockPostService_DeletePost_Call) Run(run func(ctx context.Context, userId int64, postId int64)) *MockPostService_DeletePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPostService_DeletePost_Call) Return(err error) *MockPostService_DeletePost_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPostService_DeletePost_Call) RunAndReturn(run func(ctx context.Context, userId int64, postId int64) error) *MockPostService_DeletePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNewsfeed provides a mock function for the type MockPostService
+func (_mock *MockPostService) GetNewsfeed(ctx context.Context, userId int64, paging *model.Paging) ([]*model.RankedPost, *cursor.Cursor, error) {
+	ret := _mock.Called(ctx, userId, paging)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNewsfeed")
 	}
 
-	var r0 *model.Follow
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*model.Follow, error)); ok {
-		return returnFunc(ctx, userId, peerId)
+	var r0 []*model.RankedPost
+	var r1 *cursor.Cursor
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.RankedPost, *cursor.Cursor, error)); ok {
+		return returnFunc(ctx, userId, paging)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *model.Follow); ok {
-		r0 = returnFunc(ctx, userId, peerId)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.RankedPost); ok {
+		r0 = returnFunc(ctx, userId, paging)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Follow)
+			r0 = ret.Get(0).([]*model.RankedPost)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
-		r1 = returnFunc(ctx, userId, peerId)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) *cursor.Cursor); ok {
+		r1 = returnFunc(ctx, userId, paging)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cursor.Cursor)
+		}
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, *model.Paging) error); ok {
+		r2 = returnFunc(ctx, userId, paging)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockUserService_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
-type MockUserService_Follow_Call struct {
+// MockPostService_GetNewsfeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNewsfeed'
+type MockPostService_GetNewsfeed_Call struct {
 	*mock.Call
 }
 
-// Follow is a helper method to define mock.On call
+// GetNewsfeed is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userId int64
-//   - peerId int64
-func (_e *MockUserService_Expecter) Follow(ctx interface{}, userId interface{}, peerId interface{}) *MockUserService_Follow_Call {
-	return &MockUserService_Follow_Call{Call: _e.mock.On("Follow", ctx, userId, peerId)}
+//   - paging *model.Paging
+func (_e *MockPostService_Expecter) GetNewsfeed(ctx interface{}, userId interface{}, paging interface{}) *MockPostService_GetNewsfeed_Call {
+	return &MockPostService_GetNewsfeed_Call{Call: _e.mock.On("GetNewsfeed", ctx, userId, paging)}
 }
 
-func (_c *MockUserService_Follow_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserService_Follow_Call {
+func (_c *MockPostService_GetNewsfeed_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockPostService_GetNewsfeed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -89,9 +2357,9 @@ func (_c *MockUserService_Follow_Call) Run(run func(ctx context.Context, userId
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
-		var arg2 int64
+		var arg2 *model.Paging
 		if args[2] != nil {
-			arg2 = args[2].(int64)
+			arg2 = args[2].(*model.Paging)
 		}
 		run(
 			arg0,
@@ -102,58 +2370,59 @@ func (_c *MockUserService_Follow_Call) Run(run func(ctx context.Context, userId
 	return _c
 }
 
-func (_c *MockUserService_Follow_Call) Return(follow *model.Follow, err error) *MockUserService_Follow_Call {
-	_c.Call.Return(follow, err)
+func (_c *MockPostService_GetNewsfeed_Call) Return(rankedPosts []*model.RankedPost, cursor *cursor.Cursor, err error) *MockPostService_GetNewsfeed_Call {
+	_c.Call.Return(rankedPosts, cursor, err)
 	return _c
 }
 
-func (_c *MockUserService_Follow_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) (*model.Follow, error)) *MockUserService_Follow_Call {
+func (_c *MockPostService_GetNewsfeed_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.RankedPost, *cursor.Cursor, error)) *MockPostService_GetNewsfeed_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFollowings provides a mock function for the type MockUserService
-func (_mock *MockUserService) GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error) {
-	ret := _mock.Called(ctx, userId, paging)
+// GetPostByUserID provides a mock function for the type MockPostService
+func (_mock *MockPostService) GetPostByUserID(ctx context.Context, viewerId int64, userId int64, paging *model.Paging) ([]*model.Post, error) {
+	ret := _mock.Called(ctx, viewerId, userId, paging)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFollowings")
+		panic("no return value specified for GetPostByUserID")
 	}
 
-	var r0 []*model.Follow
+	var r0 []*model.Post
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.Follow, error)); ok {
-		return returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, *model.Paging) ([]*model.Post, error)); ok {
+		return returnFunc(ctx, viewerId, userId, paging)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.Follow); ok {
-		r0 = returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, *model.Paging) []*model.Post); ok {
+		r0 = returnFunc(ctx, viewerId, userId, paging)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Follow)
+			r0 = ret.Get(0).([]*model.Post)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) error); ok {
-		r1 = returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, *model.Paging) error); ok {
+		r1 = returnFunc(ctx, viewerId, userId, paging)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserService_GetFollowings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowings'
-type MockUserService_GetFollowings_Call struct {
+// MockPostService_GetPostByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostByUserID'
+type MockPostService_GetPostByUserID_Call struct {
 	*mock.Call
 }
 
-// GetFollowings is a helper method to define mock.On call
+// GetPostByUserID is a helper method to define mock.On call
 //   - ctx context.Context
+//   - viewerId int64
 //   - userId int64
 //   - paging *model.Paging
-func (_e *MockUserService_Expecter) GetFollowings(ctx interface{}, userId interface{}, paging interface{}) *MockUserService_GetFollowings_Call {
-	return &MockUserService_GetFollowings_Call{Call: _e.mock.On("GetFollowings", ctx, userId, paging)}
+func (_e *MockPostService_Expecter) GetPostByUserID(ctx interface{}, viewerId interface{}, userId interface{}, paging interface{}) *MockPostService_GetPostByUserID_Call {
+	return &MockPostService_GetPostByUserID_Call{Call: _e.mock.On("GetPostByUserID", ctx, viewerId, userId, paging)}
 }
 
-func (_c *MockUserService_GetFollowings_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockUserService_GetFollowings_Call {
+func (_c *MockPostService_GetPostByUserID_Call) Run(run func(ctx context.Context, viewerId int64, userId int64, paging *model.Paging)) *MockPostService_GetPostByUserID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -163,196 +2432,243 @@ func (_c *MockUserService_GetFollowings_Call) Run(run func(ctx context.Context,
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
-		var arg2 *model.Paging
+		var arg2 int64
 		if args[2] != nil {
-			arg2 = args[2].(*model.Paging)
+			arg2 = args[2].(int64)
+		}
+		var arg3 *model.Paging
+		if args[3] != nil {
+			arg3 = args[3].(*model.Paging)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserService_GetFollowings_Call) Return(follows []*model.Follow, err error) *MockUserService_GetFollowings_Call {
-	_c.Call.Return(follows, err)
+func (_c *MockPostService_GetPostByUserID_Call) Return(posts []*model.Post, err error) *MockPostService_GetPostByUserID_Call {
+	_c.Call.Return(posts, err)
 	return _c
 }
 
-func (_c *MockUserService_GetFollowings_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error)) *MockUserService_GetFollowings_Call {
+func (_c *MockPostService_GetPostByUserID_Call) RunAndReturn(run func(ctx context.Context, viewerId int64, userId int64, paging *model.Paging) ([]*model.Post, error)) *MockPostService_GetPostByUserID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Login provides a mock function for the type MockUserService
-func (_mock *MockUserService) Login(ctx context.Context, user *model.User) (*model.User, error) {
-	ret := _mock.Called(ctx, user)
+// ListComments provides a mock function for the type MockPostService
+func (_mock *MockPostService) ListComments(ctx context.Context, viewerId int64, postId int64, parentId int64, paging *model.Paging) ([]*model.Comment, error) {
+	ret := _mock.Called(ctx, viewerId, postId, parentId, paging)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for ListComments")
 	}
 
-	var r0 *model.User
+	var r0 []*model.Comment
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User) (*model.User, error)); ok {
-		return returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, int64, *model.Paging) ([]*model.Comment, error)); ok {
+		return returnFunc(ctx, viewerId, postId, parentId, paging)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User) *model.User); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, int64, *model.Paging) []*model.Comment); ok {
+		r0 = returnFunc(ctx, viewerId, postId, parentId, paging)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.User)
+			r0 = ret.Get(0).([]*model.Comment)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.User) error); ok {
-		r1 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, int64, *model.Paging) error); ok {
+		r1 = returnFunc(ctx, viewerId, postId, parentId, paging)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserService_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type MockUserService_Login_Call struct {
+// MockPostService_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type MockPostService_ListComments_Call struct {
 	*mock.Call
 }
 
-// Login is a helper method to define mock.On call
+// ListComments is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *model.User
-func (_e *MockUserService_Expecter) Login(ctx interface{}, user interface{}) *MockUserService_Login_Call {
-	return &MockUserService_Login_Call{Call: _e.mock.On("Login", ctx, user)}
+//   - viewerId int64
+//   - postId int64
+//   - parentId int64
+//   - paging *model.Paging
+func (_e *MockPostService_Expecter) ListComments(ctx interface{}, viewerId interface{}, postId interface{}, parentId interface{}, paging interface{}) *MockPostService_ListComments_Call {
+	return &MockPostService_ListComments_Call{Call: _e.mock.On("ListComments", ctx, viewerId, postId, parentId, paging)}
 }
 
-func (_c *MockUserService_Login_Call) Run(run func(ctx context.Context, user *model.User)) *MockUserService_Login_Call {
+func (_c *MockPostService_ListComments_Call) Run(run func(ctx context.Context, viewerId int64, postId int64, parentId int64, paging *model.Paging)) *MockPostService_ListComments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *model.User
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*model.User)
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		var arg4 *model.Paging
+		if args[4] != nil {
+			arg4 = args[4].(*model.Paging)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserService_Login_Call) Return(user1 *model.User, err error) *MockUserService_Login_Call {
-	_c.Call.Return(user1, err)
+func (_c *MockPostService_ListComments_Call) Return(comments []*model.Comment, err error) *MockPostService_ListComments_Call {
+	_c.Call.Return(comments, err)
 	return _c
 }
 
-func (_c *MockUserService_Login_Call) RunAndReturn(run func(ctx context.Context, user *model.User) (*model.User, error)) *MockUserService_Login_Call {
+func (_c *MockPostService_ListComments_Call) RunAndReturn(run func(ctx context.Context, viewerId int64, postId int64, parentId int64, paging *model.Paging) ([]*model.Comment, error)) *MockPostService_ListComments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Signup provides a mock function for the type MockUserService
-func (_mock *MockUserService) Signup(ctx context.Context, user *model.User) (*model.User, error) {
-	ret := _mock.Called(ctx, user)
+// ListPostRevisions provides a mock function for the type MockPostService
+func (_mock *MockPostService) ListPostRevisions(ctx context.Context, userId int64, postId int64, paging *model.Paging) ([]*model.PostRevision, error) {
+	ret := _mock.Called(ctx, userId, postId, paging)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Signup")
+		panic("no return value specified for ListPostRevisions")
 	}
 
-	var r0 *model.User
+	var r0 []*model.PostRevision
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User) (*model.User, error)); ok {
-		return returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, *model.Paging) ([]*model.PostRevision, error)); ok {
+		return returnFunc(ctx, userId, postId, paging)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User) *model.User); ok {
-		r0 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, *model.Paging) []*model.PostRevision); ok {
+		r0 = returnFunc(ctx, userId, postId, paging)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.User)
+			r0 = ret.Get(0).([]*model.PostRevision)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.User) error); ok {
-		r1 = returnFunc(ctx, user)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, *model.Paging) error); ok {
+		r1 = returnFunc(ctx, userId, postId, paging)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserService_Signup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Signup'
-type MockUserService_Signup_Call struct {
+// MockPostService_ListPostRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPostRevisions'
+type MockPostService_ListPostRevisions_Call struct {
 	*mock.Call
 }
 
-// Signup is a helper method to define mock.On call
+// ListPostRevisions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *model.User
-func (_e *MockUserService_Expecter) Signup(ctx interface{}, user interface{}) *MockUserService_Signup_Call {
-	return &MockUserService_Signup_Call{Call: _e.mock.On("Signup", ctx, user)}
+//   - userId int64
+//   - postId int64
+//   - paging *model.Paging
+func (_e *MockPostService_Expecter) ListPostRevisions(ctx interface{}, userId interface{}, postId interface{}, paging interface{}) *MockPostService_ListPostRevisions_Call {
+	return &MockPostService_ListPostRevisions_Call{Call: _e.mock.On("ListPostRevisions", ctx, userId, postId, paging)}
 }
 
-func (_c *MockUserService_Signup_Call) Run(run func(ctx context.Context, user *model.User)) *MockUserService_Signup_Call {
+func (_c *MockPostService_ListPostRevisions_Call) Run(run func(ctx context.Context, userId int64, postId int64, paging *model.Paging)) *MockPostService_ListPostRevisions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *model.User
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*model.User)
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 *model.Paging
+		if args[3] != nil {
+			arg3 = args[3].(*model.Paging)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserService_Signup_Call) Return(user1 *model.User, err error) *MockUserService_Signup_Call {
-	_c.Call.Return(user1, err)
+func (_c *MockPostService_ListPostRevisions_Call) Return(postRevisions []*model.PostRevision, err error) *MockPostService_ListPostRevisions_Call {
+	_c.Call.Return(postRevisions, err)
 	return _c
 }
 
-func (_c *MockUserService_Signup_Call) RunAndReturn(run func(ctx context.Context, user *model.User) (*model.User, error)) *MockUserService_Signup_Call {
+func (_c *MockPostService_ListPostRevisions_Call) RunAndReturn(run func(ctx context.Context, userId int64, postId int64, paging *model.Paging) ([]*model.PostRevision, error)) *MockPostService_ListPostRevisions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Unfollow provides a mock function for the type MockUserService
-func (_mock *MockUserService) Unfollow(ctx context.Context, userId int64, peerId int64) error {
-	ret := _mock.Called(ctx, userId, peerId)
+// ReactPost provides a mock function for the type MockPostService
+func (_mock *MockPostService) ReactPost(ctx context.Context, userId int64, postId int64, reactionType string) (*model.PostReactions, error) {
+	ret := _mock.Called(ctx, userId, postId, reactionType)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Unfollow")
+		panic("no return value specified for ReactPost")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
-		r0 = returnFunc(ctx, userId, peerId)
+	var r0 *model.PostReactions
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, string) (*model.PostReactions, error)); ok {
+		return returnFunc(ctx, userId, postId, reactionType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, string) *model.PostReactions); ok {
+		r0 = returnFunc(ctx, userId, postId, reactionType)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostReactions)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, string) error); ok {
+		r1 = returnFunc(ctx, userId, postId, reactionType)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockUserService_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
-type MockUserService_Unfollow_Call struct {
+// MockPostService_ReactPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReactPost'
+type MockPostService_ReactPost_Call struct {
 	*mock.Call
 }
 
-// Unfollow is a helper method to define mock.On call
+// ReactPost is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userId int64
-//   - peerId int64
-func (_e *MockUserService_Expecter) Unfollow(ctx interface{}, userId interface{}, peerId interface{}) *MockUserService_Unfollow_Call {
-	return &MockUserService_Unfollow_Call{Call: _e.mock.On("Unfollow", ctx, userId, peerId)}
+//   - postId int64
+//   - reactionType string
+func (_e *MockPostService_Expecter) ReactPost(ctx interface{}, userId interface{}, postId interface{}, reactionType interface{}) *MockPostService_ReactPost_Call {
+	return &MockPostService_ReactPost_Call{Call: _e.mock.On("ReactPost", ctx, userId, postId, reactionType)}
 }
 
-func (_c *MockUserService_Unfollow_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserService_Unfollow_Call {
+func (_c *MockPostService_ReactPost_Call) Run(run func(ctx context.Context, userId int64, postId int64, reactionType string)) *MockPostService_ReactPost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -366,58 +2682,36 @@ func (_c *MockUserService_Unfollow_Call) Run(run func(ctx context.Context, userI
 		if args[2] != nil {
 			arg2 = args[2].(int64)
 		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserService_Unfollow_Call) Return(err error) *MockUserService_Unfollow_Call {
-	_c.Call.Return(err)
+func (_c *MockPostService_ReactPost_Call) Return(postReactions *model.PostReactions, err error) *MockPostService_ReactPost_Call {
+	_c.Call.Return(postReactions, err)
 	return _c
 }
 
-func (_c *MockUserService_Unfollow_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserService_Unfollow_Call {
+func (_c *MockPostService_ReactPost_Call) RunAndReturn(run func(ctx context.Context, userId int64, postId int64, reactionType string) (*model.PostReactions, error)) *MockPostService_ReactPost_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockPostService creates a new instance of MockPostService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPostService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPostService {
-	mock := &MockPostService{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockPostService is an autogenerated mock type for the PostService type
-type MockPostService struct {
-	mock.Mock
-}
-
-type MockPostService_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockPostService) EXPECT() *MockPostService_Expecter {
-	return &MockPostService_Expecter{mock: &_m.Mock}
-}
-
-// CreatePost provides a mock function for the type MockPostService
-func (_mock *MockPostService) CreatePost(ctx context.Context, post *model.Post) (*model.Post, error) {
+// UpdatePost provides a mock function for the type MockPostService
+func (_mock *MockPostService) UpdatePost(ctx context.Context, post *model.Post) (*model.Post, error) {
 	ret := _mock.Called(ctx, post)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePost")
+		panic("no return value specified for UpdatePost")
 	}
 
 	var r0 *model.Post
@@ -440,19 +2734,19 @@ func (_mock *MockPostService) CreatePost(ctx context.Context, post *model.Post)
 	return r0, r1
 }
 
-// MockPostService_CreatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePost'
-type MockPostService_CreatePost_Call struct {
+// MockPostService_UpdatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePost'
+type MockPostService_UpdatePost_Call struct {
 	*mock.Call
 }
 
-// CreatePost is a helper method to define mock.On call
+// UpdatePost is a helper method to define mock.On call
 //   - ctx context.Context
 //   - post *model.Post
-func (_e *MockPostService_Expecter) CreatePost(ctx interface{}, post interface{}) *MockPostService_CreatePost_Call {
-	return &MockPostService_CreatePost_Call{Call: _e.mock.On("CreatePost", ctx, post)}
+func (_e *MockPostService_Expecter) UpdatePost(ctx interface{}, post interface{}) *MockPostService_UpdatePost_Call {
+	return &MockPostService_UpdatePost_Call{Call: _e.mock.On("UpdatePost", ctx, post)}
 }
 
-func (_c *MockPostService_CreatePost_Call) Run(run func(ctx context.Context, post *model.Post)) *MockPostService_CreatePost_Call {
+func (_c *MockPostService_UpdatePost_Call) Run(run func(ctx context.Context, post *model.Post)) *MockPostService_UpdatePost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -470,70 +2764,70 @@ func (_c *MockPostService_CreatePost_Call) Run(run func(ctx context.Context, pos
 	return _c
 }
 
-func (_c *MockPostService_CreatePost_Call) Return(post1 *model.Post, err error) *MockPostService_CreatePost_Call {
+func (_c *MockPostService_UpdatePost_Call) Return(post1 *model.Post, err error) *MockPostService_UpdatePost_Call {
 	_c.Call.Return(post1, err)
 	return _c
 }
 
-func (_c *MockPostService_CreatePost_Call) RunAndReturn(run func(ctx context.Context, post *model.Post) (*model.Post, error)) *MockPostService_CreatePost_Call {
+func (_c *MockPostService_UpdatePost_Call) RunAndReturn(run func(ctx context.Context, post *model.Post) (*model.Post, error)) *MockPostService_UpdatePost_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetNewsfeed provides a mock function for the type MockPostService
-func (_mock *MockPostService) GetNewsfeed(ctx context.Context, userId int, paging model.Paging) ([]*model.Post, error) {
-	ret := _mock.Called(ctx, userId, paging)
+// UploadMedia provides a mock function for the type MockPostService
+func (_mock *MockPostService) UploadMedia(ctx context.Context, userId int64, data []byte) (*model.Media, error) {
+	ret := _mock.Called(ctx, userId, data)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetNewsfeed")
+		panic("no return value specified for UploadMedia")
 	}
 
-	var r0 []*model.Post
+	var r0 *model.Media
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, model.Paging) ([]*model.Post, error)); ok {
-		return returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, []byte) (*model.Media, error)); ok {
+		return returnFunc(ctx, userId, data)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, model.Paging) []*model.Post); ok {
-		r0 = returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, []byte) *model.Media); ok {
+		r0 = returnFunc(ctx, userId, data)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Post)
+			r0 = ret.Get(0).(*model.Media)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, model.Paging) error); ok {
-		r1 = returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, []byte) error); ok {
+		r1 = returnFunc(ctx, userId, data)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPostService_GetNewsfeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNewsfeed'
-type MockPostService_GetNewsfeed_Call struct {
+// MockPostService_UploadMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadMedia'
+type MockPostService_UploadMedia_Call struct {
 	*mock.Call
 }
 
-// GetNewsfeed is a helper method to define mock.On call
+// UploadMedia is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId int
-//   - paging model.Paging
-func (_e *MockPostService_Expecter) GetNewsfeed(ctx interface{}, userId interface{}, paging interface{}) *MockPostService_GetNewsfeed_Call {
-	return &MockPostService_GetNewsfeed_Call{Call: _e.mock.On("GetNewsfeed", ctx, userId, paging)}
+//   - userId int64
+//   - data []byte
+func (_e *MockPostService_Expecter) UploadMedia(ctx interface{}, userId interface{}, data interface{}) *MockPostService_UploadMedia_Call {
+	return &MockPostService_UploadMedia_Call{Call: _e.mock.On("UploadMedia", ctx, userId, data)}
 }
 
-func (_c *MockPostService_GetNewsfeed_Call) Run(run func(ctx context.Context, userId int, paging model.Paging)) *MockPostService_GetNewsfeed_Call {
+func (_c *MockPostService_UploadMedia_Call) Run(run func(ctx context.Context, userId int64, data []byte)) *MockPostService_UploadMedia_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(int64)
 		}
-		var arg2 model.Paging
+		var arg2 []byte
 		if args[2] != nil {
-			arg2 = args[2].(model.Paging)
+			arg2 = args[2].([]byte)
 		}
 		run(
 			arg0,
@@ -544,70 +2838,97 @@ func (_c *MockPostService_GetNewsfeed_Call) Run(run func(ctx context.Context, us
 	return _c
 }
 
-func (_c *MockPostService_GetNewsfeed_Call) Return(posts []*model.Post, err error) *MockPostService_GetNewsfeed_Call {
-	_c.Call.Return(posts, err)
+func (_c *MockPostService_UploadMedia_Call) Return(media *model.Media, err error) *MockPostService_UploadMedia_Call {
+	_c.Call.Return(media, err)
 	return _c
 }
 
-func (_c *MockPostService_GetNewsfeed_Call) RunAndReturn(run func(ctx context.Context, userId int, paging model.Paging) ([]*model.Post, error)) *MockPostService_GetNewsfeed_Call {
+func (_c *MockPostService_UploadMedia_Call) RunAndReturn(run func(ctx context.Context, userId int64, data []byte) (*model.Media, error)) *MockPostService_UploadMedia_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPostByUserID provides a mock function for the type MockPostService
-func (_mock *MockPostService) GetPostByUserID(ctx context.Context, userId int, paging model.Paging) ([]*model.Post, error) {
-	ret := _mock.Called(ctx, userId, paging)
+// NewMockSuggestionService creates a new instance of MockSuggestionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSuggestionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSuggestionService {
+	mock := &MockSuggestionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSuggestionService is an autogenerated mock type for the SuggestionService type
+type MockSuggestionService struct {
+	mock.Mock
+}
+
+type MockSuggestionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSuggestionService) EXPECT() *MockSuggestionService_Expecter {
+	return &MockSuggestionService_Expecter{mock: &_m.Mock}
+}
+
+// GetSuggestions provides a mock function for the type MockSuggestionService
+func (_mock *MockSuggestionService) GetSuggestions(ctx context.Context, userId int64, limit int64) ([]*model.Suggestion, error) {
+	ret := _mock.Called(ctx, userId, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPostByUserID")
+		panic("no return value specified for GetSuggestions")
 	}
 
-	var r0 []*model.Post
+	var r0 []*model.Suggestion
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, model.Paging) ([]*model.Post, error)); ok {
-		return returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) ([]*model.Suggestion, error)); ok {
+		return returnFunc(ctx, userId, limit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, model.Paging) []*model.Post); ok {
-		r0 = returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) []*model.Suggestion); ok {
+		r0 = returnFunc(ctx, userId, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Post)
+			r0 = ret.Get(0).([]*model.Suggestion)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, model.Paging) error); ok {
-		r1 = returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, userId, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPostService_GetPostByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPostByUserID'
-type MockPostService_GetPostByUserID_Call struct {
+// MockSuggestionService_GetSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSuggestions'
+type MockSuggestionService_GetSuggestions_Call struct {
 	*mock.Call
 }
 
-// GetPostByUserID is a helper method to define mock.On call
+// GetSuggestions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId int
-//   - paging model.Paging
-func (_e *MockPostService_Expecter) GetPostByUserID(ctx interface{}, userId interface{}, paging interface{}) *MockPostService_GetPostByUserID_Call {
-	return &MockPostService_GetPostByUserID_Call{Call: _e.mock.On("GetPostByUserID", ctx, userId, paging)}
+//   - userId int64
+//   - limit int64
+func (_e *MockSuggestionService_Expecter) GetSuggestions(ctx interface{}, userId interface{}, limit interface{}) *MockSuggestionService_GetSuggestions_Call {
+	return &MockSuggestionService_GetSuggestions_Call{Call: _e.mock.On("GetSuggestions", ctx, userId, limit)}
 }
 
-func (_c *MockPostService_GetPostByUserID_Call) Run(run func(ctx context.Context, userId int, paging model.Paging)) *MockPostService_GetPostByUserID_Call {
+func (_c *MockSuggestionService_GetSuggestions_Call) Run(run func(ctx context.Context, userId int64, limit int64)) *MockSuggestionService_GetSuggestions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(int64)
 		}
-		var arg2 model.Paging
+		var arg2 int64
 		if args[2] != nil {
-			arg2 = args[2].(model.Paging)
+			arg2 = args[2].(int64)
 		}
 		run(
 			arg0,
@@ -618,12 +2939,12 @@ func (_c *MockPostService_GetPostByUserID_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockPostService_GetPostByUserID_Call) Return(posts []*model.Post, err error) *MockPostService_GetPostByUserID_Call {
-	_c.Call.Return(posts, err)
+func (_c *MockSuggestionService_GetSuggestions_Call) Return(suggestions []*model.Suggestion, err error) *MockSuggestionService_GetSuggestions_Call {
+	_c.Call.Return(suggestions, err)
 	return _c
 }
 
-func (_c *MockPostService_GetPostByUserID_Call) RunAndReturn(run func(ctx context.Context, userId int, paging model.Paging) ([]*model.Post, error)) *MockPostService_GetPostByUserID_Call {
+func (_c *MockSuggestionService_GetSuggestions_Call) RunAndReturn(run func(ctx context.Context, userId int64, limit int64) ([]*model.Suggestion, error)) *MockSuggestionService_GetSuggestions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2,14 +2,17 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/protobuf/proto"
 
+	"ep.k16/newsfeed/internal/common"
 	user_pb "ep.k16/newsfeed/internal/handler/proto/grpc"
 	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
 )
 
 func TestUserGrpcHandler_Signup(t *testing.T) {
@@ -51,3 +54,119 @@ func TestUserGrpcHandler_Signup(t *testing.T) {
 		assert.Equal(t, expectedUser.Dob, resp.User.GetDob())
 	})
 }
+
+func TestUserGrpcHandler_CreatePost(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mockUserService := new(MockUserService)
+		mockUserService.On("CheckVerified", mock.Anything, int64(1), model.ActionPost).Return(nil)
+		mockPostService := new(MockPostService)
+		handler := &userGrpcHandler{userService: mockUserService, postService: mockPostService}
+
+		req := &user_pb.CreatePostRequest{
+			UserId:  proto.Int64(1),
+			Content: proto.String("hello world"),
+		}
+
+		expectedPost := &model.Post{
+			ID:               10,
+			UserID:           1,
+			Content:          "hello world",
+			CreatedTimestamp: 1700000000,
+		}
+
+		mockPostService.On("CreatePost", mock.Anything, mock.MatchedBy(func(p *model.Post) bool {
+			return p.UserID == 1 && p.Content == "hello world"
+		})).Return(expectedPost, nil).Once()
+
+		resp, err := handler.CreatePost(ctx, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, expectedPost.ID, resp.Post.GetId())
+		assert.Equal(t, expectedPost.UserID, resp.Post.GetUserId())
+		assert.Equal(t, expectedPost.Content, resp.Post.GetContent())
+		assert.Equal(t, expectedPost.CreatedTimestamp, resp.Post.GetCreatedTimestamp())
+	})
+
+	t.Run("email not verified", func(t *testing.T) {
+		mockUserService := new(MockUserService)
+		mockUserService.On("CheckVerified", mock.Anything, int64(1), model.ActionPost).
+			Return(common.NewError(common.CodeEmailNotVerified, "email must be verified to post"))
+		mockPostService := new(MockPostService)
+		handler := &userGrpcHandler{userService: mockUserService, postService: mockPostService}
+
+		req := &user_pb.CreatePostRequest{
+			UserId:  proto.Int64(1),
+			Content: proto.String("hello world"),
+		}
+
+		resp, err := handler.CreatePost(ctx, req)
+
+		assert.Nil(t, resp)
+		assert.Error(t, err)
+		mockPostService.AssertNotCalled(t, "CreatePost", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserGrpcHandler_GetFollowers(t *testing.T) {
+	ctx := context.Background()
+	codec, err := cursor.NewCodec("secret")
+	assert.NoError(t, err)
+
+	t.Run("next cursor comes from service", func(t *testing.T) {
+		mockService := new(MockUserService)
+		handler := &userGrpcHandler{userService: mockService, cursorCodec: codec}
+
+		// the page is short because a removed follower was dropped, the list goes on
+		prevCursor := cursor.Cursor{SortKey: 1700000300, ID: 9}
+		mockService.On("GetFollowers", ctx, int64(1), &model.Paging{Cursor: &prevCursor, Limit: 2}).Return([]*model.Follow{
+			{Follower: &model.User{ID: 3}, FollowTs: 1700000200},
+		}, &cursor.Cursor{SortKey: 1700000100, ID: 2}, nil).Once()
+
+		resp, err := handler.GetFollowers(ctx, &user_pb.GetFollowersRequest{
+			UserId: proto.Int64(1),
+			Limit:  proto.Int64(2),
+			Cursor: proto.String(codec.Encode(prevCursor)),
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetFollowers(), 1)
+		nextCursor, err := codec.Decode(resp.GetNextCursor())
+		assert.NoError(t, err)
+		assert.Equal(t, cursor.Cursor{SortKey: 1700000100, ID: 2}, nextCursor)
+	})
+
+	t.Run("no next cursor on the last page", func(t *testing.T) {
+		mockService := new(MockUserService)
+		handler := &userGrpcHandler{userService: mockService, cursorCodec: codec}
+
+		mockService.On("GetFollowers", ctx, int64(1), &model.Paging{Limit: 2}).Return([]*model.Follow{
+			{Follower: &model.User{ID: 3}, FollowTs: 1700000200},
+		}, (*cursor.Cursor)(nil), nil).Once()
+
+		resp, err := handler.GetFollowers(ctx, &user_pb.GetFollowersRequest{
+			UserId: proto.Int64(1),
+			Limit:  proto.Int64(2),
+		})
+
+		assert.NoError(t, err)
+		assert.Nil(t, resp.NextCursor)
+	})
+
+	t.Run("tampered cursor", func(t *testing.T) {
+		handler := &userGrpcHandler{cursorCodec: codec}
+
+		resp, err := handler.GetFollowers(ctx, &user_pb.GetFollowersRequest{
+			UserId: proto.Int64(1),
+			Limit:  proto.Int64(2),
+			Cursor: proto.String(codec.Encode(cursor.Cursor{ID: 9}) + "x"),
+		})
+
+		assert.Nil(t, resp)
+		var appErr *common.AppError
+		assert.True(t, errors.As(err, &appErr))
+		assert.Equal(t, common.CodeInvalidRequest, appErr.Code)
+	})
+}
@@ -1,13 +1,15 @@
 package http
 
 import (
-	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
 
 	"ep.k16/newsfeed/internal/common"
+	grpc_pb "ep.k16/newsfeed/internal/handler/proto/grpc"
 	"ep.k16/newsfeed/pkg/logger"
 	"ep.k16/newsfeed/pkg/monitor"
 )
@@ -39,30 +41,54 @@ func (h *Server) MonitorMiddleware() gin.HandlerFunc {
 	}
 }
 
+// DevUserHeader impersonates a user without token when Config.DevAuth is enabled, e.g. X-Dev-User-Id: 3
+const DevUserHeader = "X-Dev-User-Id"
+
 func (h *Server) JWTMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		if c.Query("mock") == "true" {
-			c.Set("user_id", int64(3))
+		if devUserId := c.GetHeader(DevUserHeader); h.config.DevAuth && len(devUserId) > 0 {
+			userId, err := strconv.ParseInt(devUserId, 10, 64)
+			if err != nil || userId <= 0 {
+				unauthErr := common.NewError(common.CodeUnauthorized, "invalid "+DevUserHeader+" header")
+				h.returnErrResp(c, unauthErr)
+				c.Abort()
+				return
+			}
+
+			c.Set("user_id", userId)
 			c.Next()
 			return
 		}
 
 		authHeader := c.GetHeader("Authorization")
 		if !strings.HasPrefix(authHeader, "Bearer ") {
-			unauthErr := common.NewError(http.StatusUnauthorized, "invalid Authorization header")
+			unauthErr := common.NewError(common.CodeUnauthorized, "invalid Authorization header")
 			h.returnErrResp(c, unauthErr)
 			c.Abort()
 			return
 		}
 
-		logger.Debugf("autHeader: %s", authHeader)
-
 		tokenStr := authHeader[7:]
-
-		logger.Debugf("autHeader: %s", tokenStr)
 		claims, err := h.validateJWT(tokenStr)
 		if err != nil {
-			unauthErr := common.NewError(http.StatusUnauthorized, "invalid token")
+			unauthErr := common.NewError(common.CodeUnauthorized, "invalid token")
+			h.returnErrResp(c, unauthErr)
+			c.Abort()
+			return
+		}
+
+		// token is valid until it expires, so check that its session was not logged out
+		grpcResp, err := h.grpcClient.CheckSession(c.Request.Context(), &grpc_pb.CheckSessionRequest{
+			UserId:    proto.Int64(claims.UserID),
+			SessionId: proto.String(claims.SessionID),
+		})
+		if err != nil {
+			h.returnErrResp(c, common.FromGRPCError(err))
+			c.Abort()
+			return
+		}
+		if !grpcResp.GetActive() {
+			unauthErr := common.NewError(common.CodeUnauthorized, "session is revoked")
 			h.returnErrResp(c, unauthErr)
 			c.Abort()
 			return
@@ -71,6 +97,7 @@ func (h *Server) JWTMiddleware() gin.HandlerFunc {
 		// store in context for handlers
 		c.Set("user_id", claims.UserID)
 		c.Set("username", claims.Username)
+		c.Set("session_id", claims.SessionID)
 		c.Next()
 	}
 }
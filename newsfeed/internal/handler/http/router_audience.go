@@ -0,0 +1,154 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+
+	"ep.k16/newsfeed/internal/common"
+	grpc_pb "ep.k16/newsfeed/internal/handler/proto/grpc"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+type AddCloseFriendRequest struct {
+	PeerId int64 `json:"peer_id"`
+}
+
+type CloseFriendsData struct {
+	Friends    []*UserData `json:"friends"`
+	NextCursor string      `json:"next_cursor,omitempty"` // empty if there is no more friend
+}
+
+func (h *Server) AddCloseFriend(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = &AddCloseFriendRequest{}
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind req
+	if err := c.ShouldBind(req); err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
+	}
+	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
+
+	// process logic
+	grpcReq := &grpc_pb.AddCloseFriendRequest{
+		UserId: proto.Int64(userId),
+		PeerId: proto.Int64(req.PeerId),
+	}
+
+	grpcResp, err := h.grpcClient.AddCloseFriend(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Add close friend successfully", toUserData(grpcResp.GetFriend()))
+}
+
+func (h *Server) RemoveCloseFriend(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	peerId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("peer_id", peerId))
+
+	// process logic
+	grpcReq := &grpc_pb.RemoveCloseFriendRequest{
+		UserId: proto.Int64(userId),
+		PeerId: proto.Int64(peerId),
+	}
+
+	_, err = h.grpcClient.RemoveCloseFriend(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Remove close friend successfully", nil)
+}
+
+func (h *Server) GetCloseFriends(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		api    = c.Request.Method + " " + c.Request.RequestURI
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind query param
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil || limit <= 0 {
+		h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "invalid limit query"))
+		return
+	}
+	cursor := c.Query("cursor") // opaque, validated by grpc server
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("limit", limit), logger.F("cursor", cursor))
+
+	// process logic
+	grpcReq := &grpc_pb.GetCloseFriendsRequest{
+		UserId: proto.Int64(userId),
+		Limit:  proto.Int64(int64(limit)),
+		Cursor: proto.String(cursor),
+	}
+
+	grpcResp, err := h.grpcClient.GetCloseFriends(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	data := &CloseFriendsData{
+		Friends:    make([]*UserData, 0, len(grpcResp.GetFriends())),
+		NextCursor: grpcResp.GetNextCursor(),
+	}
+	for _, friend := range grpcResp.GetFriends() {
+		data.Friends = append(data.Friends, toUserData(friend))
+	}
+
+	h.returnDataResp(c, "Get close friends successfully", data)
+}
+
+func toUserData(user *grpc_pb.UserData) *UserData {
+	if user == nil {
+		return nil
+	}
+	userData := &UserData{
+		ID:            user.GetId(),
+		Username:      user.GetUserName(),
+		Email:         user.GetEmail(),
+		DisplayName:   user.GetDisplayName(),
+		Dob:           user.GetDob(),
+		IsPrivate:     user.GetIsPrivate(),
+		EmailVerified: user.GetEmailVerified(),
+	}
+	if counts := user.GetCounts(); counts != nil {
+		userData.Counts = &UserCountsData{
+			Followers:  counts.GetFollowers(),
+			Followings: counts.GetFollowings(),
+			Posts:      counts.GetPosts(),
+		}
+	}
+	return userData
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"regexp"
 	"time"
 
@@ -12,44 +13,64 @@ import (
 
 	"ep.k16/newsfeed/internal/common"
 	"ep.k16/newsfeed/internal/handler/proto/grpc"
+	"ep.k16/newsfeed/pkg/keyring"
 	"ep.k16/newsfeed/pkg/logger"
 )
 
 type Claims struct {
 	jwt.RegisteredClaims
-	UserID   int64  `json:"user_id"`
-	Username string `json:"username"`
+	UserID    int64  `json:"user_id"`
+	Username  string `json:"username"`
+	SessionID string `json:"sid"` // token is rejected once the session is revoked
 }
 
-func (h *Server) generateJWT(userID int64, username string, duration time.Duration) (string, error) {
+func (h *Server) generateJWT(userID int64, username string, sessionID string, duration time.Duration) (string, error) {
 	now := time.Now()
 	claims := &Claims{
-		UserID:   userID,
-		Username: username,
+		UserID:    userID,
+		Username:  username,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
 			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(h.config.JwtKey)
+	key := h.keyring.SigningKey()
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.Private)
 }
 
 func (h *Server) validateJWT(tokenStr string) (*Claims, error) {
 	claims := &Claims{}
 	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
-		return h.config.JwtKey, nil
-	})
+		kid, _ := token.Header["kid"].(string)
+		key, err := h.keyring.VerificationKey(kid)
+		if err != nil {
+			return nil, err
+		}
+		// pin the algorithm to the key, so a token cannot pick how it is verified
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+		}
+		return key.Public, nil
+	}, jwt.WithValidMethods([]string{keyring.AlgorithmRS256, keyring.AlgorithmEdDSA}))
 	if err != nil || !token.Valid {
 		return nil, errors.New("invalid token")
 	}
-	if claims.UserID == 0 || len(claims.Username) == 0 {
-		return nil, errors.New("invalid userID or username")
+	if claims.UserID == 0 || len(claims.Username) == 0 || len(claims.SessionID) == 0 {
+		return nil, errors.New("invalid userID, username or sessionID")
 	}
 	return claims, nil
 }
 
+// GetJWKS returns public keys verifying access tokens, so other services can verify them
+func (h *Server) GetJWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.keyring.JWKS())
+}
+
 type SignupRequest struct {
 	Username    string `json:"user_name"`
 	Password    string `json:"password"`
@@ -59,16 +80,31 @@ type SignupRequest struct {
 }
 
 type UserData struct {
-	ID          int64  `json:"id"`
-	Username    string `json:"username"`
-	Email       string `json:"email"`
-	DisplayName string `json:"display_name"`
-	Dob         string `json:"dob"`
+	ID            int64  `json:"id"`
+	Username      string `json:"username"`
+	Email         string `json:"email"`
+	DisplayName   string `json:"display_name"`
+	Dob           string `json:"dob"`
+	IsPrivate     bool   `json:"is_private"`
+	EmailVerified bool   `json:"email_verified"`
+
+	Counts *UserCountsData `json:"counts,omitempty"` // only returned by profile and login
+}
+
+type UserCountsData struct {
+	Followers  int64 `json:"followers"`
+	Followings int64 `json:"followings"`
+	Posts      int64 `json:"posts"`
 }
 
 type UserDataWithToken struct {
 	UserData
-	Token string `json:"token"`
+	Token          string `json:"token"`
+	TokenExpiresIn int64  `json:"token_expires_in"` // in seconds
+
+	// RefreshToken is exchanged for a new access token and a new refresh token at /refresh, it can be used only once
+	RefreshToken    string `json:"refresh_token"`
+	RefreshExpireTs int64  `json:"refresh_expire_ts"`
 }
 
 func (h *Server) Signup(c *gin.Context) {
@@ -110,11 +146,12 @@ func (h *Server) Signup(c *gin.Context) {
 	// process response
 	createdUser := grpcResp.GetUser()
 	userData := &UserData{
-		ID:          createdUser.GetId(),
-		Username:    createdUser.GetUserName(),
-		Email:       createdUser.GetEmail(),
-		DisplayName: createdUser.GetDisplayName(),
-		Dob:         createdUser.GetDob(),
+		ID:            createdUser.GetId(),
+		Username:      createdUser.GetUserName(),
+		Email:         createdUser.GetEmail(),
+		DisplayName:   createdUser.GetDisplayName(),
+		Dob:           createdUser.GetDob(),
+		EmailVerified: createdUser.GetEmailVerified(),
 	}
 
 	h.returnDataResp(c, "Sign up successfully", userData)
@@ -181,24 +218,272 @@ func (h *Server) Login(c *gin.Context) {
 	}
 
 	// process response
-	createdUser := grpcResp.GetUser()
-	token, err := h.generateJWT(createdUser.GetId(), createdUser.GetUserName(), 24*time.Hour)
+	userData, err := h.toUserDataWithToken(grpcResp.GetUser(), grpcResp.GetSession())
 	if err != nil {
-		tokenErr := common.WrapError(common.CodeInternal, "generate jwt token error", err)
-		h.returnErrResp(c, tokenErr)
+		h.returnErrResp(c, err)
 		return
 	}
 
-	userData := &UserDataWithToken{
-		UserData: UserData{
-			ID:          createdUser.GetId(),
-			Username:    createdUser.GetUserName(),
-			Email:       createdUser.GetEmail(),
-			DisplayName: createdUser.GetDisplayName(),
-			Dob:         createdUser.GetDob(),
-		},
-		Token: token,
+	h.returnDataResp(c, "Log in successfully", userData)
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+func (h *Server) Refresh(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = &RefreshRequest{}
+	)
+
+	// bind req
+	if err := c.ShouldBind(req); err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
 	}
 
-	h.returnDataResp(c, "Log in successfully", userData)
+	// validate req
+	if len(req.RefreshToken) == 0 {
+		h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "refresh_token is required"))
+		return
+	}
+
+	// process logic
+	grpcReq := &grpc.RefreshSessionRequest{
+		RefreshToken: proto.String(req.RefreshToken),
+	}
+
+	grpcResp, err := h.grpcClient.RefreshSession(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	userData, err := h.toUserDataWithToken(grpcResp.GetUser(), grpcResp.GetSession())
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	h.returnDataResp(c, "Refresh token successfully", userData)
+}
+
+// Logout revokes the session of the access token, its refresh token and other access tokens stop working too
+func (h *Server) Logout(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+
+		userId    = c.GetInt64("user_id")
+		sessionId = c.GetString("session_id")
+	)
+
+	// process logic
+	grpcReq := &grpc.LogoutRequest{
+		UserId:    proto.Int64(userId),
+		SessionId: proto.String(sessionId),
+	}
+
+	_, err := h.grpcClient.Logout(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Log out successfully", nil)
+}
+
+// LogoutAll revokes all sessions of the user, e.g. when a device is lost
+func (h *Server) LogoutAll(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+
+		userId = c.GetInt64("user_id")
+	)
+
+	// process logic
+	grpcReq := &grpc.LogoutAllRequest{
+		UserId: proto.Int64(userId),
+	}
+
+	_, err := h.grpcClient.LogoutAll(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Log out of all sessions successfully", nil)
+}
+
+func (h *Server) toUserDataWithToken(user *grpc.UserData, session *grpc.SessionData) (*UserDataWithToken, error) {
+	token, err := h.generateJWT(user.GetId(), user.GetUserName(), session.GetId(), h.config.AccessTokenTTL)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "generate jwt token error", err)
+	}
+
+	return &UserDataWithToken{
+		UserData:        *toUserData(user),
+		Token:           token,
+		TokenExpiresIn:  int64(h.config.AccessTokenTTL.Seconds()),
+		RefreshToken:    session.GetRefreshToken(),
+		RefreshExpireTs: session.GetRefreshExpireTs(),
+	}, nil
+}
+
+type RequestPasswordResetRequest struct {
+	Email string `json:"email"`
+}
+
+// RequestPasswordReset mails a reset token, it succeeds for unknown emails too so it does not tell which are registered
+func (h *Server) RequestPasswordReset(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = &RequestPasswordResetRequest{}
+	)
+
+	// bind req
+	if err := c.ShouldBind(req); err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
+	}
+
+	// validate req
+	if match, _ := regexp.Match(`^.+@.+\..+$`, []byte(req.Email)); !match { // simple check: xxx@xxx.xxx
+		h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "invalid email format"))
+		return
+	}
+
+	// process logic
+	grpcReq := &grpc.RequestPasswordResetRequest{
+		Email: proto.String(req.Email),
+	}
+
+	_, err := h.grpcClient.RequestPasswordReset(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Password reset mail is sent if the email is registered", nil)
+}
+
+type ConfirmPasswordResetRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+// ConfirmPasswordReset sets a new password with a reset token, all sessions of the user are logged out
+func (h *Server) ConfirmPasswordReset(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = &ConfirmPasswordResetRequest{}
+	)
+
+	// bind req
+	if err := c.ShouldBind(req); err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
+	}
+
+	// validate req
+	if len(req.Token) == 0 {
+		h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "token is required"))
+		return
+	}
+	if len(req.NewPassword) < 8 {
+		h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "password must have at least 8 characters"))
+		return
+	}
+
+	// process logic
+	grpcReq := &grpc.ConfirmPasswordResetRequest{
+		Token:       proto.String(req.Token),
+		NewPassword: proto.String(req.NewPassword),
+	}
+
+	_, err := h.grpcClient.ConfirmPasswordReset(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Reset password successfully", nil)
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// VerifyEmail verifies the email of a user with the token mailed at signup, it needs no login
+// so the link in the mail works on any device
+func (h *Server) VerifyEmail(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = &VerifyEmailRequest{}
+	)
+
+	// bind req
+	if err := c.ShouldBind(req); err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
+	}
+
+	// validate req
+	if len(req.Token) == 0 {
+		h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "token is required"))
+		return
+	}
+
+	// process logic
+	grpcReq := &grpc.VerifyEmailRequest{
+		Token: proto.String(req.Token),
+	}
+
+	grpcResp, err := h.grpcClient.VerifyEmail(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Verify email successfully", toUserData(grpcResp.GetUser()))
+}
+
+// ResendEmailVerification mails a new verification token to the logged in user
+func (h *Server) ResendEmailVerification(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+
+		userId = c.GetInt64("user_id")
+	)
+
+	// process logic
+	grpcReq := &grpc.ResendEmailVerificationRequest{
+		UserId: proto.Int64(userId),
+	}
+
+	_, err := h.grpcClient.ResendEmailVerification(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Verification mail is sent", nil)
 }
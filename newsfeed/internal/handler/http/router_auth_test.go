@@ -2,17 +2,27 @@ package http
 
 import (
 	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/protobuf/proto"
 
+	"ep.k16/newsfeed/config"
 	"ep.k16/newsfeed/internal/common"
 	"ep.k16/newsfeed/internal/handler/proto/grpc"
+	"ep.k16/newsfeed/pkg/keyring"
 )
 
 func TestServer_Signup(t *testing.T) {
@@ -30,8 +40,9 @@ func TestServer_Signup(t *testing.T) {
 		}, nil)
 
 	cfg := Config{
-		Host: "127.0.0.1",
-		Port: 18080,
+		Host:      "127.0.0.1",
+		Port:      18080,
+		JwtKeyDir: newTestJwtKeyDir(t),
 	}
 	srv, err := New(cfg, mockUserClient)
 	assert.NoError(t, err)
@@ -66,3 +77,308 @@ func TestServer_Signup(t *testing.T) {
 	assert.Equal(t, 1, int(userDataJson["id"].(float64)))
 	assert.Equal(t, "username", userDataJson["username"])
 }
+
+func TestServer_JWTMiddleware_RevokedSession(t *testing.T) {
+	// assume
+	mockUserClient := new(grpc.MockServiceClient)
+	mockUserClient.On("CheckSession", mock.Anything, mock.MatchedBy(func(req *grpc.CheckSessionRequest) bool {
+		return req.GetUserId() == 1 && req.GetSessionId() == "s1"
+	})).Return(&grpc.CheckSessionResponse{Active: proto.Bool(false)}, nil)
+
+	cfg := Config{
+		Host:      "127.0.0.1",
+		Port:      18080,
+		JwtKeyDir: newTestJwtKeyDir(t),
+	}
+	srv, err := New(cfg, mockUserClient)
+	assert.NoError(t, err)
+
+	token, err := srv.generateJWT(1, "username", "s1", time.Minute)
+	assert.NoError(t, err)
+
+	// act
+	req := httptest.NewRequest(http.MethodGet, "/grpc/me/followers", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	rec := httptest.NewRecorder()
+	srv.router.ServeHTTP(rec, req)
+
+	// assert
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	mockUserClient.AssertExpectations(t)
+}
+
+func TestServer_Refresh(t *testing.T) {
+	// assume
+	mockUserClient := new(grpc.MockServiceClient)
+	mockUserClient.On("RefreshSession", mock.Anything, mock.MatchedBy(func(req *grpc.RefreshSessionRequest) bool {
+		return req.GetRefreshToken() == "old-refresh-token"
+	})).Return(&grpc.RefreshSessionResponse{
+		User: &grpc.UserData{
+			Id:       proto.Int64(1),
+			UserName: proto.String("username"),
+		},
+		Session: &grpc.SessionData{
+			Id:              proto.String("s1"),
+			RefreshToken:    proto.String("new-refresh-token"),
+			RefreshExpireTs: proto.Int64(1700000000),
+		},
+	}, nil)
+
+	cfg := Config{
+		Host:      "127.0.0.1",
+		Port:      18080,
+		JwtKeyDir: newTestJwtKeyDir(t),
+	}
+	srv, err := New(cfg, mockUserClient)
+	assert.NoError(t, err)
+
+	// act
+	body := `{"refresh_token": "old-refresh-token"}`
+	req := httptest.NewRequest(http.MethodPost, "/grpc/refresh", bytes.NewBuffer([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	srv.router.ServeHTTP(rec, req)
+
+	// assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	resp := new(DataResponse)
+	err = json.Unmarshal(rec.Body.Bytes(), resp)
+	assert.NoError(t, err)
+
+	userDataJson, ok := resp.Data.(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, "new-refresh-token", userDataJson["refresh_token"])
+	assert.Equal(t, 900, int(userDataJson["token_expires_in"].(float64)))
+
+	claims, err := srv.validateJWT(userDataJson["token"].(string))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), claims.UserID)
+	assert.Equal(t, "s1", claims.SessionID)
+}
+
+func TestServer_JWTMiddleware_DevAuth(t *testing.T) {
+	t.Run("dev auth disabled rejects impersonation", func(t *testing.T) {
+		// assume
+		mockUserClient := new(grpc.MockServiceClient)
+
+		cfg := Config{
+			Host:      "127.0.0.1",
+			Port:      18080,
+			JwtKeyDir: newTestJwtKeyDir(t),
+		}
+		srv, err := New(cfg, mockUserClient)
+		assert.NoError(t, err)
+
+		// act
+		for _, req := range []*http.Request{
+			httptest.NewRequest(http.MethodPost, "/grpc/logout-all?mock=true", nil),
+			httptest.NewRequest(http.MethodPost, "/grpc/logout-all", nil),
+		} {
+			req.Header.Set(DevUserHeader, "5")
+
+			rec := httptest.NewRecorder()
+			srv.router.ServeHTTP(rec, req)
+
+			// assert
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+		mockUserClient.AssertNotCalled(t, "LogoutAll", mock.Anything, mock.Anything)
+	})
+
+	t.Run("dev auth outside local env is refused", func(t *testing.T) {
+		t.Setenv(config.EnvKey, string(config.EnvTypeProd))
+
+		cfg := Config{
+			Host:      "127.0.0.1",
+			Port:      18080,
+			JwtKeyDir: newTestJwtKeyDir(t),
+			DevAuth:   true,
+		}
+		srv, err := New(cfg, new(grpc.MockServiceClient))
+
+		assert.Error(t, err)
+		assert.Nil(t, srv)
+	})
+
+	t.Run("dev auth enabled impersonates user", func(t *testing.T) {
+		// assume
+		t.Setenv(config.EnvKey, string(config.EnvTypeLocal))
+		mockUserClient := new(grpc.MockServiceClient)
+		mockUserClient.On("LogoutAll", mock.Anything, mock.MatchedBy(func(req *grpc.LogoutAllRequest) bool {
+			return req.GetUserId() == 5
+		})).Return(&grpc.LogoutAllResponse{IsLoggedOut: proto.Bool(true)}, nil)
+
+		cfg := Config{
+			Host:      "127.0.0.1",
+			Port:      18080,
+			JwtKeyDir: newTestJwtKeyDir(t),
+			DevAuth:   true,
+		}
+		srv, err := New(cfg, mockUserClient)
+		assert.NoError(t, err)
+
+		// act
+		req := httptest.NewRequest(http.MethodPost, "/grpc/logout-all", nil)
+		req.Header.Set(DevUserHeader, "5")
+
+		rec := httptest.NewRecorder()
+		srv.router.ServeHTTP(rec, req)
+
+		// assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockUserClient.AssertExpectations(t)
+	})
+}
+
+// newTestJwtKeyDir returns a dir having an ed25519 key signing access tokens
+func newTestJwtKeyDir(t *testing.T) string {
+	dir := t.TempDir()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "test.pem"), data, 0o600))
+	return dir
+}
+
+func TestServer_GetJWKS(t *testing.T) {
+	// assume
+	cfg := Config{
+		Host:      "127.0.0.1",
+		Port:      18080,
+		JwtKeyDir: newTestJwtKeyDir(t),
+	}
+	srv, err := New(cfg, new(grpc.MockServiceClient))
+	assert.NoError(t, err)
+
+	token, err := srv.generateJWT(1, "username", "s1", time.Minute)
+	assert.NoError(t, err)
+
+	// act
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	rec := httptest.NewRecorder()
+	srv.router.ServeHTTP(rec, req)
+
+	// assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	jwks := new(keyring.JWKSet)
+	err = json.Unmarshal(rec.Body.Bytes(), jwks)
+	assert.NoError(t, err)
+	assert.Len(t, jwks.Keys, 1)
+	assert.Equal(t, "test", jwks.Keys[0].Kid)
+	assert.Equal(t, "EdDSA", jwks.Keys[0].Alg)
+
+	// token is signed by the published key
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &Claims{})
+	assert.NoError(t, err)
+	assert.Equal(t, "test", parsed.Header["kid"])
+	assert.Equal(t, "EdDSA", parsed.Method.Alg())
+}
+
+func TestServer_validateJWT_RejectsOtherAlgorithms(t *testing.T) {
+	cfg := Config{
+		Host:      "127.0.0.1",
+		Port:      18080,
+		JwtKeyDir: newTestJwtKeyDir(t),
+	}
+	srv, err := New(cfg, new(grpc.MockServiceClient))
+	assert.NoError(t, err)
+
+	// hmac token signed with the public key as secret must not be accepted
+	pub, ok := srv.keyring.SigningKey().Public.(ed25519.PublicKey)
+	assert.True(t, ok)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{UserID: 1, Username: "username", SessionID: "s1"})
+	token.Header["kid"] = "test"
+	tokenStr, err := token.SignedString([]byte(pub))
+	assert.NoError(t, err)
+
+	_, err = srv.validateJWT(tokenStr)
+	assert.Error(t, err)
+}
+
+func TestServer_ConfirmPasswordReset(t *testing.T) {
+	// assume
+	mockUserClient := new(grpc.MockServiceClient)
+	mockUserClient.On("ConfirmPasswordReset", mock.Anything, mock.MatchedBy(func(req *grpc.ConfirmPasswordResetRequest) bool {
+		return req.GetToken() == "reset-token" && req.GetNewPassword() == "new-password"
+	})).Return(&grpc.ConfirmPasswordResetResponse{IsReset: proto.Bool(true)}, nil)
+
+	cfg := Config{
+		Host:      "127.0.0.1",
+		Port:      18080,
+		JwtKeyDir: newTestJwtKeyDir(t),
+	}
+	srv, err := New(cfg, mockUserClient)
+	assert.NoError(t, err)
+
+	t.Run("reset password", func(t *testing.T) {
+		body := `{"token": "reset-token", "new_password": "new-password"}`
+		req := httptest.NewRequest(http.MethodPost, "/grpc/password/confirm-reset", bytes.NewBuffer([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+
+		rec := httptest.NewRecorder()
+		srv.router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockUserClient.AssertExpectations(t)
+	})
+
+	t.Run("short password", func(t *testing.T) {
+		body := `{"token": "reset-token", "new_password": "short"}`
+		req := httptest.NewRequest(http.MethodPost, "/grpc/password/confirm-reset", bytes.NewBuffer([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+
+		rec := httptest.NewRecorder()
+		srv.router.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		mockUserClient.AssertNumberOfCalls(t, "ConfirmPasswordReset", 1)
+	})
+}
+
+func TestServer_VerifyEmail(t *testing.T) {
+	// assume
+	mockUserClient := new(grpc.MockServiceClient)
+	mockUserClient.On("VerifyEmail", mock.Anything, mock.MatchedBy(func(req *grpc.VerifyEmailRequest) bool {
+		return req.GetToken() == "verification-token"
+	})).Return(&grpc.VerifyEmailResponse{
+		User: &grpc.UserData{
+			Id:            proto.Int64(1),
+			UserName:      proto.String("username"),
+			EmailVerified: proto.Bool(true),
+		},
+	}, nil)
+
+	cfg := Config{
+		Host:      "127.0.0.1",
+		Port:      18080,
+		JwtKeyDir: newTestJwtKeyDir(t),
+	}
+	srv, err := New(cfg, mockUserClient)
+	assert.NoError(t, err)
+
+	// act
+	body := `{"token": "verification-token"}`
+	req := httptest.NewRequest(http.MethodPost, "/grpc/email/verify", bytes.NewBuffer([]byte(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	srv.router.ServeHTTP(rec, req)
+
+	// assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	resp := new(DataResponse)
+	err = json.Unmarshal(rec.Body.Bytes(), resp)
+	assert.NoError(t, err)
+
+	userDataJson, ok := resp.Data.(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, true, userDataJson["email_verified"])
+}
@@ -0,0 +1,152 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+
+	"ep.k16/newsfeed/internal/common"
+	grpc_pb "ep.k16/newsfeed/internal/handler/proto/grpc"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+type BlockRequest struct {
+	PeerId int64 `json:"peer_id"`
+}
+
+type MuteRequest struct {
+	PeerId int64 `json:"peer_id"`
+}
+
+func (h *Server) Block(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = &BlockRequest{}
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind req
+	if err := c.ShouldBind(req); err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
+	}
+	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
+
+	// process logic
+	grpcReq := &grpc_pb.BlockRequest{
+		UserId: proto.Int64(userId),
+		PeerId: proto.Int64(req.PeerId),
+	}
+
+	_, err := h.grpcClient.Block(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Block successfully", nil)
+}
+
+func (h *Server) Unblock(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	peerId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("peer_id", peerId))
+
+	// process logic
+	grpcReq := &grpc_pb.UnblockRequest{
+		UserId: proto.Int64(userId),
+		PeerId: proto.Int64(peerId),
+	}
+
+	_, err = h.grpcClient.Unblock(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Unblock successfully", nil)
+}
+
+func (h *Server) Mute(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = &MuteRequest{}
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind req
+	if err := c.ShouldBind(req); err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
+	}
+	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
+
+	// process logic
+	grpcReq := &grpc_pb.MuteRequest{
+		UserId: proto.Int64(userId),
+		PeerId: proto.Int64(req.PeerId),
+	}
+
+	_, err := h.grpcClient.Mute(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Mute successfully", nil)
+}
+
+func (h *Server) Unmute(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	peerId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("peer_id", peerId))
+
+	// process logic
+	grpcReq := &grpc_pb.UnmuteRequest{
+		UserId: proto.Int64(userId),
+		PeerId: proto.Int64(peerId),
+	}
+
+	_, err = h.grpcClient.Unmute(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Unmute successfully", nil)
+}
@@ -0,0 +1,220 @@
+package http
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+
+	"ep.k16/newsfeed/internal/common"
+	grpc_pb "ep.k16/newsfeed/internal/handler/proto/grpc"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+type CreateCommentRequest struct {
+	ParentID int64  `json:"parent_id"` // set to reply to a top-level comment
+	Content  string `json:"content"`
+}
+
+type CommentData struct {
+	ID               int64  `json:"id"`
+	PostID           int64  `json:"post_id"`
+	UserID           int64  `json:"user_id"`
+	ParentID         int64  `json:"parent_id"`
+	Content          string `json:"content"`
+	ReplyCount       int64  `json:"reply_count"`
+	CreatedTimestamp int64  `json:"created_ts"`
+	CreatedTime      string `json:"created_time"`
+}
+
+type ListCommentsRequest struct {
+	ParentID int64  `json:"parent_id"`
+	Limit    int64  `json:"limit"`
+	Cursor   string `json:"cursor"` // next_cursor of previous page, empty for the first page
+}
+
+type CommentsData struct {
+	Comments   []*CommentData `json:"comments"`
+	NextCursor string         `json:"next_cursor,omitempty"` // empty if there is no more comment
+}
+
+func (h *Server) CreateComment(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = &CreateCommentRequest{}
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	postId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	// bind req
+	if err := c.ShouldBind(req); err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
+	}
+	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
+
+	// validate req
+	if len(req.Content) == 0 {
+		h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "content is required"))
+		return
+	}
+
+	// process logic
+	grpcReq := &grpc_pb.CreateCommentRequest{
+		UserId:   proto.Int64(userId),
+		PostId:   proto.Int64(postId),
+		ParentId: proto.Int64(req.ParentID),
+		Content:  proto.String(req.Content),
+	}
+
+	grpcResp, err := h.grpcClient.CreateComment(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Create comment successfully", toCommentData(grpcResp.GetComment()))
+}
+
+func (h *Server) ListComments(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	postId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	// bind query param
+	req, err := parseListCommentsRequest(c)
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
+
+	// process logic
+	grpcReq := &grpc_pb.ListCommentsRequest{
+		UserId:   proto.Int64(userId),
+		PostId:   proto.Int64(postId),
+		ParentId: proto.Int64(req.ParentID),
+		Limit:    proto.Int64(req.Limit),
+		Cursor:   proto.String(req.Cursor),
+	}
+
+	grpcResp, err := h.grpcClient.ListComments(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	data := &CommentsData{
+		Comments:   make([]*CommentData, 0, len(grpcResp.GetComments())),
+		NextCursor: grpcResp.GetNextCursor(),
+	}
+	for _, comment := range grpcResp.GetComments() {
+		data.Comments = append(data.Comments, toCommentData(comment))
+	}
+
+	h.returnDataResp(c, "List comments successfully", data)
+}
+
+func (h *Server) DeleteComment(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	postId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+	commentId, err := parseIdParam(c, "comment_id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("post_id", postId), logger.F("comment_id", commentId))
+
+	// process logic
+	grpcReq := &grpc_pb.DeleteCommentRequest{
+		UserId:    proto.Int64(userId),
+		PostId:    proto.Int64(postId),
+		CommentId: proto.Int64(commentId),
+	}
+
+	_, err = h.grpcClient.DeleteComment(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Delete comment successfully", nil)
+}
+
+func parseListCommentsRequest(c *gin.Context) (*ListCommentsRequest, error) {
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil || limit <= 0 {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid limit query")
+	}
+
+	req := &ListCommentsRequest{
+		Limit:  int64(limit),
+		Cursor: c.Query("cursor"),
+	}
+	if parentId := c.Query("parent_id"); len(parentId) > 0 {
+		req.ParentID, err = strconv.ParseInt(parentId, 10, 64)
+		if err != nil {
+			return nil, common.NewError(common.CodeInvalidRequest, "invalid parent_id query")
+		}
+	}
+	return req, nil
+}
+
+// parseIdParam parses a positive id from path param
+func parseIdParam(c *gin.Context, name string) (int64, error) {
+	id, err := strconv.ParseInt(c.Param(name), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, common.NewError(common.CodeInvalidRequest, fmt.Sprintf("invalid %s param", name))
+	}
+	return id, nil
+}
+
+func toCommentData(comment *grpc_pb.CommentData) *CommentData {
+	return &CommentData{
+		ID:               comment.GetId(),
+		PostID:           comment.GetPostId(),
+		UserID:           comment.GetUserId(),
+		ParentID:         comment.GetParentId(),
+		Content:          comment.GetContent(),
+		ReplyCount:       comment.GetReplyCount(),
+		CreatedTimestamp: comment.GetCreatedTimestamp(),
+		CreatedTime:      time.Unix(comment.GetCreatedTimestamp(), 0).Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,220 @@
+package http
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+
+	"ep.k16/newsfeed/internal/common"
+	grpc_pb "ep.k16/newsfeed/internal/handler/proto/grpc"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+type SetPrivateRequest struct {
+	IsPrivate bool `json:"is_private"`
+}
+
+type FollowRequestData struct {
+	ID               int64     `json:"id"`
+	Requester        *UserData `json:"requester"`
+	CreatedTimestamp int64     `json:"created_timestamp"`
+	CreatedTime      string    `json:"created_time"`
+}
+
+type FollowRequestsData struct {
+	Requests   []*FollowRequestData `json:"requests"`
+	NextCursor string               `json:"next_cursor,omitempty"` // empty if there is no more request
+}
+
+func (h *Server) SetPrivate(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = &SetPrivateRequest{}
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind req
+	if err := c.ShouldBind(req); err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
+	}
+	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
+
+	// process logic
+	grpcReq := &grpc_pb.SetPrivateRequest{
+		UserId:    proto.Int64(userId),
+		IsPrivate: proto.Bool(req.IsPrivate),
+	}
+
+	grpcResp, err := h.grpcClient.SetPrivate(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Set private successfully", toUserData(grpcResp.GetUser()))
+}
+
+func (h *Server) GetFollowRequests(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		api    = c.Request.Method + " " + c.Request.RequestURI
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind query param
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil || limit <= 0 {
+		h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "invalid limit query"))
+		return
+	}
+	cursor := c.Query("cursor") // opaque, validated by grpc server
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("limit", limit), logger.F("cursor", cursor))
+
+	// process logic
+	grpcReq := &grpc_pb.GetFollowRequestsRequest{
+		UserId: proto.Int64(userId),
+		Limit:  proto.Int64(int64(limit)),
+		Cursor: proto.String(cursor),
+	}
+
+	grpcResp, err := h.grpcClient.GetFollowRequests(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	data := &FollowRequestsData{
+		Requests:   make([]*FollowRequestData, 0, len(grpcResp.GetRequests())),
+		NextCursor: grpcResp.GetNextCursor(),
+	}
+	for _, request := range grpcResp.GetRequests() {
+		data.Requests = append(data.Requests, &FollowRequestData{
+			ID:               request.GetId(),
+			Requester:        toUserData(request.GetRequester()),
+			CreatedTimestamp: request.GetCreatedTimestamp(),
+			CreatedTime:      time.Unix(request.GetCreatedTimestamp(), 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	h.returnDataResp(c, "Get follow requests successfully", data)
+}
+
+// ApproveFollowRequest approves the request of the user in id param
+func (h *Server) ApproveFollowRequest(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	requesterId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("requester_id", requesterId))
+
+	// process logic
+	grpcReq := &grpc_pb.ApproveFollowRequestRequest{
+		UserId:      proto.Int64(userId),
+		RequesterId: proto.Int64(requesterId),
+	}
+
+	grpcResp, err := h.grpcClient.ApproveFollowRequest(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	f := grpcResp.GetFollow()
+	data := &FollowData{
+		Follower:        toUserData(f.GetFollower()),
+		FollowTimestamp: f.GetFollowTimestamp(),
+		FollowTime:      time.Unix(f.GetFollowTimestamp(), 0).Format("2006-01-02 15:04:05"),
+	}
+
+	h.returnDataResp(c, "Approve follow request successfully", data)
+}
+
+// RejectFollowRequest rejects the request of the user in id param
+func (h *Server) RejectFollowRequest(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	requesterId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("requester_id", requesterId))
+
+	// process logic
+	grpcReq := &grpc_pb.RejectFollowRequestRequest{
+		UserId:      proto.Int64(userId),
+		RequesterId: proto.Int64(requesterId),
+	}
+
+	_, err = h.grpcClient.RejectFollowRequest(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Reject follow request successfully", nil)
+}
+
+// CancelFollowRequest cancels the request of the current user to the user in id param
+func (h *Server) CancelFollowRequest(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	peerId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("peer_id", peerId))
+
+	// process logic
+	grpcReq := &grpc_pb.CancelFollowRequestRequest{
+		UserId: proto.Int64(userId),
+		PeerId: proto.Int64(peerId),
+	}
+
+	_, err = h.grpcClient.CancelFollowRequest(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Cancel follow request successfully", nil)
+}
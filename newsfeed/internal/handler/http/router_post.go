@@ -1,17 +1,46 @@
 package http
 
 import (
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
 
 	"ep.k16/newsfeed/internal/common"
 	grpc_pb "ep.k16/newsfeed/internal/handler/proto/grpc"
 	"ep.k16/newsfeed/pkg/logger"
 )
 
+const (
+	maxUploadRequestSize = 10 << 20 // media size limit is checked by grpc service, this only stops huge requests
+)
+
 type CreatePostRequest struct {
+	Content    string  `json:"content"`
+	MediaIDs   []int64 `json:"media_ids"`
+	Visibility string  `json:"visibility"` // public, followers, close_friends, empty for public
 }
 
 type PostData struct {
+	ID               int64          `json:"id"`
+	UserID           int64          `json:"user_id"`
+	Content          string         `json:"content"`
+	Visibility       string         `json:"visibility"`
+	Media            []*MediaData   `json:"media,omitempty"`
+	CommentCount     int64          `json:"comment_count"`
+	Reactions        *ReactionsData `json:"reactions,omitempty"`
+	CreatedTimestamp int64          `json:"created_ts"`
+	CreatedTime      string         `json:"created_time"`
+	Edited           bool           `json:"edited"`
+	EditedTimestamp  int64          `json:"edited_ts,omitempty"`
+	EditedTime       string         `json:"edited_time,omitempty"`
+
+	RankingScore float64 `json:"ranking_score,omitempty"` // only for newsfeed
 }
 
 func (h *Server) CreatePost(c *gin.Context) {
@@ -20,7 +49,442 @@ func (h *Server) CreatePost(c *gin.Context) {
 		req = &CreatePostRequest{}
 		api = c.Request.Method + " " + c.Request.RequestURI
 
-		// userId = c.GetInt64("user_id")
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind req
+	if err := c.ShouldBind(req); err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
+	}
+	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
+
+	// validate req
+	if err := validateCreatePostReq(req); err != nil {
+		validateErr := common.WrapError(common.CodeInvalidRequest, "invalid request", err)
+		h.returnErrResp(c, validateErr)
+		return
+	}
+
+	// process logic
+	grpcReq := &grpc_pb.CreatePostRequest{
+		UserId:     proto.Int64(userId),
+		Content:    proto.String(req.Content),
+		MediaIds:   req.MediaIDs,
+		Visibility: proto.String(req.Visibility),
+	}
+
+	grpcResp, err := h.grpcClient.CreatePost(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Create post successfully", toPostData(grpcResp.GetPost()))
+}
+
+type UpdatePostRequest struct {
+	Content string `json:"content"`
+}
+
+func (h *Server) UpdatePost(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = &UpdatePostRequest{}
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	postId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	// bind req
+	if err := c.ShouldBind(req); err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
+	}
+	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
+
+	// process logic
+	grpcReq := &grpc_pb.UpdatePostRequest{
+		UserId:  proto.Int64(userId),
+		PostId:  proto.Int64(postId),
+		Content: proto.String(req.Content),
+	}
+
+	grpcResp, err := h.grpcClient.UpdatePost(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Update post successfully", toPostData(grpcResp.GetPost()))
+}
+
+func (h *Server) DeletePost(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	postId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("post_id", postId))
+
+	// process logic
+	grpcReq := &grpc_pb.DeletePostRequest{
+		UserId: proto.Int64(userId),
+		PostId: proto.Int64(postId),
+	}
+
+	_, err = h.grpcClient.DeletePost(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Delete post successfully", nil)
+}
+
+type PostRevisionData struct {
+	ID               int64  `json:"id"`
+	PostID           int64  `json:"post_id"`
+	Content          string `json:"content"`
+	CreatedTimestamp int64  `json:"created_ts"`
+	CreatedTime      string `json:"created_time"`
+}
+
+type PostRevisionsData struct {
+	Revisions  []*PostRevisionData `json:"revisions"`
+	NextCursor string              `json:"next_cursor,omitempty"` // empty if there is no more revision
+}
+
+func (h *Server) ListPostRevisions(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		api    = c.Request.Method + " " + c.Request.RequestURI
+		userId = c.GetInt64("user_id")
+	)
+
+	postId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	// bind query param
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil || limit <= 0 {
+		h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "invalid limit query"))
+		return
+	}
+	cursor := c.Query("cursor") // opaque, validated by grpc server
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("post_id", postId), logger.F("limit", limit), logger.F("cursor", cursor))
+
+	// process logic
+	grpcReq := &grpc_pb.ListPostRevisionsRequest{
+		UserId: proto.Int64(userId),
+		PostId: proto.Int64(postId),
+		Limit:  proto.Int64(int64(limit)),
+		Cursor: proto.String(cursor),
+	}
+
+	grpcResp, err := h.grpcClient.ListPostRevisions(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	data := &PostRevisionsData{
+		Revisions:  make([]*PostRevisionData, 0, len(grpcResp.GetRevisions())),
+		NextCursor: grpcResp.GetNextCursor(),
+	}
+	for _, r := range grpcResp.GetRevisions() {
+		data.Revisions = append(data.Revisions, &PostRevisionData{
+			ID:               r.GetId(),
+			PostID:           r.GetPostId(),
+			Content:          r.GetContent(),
+			CreatedTimestamp: r.GetCreatedTimestamp(),
+			CreatedTime:      time.Unix(r.GetCreatedTimestamp(), 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	h.returnDataResp(c, "List post revisions successfully", data)
+}
+
+func validateCreatePostReq(req *CreatePostRequest) error {
+	if len(req.Content) == 0 && len(req.MediaIDs) == 0 {
+		return fmt.Errorf("content or media_ids is required")
+	}
+	return nil
+}
+
+func toPostData(post *grpc_pb.PostData) *PostData {
+	postData := &PostData{
+		ID:               post.GetId(),
+		UserID:           post.GetUserId(),
+		Content:          post.GetContent(),
+		Visibility:       post.GetVisibility(),
+		CommentCount:     post.GetCommentCount(),
+		Reactions:        toReactionsData(post.GetReactions()),
+		CreatedTimestamp: post.GetCreatedTimestamp(),
+		CreatedTime:      time.Unix(post.GetCreatedTimestamp(), 0).Format("2006-01-02 15:04:05"),
+	}
+	if post.GetEditedTimestamp() > 0 {
+		postData.Edited = true
+		postData.EditedTimestamp = post.GetEditedTimestamp()
+		postData.EditedTime = time.Unix(post.GetEditedTimestamp(), 0).Format("2006-01-02 15:04:05")
+	}
+	for _, media := range post.GetMedia() {
+		postData.Media = append(postData.Media, toMediaData(media))
+	}
+	return postData
+}
+
+type MediaData struct {
+	ID           int64  `json:"id"`
+	ContentType  string `json:"content_type"`
+	Size         int64  `json:"size"`
+	Width        int32  `json:"width"`
+	Height       int32  `json:"height"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+// UploadMedia receives a multipart form with the file in "file" field,
+// the returned media id is used to attach it to a new post
+func (h *Server) UploadMedia(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind req
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxUploadRequestSize)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
+	}
+	logger.Debug("parse request", logger.F("api", api), logger.F("filename", fileHeader.Filename), logger.F("size", fileHeader.Size))
+
+	data, err := readFormFile(fileHeader)
+	if err != nil {
+		h.returnErrResp(c, common.WrapError(common.CodeInvalidRequest, "failed to read file", err))
+		return
+	}
+
+	// process logic
+	grpcResp, err := h.grpcClient.UploadMedia(ctx, &grpc_pb.UploadMediaRequest{
+		UserId: proto.Int64(userId),
+		Data:   data,
+	})
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Upload media successfully", toMediaData(grpcResp.GetMedia()))
+}
+
+func readFormFile(fileHeader *multipart.FileHeader) ([]byte, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
+
+func toMediaData(media *grpc_pb.MediaData) *MediaData {
+	return &MediaData{
+		ID:           media.GetId(),
+		ContentType:  media.GetContentType(),
+		Size:         media.GetSize(),
+		Width:        media.GetWidth(),
+		Height:       media.GetHeight(),
+		URL:          media.GetUrl(),
+		ThumbnailURL: media.GetThumbnailUrl(),
+	}
+}
+
+type GetNewsfeedRequest struct {
+	Limit   int64  `json:"limit"`
+	Cursor  string `json:"cursor"` // next_cursor of previous page, empty for the first page
+	Ranking string `json:"ranking"`
+}
+
+type NewsfeedData struct {
+	Posts      []*PostData `json:"posts"`
+	NextCursor string      `json:"next_cursor,omitempty"` // empty if there is no more post
+}
+
+func (h *Server) GetNewsfeed(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		api    = c.Request.Method + " " + c.Request.RequestURI
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind query param
+	req, err := parseGetNewsfeedRequest(c)
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
+
+	grpcReq := &grpc_pb.GetNewsfeedRequest{
+		UserId:  proto.Int64(userId),
+		Limit:   proto.Int64(req.Limit),
+		Cursor:  proto.String(req.Cursor),
+		Ranking: proto.String(req.Ranking),
+	}
+
+	// process logic
+	grpcResp, err := h.grpcClient.GetNewsfeed(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	data := &NewsfeedData{
+		Posts:      make([]*PostData, 0, len(grpcResp.GetPosts())),
+		NextCursor: grpcResp.GetNextCursor(),
+	}
+	scores := grpcResp.GetRankingScores()
+	for i, p := range grpcResp.GetPosts() {
+		postData := toPostData(p)
+		if i < len(scores) {
+			postData.RankingScore = scores[i]
+		}
+		data.Posts = append(data.Posts, postData)
+	}
+
+	h.returnDataResp(c, "Get newsfeed successfully", data)
+}
+
+func parseGetNewsfeedRequest(c *gin.Context) (*GetNewsfeedRequest, error) {
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil || limit <= 0 {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid limit query")
+	}
+
+	return &GetNewsfeedRequest{
+		Limit:   int64(limit),
+		Cursor:  c.Query("cursor"),
+		Ranking: c.Query("ranking"),
+	}, nil
+}
+
+type GetPostsRequest struct {
+	Limit  int64  `json:"limit"`
+	Cursor string `json:"cursor"` // next_cursor of previous page, empty for the first page
+}
+
+type PostsData struct {
+	Posts      []*PostData `json:"posts"`
+	NextCursor string      `json:"next_cursor,omitempty"` // empty if there is no more post
+}
+
+// GetPosts returns posts of the user in path, seen by the logged-in user
+func (h *Server) GetPosts(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		api    = c.Request.Method + " " + c.Request.RequestURI
+		userId = c.GetInt64("user_id")
+	)
+
+	authorId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	// bind query param
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil || limit <= 0 {
+		h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "invalid limit query"))
+		return
+	}
+	req := &GetPostsRequest{
+		Limit:  int64(limit),
+		Cursor: c.Query("cursor"),
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
+
+	// process logic
+	grpcReq := &grpc_pb.GetPostsRequest{
+		ViewerId: proto.Int64(userId),
+		UserId:   proto.Int64(authorId),
+		Limit:    proto.Int64(req.Limit),
+		Cursor:   proto.String(req.Cursor),
+	}
+
+	grpcResp, err := h.grpcClient.GetPosts(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	data := &PostsData{
+		Posts:      make([]*PostData, 0, len(grpcResp.GetPosts())),
+		NextCursor: grpcResp.GetNextCursor(),
+	}
+	for _, p := range grpcResp.GetPosts() {
+		data.Posts = append(data.Posts, toPostData(p))
+	}
+
+	h.returnDataResp(c, "Get posts successfully", data)
+}
+
+type ReactPostRequest struct {
+	ReactionType string `json:"reaction_type"` // like, love, haha, wow, sad, angry
+}
+
+type ReactionsData struct {
+	Counts         map[string]int64 `json:"counts"`
+	Total          int64            `json:"total"`
+	ViewerReaction string           `json:"viewer_reaction,omitempty"`
+}
+
+func (h *Server) ReactPost(c *gin.Context) {
+	var (
+		req = &ReactPostRequest{}
+		api = c.Request.Method + " " + c.Request.RequestURI
 	)
 
 	// bind req
@@ -31,12 +495,40 @@ func (h *Server) CreatePost(c *gin.Context) {
 	}
 	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
 
-	// validate req etc ...
+	// validate req
+	if len(req.ReactionType) == 0 {
+		h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "reaction_type is required"))
+		return
+	}
+
+	h.setReaction(c, req.ReactionType)
+}
+
+func (h *Server) UnreactPost(c *gin.Context) {
+	h.setReaction(c, "")
+}
+
+// setReaction sets reaction of the logged-in user on the post in path, empty reactionType removes it
+func (h *Server) setReaction(c *gin.Context, reactionType string) {
+	var (
+		ctx    = c.Request.Context()
+		userId = c.GetInt64("user_id")
+	)
+
+	postId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
 
 	// process logic
-	grpcReq := &grpc_pb.CreatePostRequest{}
+	grpcReq := &grpc_pb.ReactPostRequest{
+		UserId:       proto.Int64(userId),
+		PostId:       proto.Int64(postId),
+		ReactionType: proto.String(reactionType),
+	}
 
-	_, err := h.grpcClient.CreatePost(ctx, grpcReq)
+	grpcResp, err := h.grpcClient.ReactPost(ctx, grpcReq)
 	if err != nil {
 		appErr := common.FromGRPCError(err)
 		h.returnErrResp(c, appErr)
@@ -44,5 +536,20 @@ func (h *Server) CreatePost(c *gin.Context) {
 	}
 
 	// process response
-	h.returnDataResp(c, "Create post successfully", map[string]interface{}{"TODO": "TODO"})
+	h.returnDataResp(c, "React post successfully", toReactionsData(grpcResp.GetReactions()))
+}
+
+func toReactionsData(reactions *grpc_pb.ReactionsData) *ReactionsData {
+	if reactions == nil {
+		return nil
+	}
+	data := &ReactionsData{
+		Counts:         make(map[string]int64, len(reactions.GetCounts())),
+		Total:          reactions.GetTotal(),
+		ViewerReaction: reactions.GetViewerReaction(),
+	}
+	for _, count := range reactions.GetCounts() {
+		data.Counts[count.GetReactionType()] = count.GetCount()
+	}
+	return data
 }
@@ -0,0 +1,42 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+
+	"ep.k16/newsfeed/internal/common"
+	grpc_pb "ep.k16/newsfeed/internal/handler/proto/grpc"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+func (h *Server) GetProfile(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		api    = c.Request.Method + " " + c.Request.RequestURI
+		userId = c.GetInt64("user_id")
+	)
+
+	profileId, err := parseIdParam(c, "id")
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("profile_id", profileId))
+
+	// process logic
+	grpcReq := &grpc_pb.GetProfileRequest{
+		ViewerId: proto.Int64(userId),
+		UserId:   proto.Int64(profileId),
+	}
+
+	grpcResp, err := h.grpcClient.GetProfile(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Get profile successfully", toUserData(grpcResp.GetUser()))
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+
+	"ep.k16/newsfeed/internal/common"
+	grpc_pb "ep.k16/newsfeed/internal/handler/proto/grpc"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+type RelationshipData struct {
+	UserID     int64 `json:"user_id"`
+	Following  bool  `json:"following"`   // you follow user
+	FollowedBy bool  `json:"followed_by"` // user follows you
+	Blocking   bool  `json:"blocking"`    // you blocked user
+	BlockedBy  bool  `json:"blocked_by"`  // user blocked you
+}
+
+type RelationshipsData struct {
+	Relationships []*RelationshipData `json:"relationships"`
+}
+
+func (h *Server) GetRelationships(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		api    = c.Request.Method + " " + c.Request.RequestURI
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind query param, ids are separated by comma
+	var peerIds []int64
+	for _, idStr := range strings.Split(c.Query("ids"), ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(idStr), 10, 64)
+		if err != nil || id <= 0 {
+			h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "invalid ids query"))
+			return
+		}
+		peerIds = append(peerIds, id)
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("ids", peerIds))
+
+	// process logic
+	grpcReq := &grpc_pb.GetRelationshipsRequest{
+		ViewerId: proto.Int64(userId),
+		UserIds:  peerIds,
+	}
+
+	grpcResp, err := h.grpcClient.GetRelationships(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	data := &RelationshipsData{
+		Relationships: make([]*RelationshipData, 0, len(grpcResp.GetRelationships())),
+	}
+	for _, relationship := range grpcResp.GetRelationships() {
+		data.Relationships = append(data.Relationships, &RelationshipData{
+			UserID:     relationship.GetUserId(),
+			Following:  relationship.GetFollowing(),
+			FollowedBy: relationship.GetFollowedBy(),
+			Blocking:   relationship.GetBlocking(),
+			BlockedBy:  relationship.GetBlockedBy(),
+		})
+	}
+
+	h.returnDataResp(c, "Get relationships successfully", data)
+}
@@ -0,0 +1,68 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+
+	"ep.k16/newsfeed/internal/common"
+	grpc_pb "ep.k16/newsfeed/internal/handler/proto/grpc"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+type SuggestionData struct {
+	User             *UserData `json:"user"`
+	Score            float64   `json:"score"`
+	MutualFollowings int64     `json:"mutual_followings"`
+	MutualFollowers  int64     `json:"mutual_followers"`
+}
+
+type SuggestionsData struct {
+	Suggestions []*SuggestionData `json:"suggestions"`
+}
+
+func (h *Server) GetSuggestions(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		api    = c.Request.Method + " " + c.Request.RequestURI
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind query param
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil || limit <= 0 {
+		h.returnErrResp(c, common.NewError(common.CodeInvalidRequest, "invalid limit query"))
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("limit", limit))
+
+	// process logic
+	grpcReq := &grpc_pb.GetSuggestionsRequest{
+		UserId: proto.Int64(userId),
+		Limit:  proto.Int64(int64(limit)),
+	}
+
+	grpcResp, err := h.grpcClient.GetSuggestions(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	data := &SuggestionsData{
+		Suggestions: make([]*SuggestionData, 0, len(grpcResp.GetSuggestions())),
+	}
+	for _, suggestion := range grpcResp.GetSuggestions() {
+		data.Suggestions = append(data.Suggestions, &SuggestionData{
+			User:             toUserData(suggestion.GetUser()),
+			Score:            suggestion.GetScore(),
+			MutualFollowings: suggestion.GetMutualFollowings(),
+			MutualFollowers:  suggestion.GetMutualFollowers(),
+		})
+	}
+
+	h.returnDataResp(c, "Get suggestions successfully", data)
+}
@@ -21,6 +21,7 @@ type FollowData struct {
 	Following       *UserData `json:"following,omitempty"` // optional
 	FollowTimestamp int64     `json:"follow_ts"`
 	FollowTime      string    `json:"follow_time"`
+	Pending         bool      `json:"pending,omitempty"` // following user is private and has not approved the follow yet
 }
 
 func (h *Server) Follow(c *gin.Context) {
@@ -56,33 +57,116 @@ func (h *Server) Follow(c *gin.Context) {
 	}
 
 	// process response
-	data := &FollowData{}
-	data.Following = &UserData{
-		ID:          grpcResp.GetFollowing().GetId(),
-		Username:    grpcResp.GetFollowing().GetUserName(),
-		Email:       grpcResp.GetFollowing().GetEmail(),
-		DisplayName: grpcResp.GetFollowing().GetDisplayName(),
-		Dob:         grpcResp.GetFollowing().GetDob(),
+	data := &FollowData{
+		Following: toUserData(grpcResp.GetFollowing()),
+		Pending:   !grpcResp.GetIsFollowed(),
+	}
+	if data.Pending {
+		h.returnDataResp(c, "Request to follow successfully", data)
+		return
 	}
 
 	h.returnDataResp(c, "Follow successfully", data)
 }
 
+func (h *Server) Unfollow(c *gin.Context) {
+	var (
+		ctx = c.Request.Context()
+		req = &FollowRequest{}
+		api = c.Request.Method + " " + c.Request.RequestURI
+
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind req
+	if err := c.ShouldBind(req); err != nil {
+		bindErr := common.WrapError(common.CodeInvalidRequest, "bind request error", err)
+		h.returnErrResp(c, bindErr)
+		return
+	}
+	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
+
+	// process logic
+	grpcReq := &grpc_pb.UnfollowRequest{
+		UserId: proto.Int64(userId),
+		PeerId: proto.Int64(req.PeerId),
+	}
+
+	_, err := h.grpcClient.Unfollow(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	h.returnDataResp(c, "Unfollow successfully", nil)
+}
+
 type GetFollowersRequest struct {
-	// paging
+	Limit  int64  `json:"limit"`
+	Cursor string `json:"cursor"` // next_cursor of previous page, empty for the first page
+}
+
+type FollowersData struct {
+	Followers  []*FollowData `json:"followers"`
+	NextCursor string        `json:"next_cursor,omitempty"` // empty if there is no more follower
 }
 
 func (h *Server) GetFollowers(c *gin.Context) {
+	var (
+		ctx    = c.Request.Context()
+		api    = c.Request.Method + " " + c.Request.RequestURI
+		userId = c.GetInt64("user_id")
+	)
+
+	// bind query param
+	req, err := parseGetFollowersRequest(c)
+	if err != nil {
+		h.returnErrResp(c, err)
+		return
+	}
+
+	logger.Debug("parse request", logger.F("api", api), logger.F("req", req))
 
+	// process logic
+	grpcReq := &grpc_pb.GetFollowersRequest{
+		UserId: proto.Int64(userId),
+		Limit:  proto.Int64(req.Limit),
+		Cursor: proto.String(req.Cursor),
+	}
+
+	grpcResp, err := h.grpcClient.GetFollowers(ctx, grpcReq)
+	if err != nil {
+		appErr := common.FromGRPCError(err)
+		h.returnErrResp(c, appErr)
+		return
+	}
+
+	// process response
+	data := &FollowersData{
+		Followers:  make([]*FollowData, 0, len(grpcResp.GetFollowers())),
+		NextCursor: grpcResp.GetNextCursor(),
+	}
+	for _, f := range grpcResp.GetFollowers() {
+		data.Followers = append(data.Followers, &FollowData{
+			Follower:        toUserData(f.GetFollower()),
+			FollowTimestamp: f.GetFollowTimestamp(),
+			FollowTime:      time.Unix(f.GetFollowTimestamp(), 0).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	h.returnDataResp(c, "Get followers successfully", data)
 }
 
 type GetFollowingsRequest struct {
-	Limit     int64 `json:"limit"`
-	LastValue int64 `json:"last_value"`
+	Limit  int64  `json:"limit"`
+	Cursor string `json:"cursor"` // next_cursor of previous page, empty for the first page
 }
 
 type FollowingsData struct {
 	Followings []*FollowData `json:"followings"`
+	NextCursor string        `json:"next_cursor,omitempty"` // empty if there is no more following
 }
 
 func (h *Server) GetFollowings(c *gin.Context) {
@@ -106,10 +190,8 @@ func (h *Server) GetFollowings(c *gin.Context) {
 	// process logic
 	grpcReq := &grpc_pb.GetFollowingsRequest{
 		UserId: proto.Int64(userId),
-		Paging: &grpc_pb.FollowPaging{
-			Limit:     proto.Int64(req.Limit),
-			LastValue: proto.Int64(req.LastValue),
-		},
+		Limit:  proto.Int64(req.Limit),
+		Cursor: proto.String(req.Cursor),
 	}
 
 	grpcResp, err := h.grpcClient.GetFollowings(ctx, grpcReq)
@@ -120,7 +202,9 @@ func (h *Server) GetFollowings(c *gin.Context) {
 	}
 
 	// process response
-	data := &FollowingsData{}
+	data := &FollowingsData{
+		NextCursor: grpcResp.GetNextCursor(),
+	}
 	for _, f := range grpcResp.GetFollowings() {
 		data.Followings = append(data.Followings, &FollowData{
 			Following: &UserData{
@@ -140,17 +224,24 @@ func (h *Server) GetFollowings(c *gin.Context) {
 
 func parseGetFollowingsRequest(c *gin.Context) (*GetFollowingsRequest, error) {
 	limit, err := strconv.Atoi(c.Query("limit"))
-	if err != nil {
+	if err != nil || limit <= 0 {
 		return nil, common.NewError(common.CodeInvalidRequest, "invalid limit query")
 	}
 
-	lastValue, err := strconv.Atoi(c.Query("last_value"))
-	if err != nil {
-		return nil, common.NewError(common.CodeInvalidRequest, "invalid last_value query")
+	return &GetFollowingsRequest{
+		Limit:  int64(limit),
+		Cursor: c.Query("cursor"),
+	}, nil
+}
+
+func parseGetFollowersRequest(c *gin.Context) (*GetFollowersRequest, error) {
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil || limit <= 0 {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid limit query")
 	}
 
-	return &GetFollowingsRequest{
-		Limit:     int64(limit),
-		LastValue: int64(lastValue),
+	return &GetFollowersRequest{
+		Limit:  int64(limit),
+		Cursor: c.Query("cursor"),
 	}, nil
 }
@@ -5,18 +5,31 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	"ep.k16/newsfeed/config"
 	grpc_pb "ep.k16/newsfeed/internal/handler/proto/grpc"
+	"ep.k16/newsfeed/pkg/keyring"
 	"ep.k16/newsfeed/pkg/logger"
 )
 
 type Config struct {
-	Host   string
-	Port   int
-	JwtKey []byte
+	Host string
+	Port int
+
+	JwtKeyDir            string        // dir of keys signing access tokens, see keyring.Keyring
+	JwtKeyReloadInterval time.Duration // keys are reloaded from dir, so they rotate without restart
+
+	AccessTokenTTL time.Duration // lifetime of access tokens, clients renew them with refresh tokens
+
+	// DevAuth lets requests impersonate any user through DevUserHeader instead of a token.
+	// It is for local development and tests only, New refuses to enable it unless ENV is local.
+	DevAuth bool
+
+	MediaDir string // local dir of uploaded media, empty if media is served by another host
 }
 
 func verifyConfig(cfg Config) error {
@@ -26,9 +39,21 @@ func verifyConfig(cfg Config) error {
 	if cfg.Port <= 0 {
 		return errors.New("port is required")
 	}
+	if len(cfg.JwtKeyDir) == 0 {
+		return errors.New("jwt key dir is required")
+	}
+	// checked here too, so a caller building config by itself cannot turn it on outside local env
+	if cfg.DevAuth && !config.IsLocalEnv() {
+		return fmt.Errorf("dev auth is only allowed with %s=%s", config.EnvKey, config.EnvTypeLocal)
+	}
 	return nil
 }
 
+const (
+	defaultAccessTokenTTL       = 15 * time.Minute
+	defaultJwtKeyReloadInterval = time.Minute
+)
+
 type Server struct {
 	config Config
 
@@ -36,6 +61,8 @@ type Server struct {
 	router     *gin.Engine
 
 	grpcClient grpc_pb.ServiceClient
+
+	keyring *keyring.Keyring
 }
 
 func New(config Config, grpcClient grpc_pb.ServiceClient) (*Server, error) {
@@ -45,9 +72,26 @@ func New(config Config, grpcClient grpc_pb.ServiceClient) (*Server, error) {
 		return nil, fmt.Errorf("invalid http config: %s", err)
 	}
 
+	if config.AccessTokenTTL <= 0 {
+		config.AccessTokenTTL = defaultAccessTokenTTL
+	}
+	if config.JwtKeyReloadInterval <= 0 {
+		config.JwtKeyReloadInterval = defaultJwtKeyReloadInterval
+	}
+
+	jwtKeyring, err := keyring.Load(config.JwtKeyDir)
+	if err != nil {
+		logger.Error("failed to load jwt keys", logger.E(err))
+		return nil, fmt.Errorf("failed to load jwt keys: %w", err)
+	}
+
 	h := &Server{
 		config:     config,
 		grpcClient: grpcClient,
+		keyring:    jwtKeyring,
+	}
+	if h.config.DevAuth {
+		logger.Warn("dev auth is enabled, any user can be impersonated by " + DevUserHeader + " header")
 	}
 
 	// init gin handlers
@@ -58,18 +102,68 @@ func New(config Config, grpcClient grpc_pb.ServiceClient) (*Server, error) {
 	userRouter := router.Group("/grpc")
 	userRouter.POST("/signup", h.Signup)
 	userRouter.POST("/login", h.Login)
+	userRouter.POST("/refresh", h.Refresh)
+	userRouter.POST("/logout", h.JWTMiddleware(), h.Logout)
+	userRouter.POST("/logout-all", h.JWTMiddleware(), h.LogoutAll)
+	userRouter.POST("/password/request-reset", h.RequestPasswordReset)
+	userRouter.POST("/password/confirm-reset", h.ConfirmPasswordReset)
+	userRouter.POST("/email/verify", h.VerifyEmail)
+	userRouter.POST("/email/resend-verification", h.JWTMiddleware(), h.ResendEmailVerification)
 
 	userMeRouter := userRouter.Group("/me")
 	userMeRouter.Use(h.JWTMiddleware())
+	userMeRouter.PUT("/private", h.SetPrivate)
 	userMeRouter.POST("/follow", h.Follow)
+	userMeRouter.POST("/unfollow", h.Unfollow)
 	userMeRouter.GET("/followers", h.GetFollowers)
 	userMeRouter.GET("/followings", h.GetFollowings)
+	userMeRouter.GET("/follow-requests", h.GetFollowRequests)
+	userMeRouter.POST("/follow-requests/:id/approve", h.ApproveFollowRequest)
+	userMeRouter.DELETE("/follow-requests/:id", h.RejectFollowRequest)
+	userMeRouter.DELETE("/sent-follow-requests/:id", h.CancelFollowRequest)
+	userMeRouter.POST("/close-friends", h.AddCloseFriend)
+	userMeRouter.GET("/close-friends", h.GetCloseFriends)
+	userMeRouter.DELETE("/close-friends/:id", h.RemoveCloseFriend)
+	userMeRouter.POST("/blocks", h.Block)
+	userMeRouter.DELETE("/blocks/:id", h.Unblock)
+	userMeRouter.POST("/mutes", h.Mute)
+	userMeRouter.DELETE("/mutes/:id", h.Unmute)
+	userMeRouter.GET("/suggestions", h.GetSuggestions)
+	userMeRouter.GET("/relationships", h.GetRelationships)
+
+	userIdRouter := userRouter.Group("/users")
+	userIdRouter.Use(h.JWTMiddleware())
+	userIdRouter.GET("/:id", h.GetProfile)
 
 	postRouter := router.Group("/post")
 	postMeRouter := postRouter.Group("/me")
 	postMeRouter.Use(h.JWTMiddleware())
 	postMeRouter.POST("/", h.CreatePost)
+	postMeRouter.POST("/media", h.UploadMedia)
+	postMeRouter.PUT("/:id", h.UpdatePost)
+	postMeRouter.DELETE("/:id", h.DeletePost)
+	postMeRouter.GET("/:id/revisions", h.ListPostRevisions)
+	postMeRouter.GET("/newsfeed", h.GetNewsfeed)
+
+	postUserRouter := postRouter.Group("/user")
+	postUserRouter.Use(h.JWTMiddleware())
+	postUserRouter.GET("/:id", h.GetPosts)
+
+	postIdRouter := postRouter.Group("/:id")
+	postIdRouter.Use(h.JWTMiddleware())
+	postIdRouter.PUT("/reaction", h.ReactPost)
+	postIdRouter.DELETE("/reaction", h.UnreactPost)
+
+	commentRouter := postIdRouter.Group("/comments")
+	commentRouter.POST("", h.CreateComment)
+	commentRouter.GET("", h.ListComments)
+	commentRouter.DELETE("/:comment_id", h.DeleteComment)
+
+	if len(h.config.MediaDir) > 0 {
+		router.Static("/media", h.config.MediaDir)
+	}
 
+	router.GET("/.well-known/jwks.json", h.GetJWKS)
 	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
 	h.router = router
@@ -86,9 +180,11 @@ func New(config Config, grpcClient grpc_pb.ServiceClient) (*Server, error) {
 }
 
 func (h *Server) Start() error {
+	go h.keyring.Watch(h.config.JwtKeyReloadInterval)
 	return h.httpServer.ListenAndServe()
 }
 
 func (h *Server) Stop() error {
+	defer h.keyring.Stop()
 	return h.httpServer.Shutdown(context.Background())
 }
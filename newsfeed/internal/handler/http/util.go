@@ -15,11 +15,14 @@ var errCodeToHttpCodeMap = map[common.ErrorCode]int{
 	// 1xx: client error
 	common.CodeInvalidRequest: http.StatusBadRequest,
 	common.CodeUnauthorized:   http.StatusUnauthorized,
+	common.CodeNotFound:       http.StatusNotFound,
+	common.CodeForbidden:      http.StatusForbidden,
 	// 2xx: biz err
 	common.CodeInvalidLogin:       http.StatusBadRequest,
 	common.CodeExistedUsername:    http.StatusBadRequest,
 	common.CodeNotExistedUsername: http.StatusBadRequest,
 	common.CodeNotExistedUserID:   http.StatusBadRequest,
+	common.CodeEmailNotVerified:   http.StatusForbidden,
 
 	// 9xx: internal error
 	common.CodeInternal:       http.StatusInternalServerError,
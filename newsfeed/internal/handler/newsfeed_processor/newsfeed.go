@@ -2,6 +2,7 @@ package newsfeed_processor
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -14,8 +15,13 @@ import (
 
 type NewsfeedService interface {
 	AppendPostToNewsfeed(ctx context.Context, post *model.Post) error
+	RemovePostFromNewsfeed(ctx context.Context, post *model.Post) error
+	RemoveUserPostsFromNewsfeed(ctx context.Context, userId, authorId int64) error
 }
 
+// eventHeaderKey is the message header set by kafka_producer, messages without it are created posts
+const eventHeaderKey = "event"
+
 type NewsfeedBuilder struct {
 	cfg Config
 
@@ -135,7 +141,7 @@ func (h *postMsgHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim
 				logger.F("ts", message.Timestamp),
 			}
 
-			err := h.newsfeedService.AppendPostToNewsfeed(context.Background(), &model.Post{})
+			err := h.processMessage(context.Background(), message)
 			if err != nil {
 				logFields = append(logFields, logger.E(err), logger.F("latency", time.Since(start)))
 				logger.Error("failed to process message", logFields...)
@@ -151,3 +157,60 @@ func (h *postMsgHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim
 		}
 	}
 }
+
+func (h *postMsgHandler) processMessage(ctx context.Context, message *sarama.ConsumerMessage) error {
+	// a malformed message can never be processed, so we log and skip it instead of retrying
+	switch event := getEvent(message); event {
+	case model.PostEventCreated:
+		post, err := decodePost(message.Value)
+		if err != nil {
+			return err
+		}
+		return h.newsfeedService.AppendPostToNewsfeed(ctx, post)
+	case model.PostEventDeleted:
+		post, err := decodePost(message.Value)
+		if err != nil {
+			return err
+		}
+		return h.newsfeedService.RemovePostFromNewsfeed(ctx, post)
+	case model.FollowEventUnfollowed:
+		follow, err := decodeFollow(message.Value)
+		if err != nil {
+			return err
+		}
+		return h.newsfeedService.RemoveUserPostsFromNewsfeed(ctx, follow.Follower.ID, follow.Following.ID)
+	default:
+		return fmt.Errorf("unknown event: %s", event)
+	}
+}
+
+// getEvent returns the event of message, default created for messages sent before events were added
+func getEvent(message *sarama.ConsumerMessage) string {
+	for _, header := range message.Headers {
+		if header != nil && string(header.Key) == eventHeaderKey {
+			return string(header.Value)
+		}
+	}
+	return model.PostEventCreated
+}
+
+// decodePost decodes post message sent by kafka_producer.SendPost and kafka_producer.SendPostDeletion
+func decodePost(data []byte) (*model.Post, error) {
+	post := &model.Post{}
+	if err := json.Unmarshal(data, post); err != nil {
+		return nil, fmt.Errorf("failed to decode post message: %s", err)
+	}
+	return post, nil
+}
+
+// decodeFollow decodes follow message sent by kafka_producer.SendUnfollow
+func decodeFollow(data []byte) (*model.Follow, error) {
+	follow := &model.Follow{}
+	if err := json.Unmarshal(data, follow); err != nil {
+		return nil, fmt.Errorf("failed to decode follow message: %s", err)
+	}
+	if follow.Follower == nil || follow.Following == nil {
+		return nil, errors.New("follow message misses follower or following")
+	}
+	return follow, nil
+}
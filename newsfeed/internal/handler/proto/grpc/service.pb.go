@@ -28,6 +28,9 @@ type UserData struct {
 	DisplayName   *string                `protobuf:"bytes,3,req,name=display_name,json=displayName" json:"display_name,omitempty"`
 	Email         *string                `protobuf:"bytes,4,req,name=email" json:"email,omitempty"`
 	Dob           *string                `protobuf:"bytes,5,req,name=dob" json:"dob,omitempty"`
+	IsPrivate     *bool                  `protobuf:"varint,6,opt,name=is_private,json=isPrivate" json:"is_private,omitempty"` // followers must be approved
+	Counts        *UserCountsData        `protobuf:"bytes,7,opt,name=counts" json:"counts,omitempty"`                         // only set by profile and login
+	EmailVerified *bool                  `protobuf:"varint,8,opt,name=email_verified,json=emailVerified" json:"email_verified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -97,6 +100,87 @@ func (x *UserData) GetDob() string {
 	return ""
 }
 
+func (x *UserData) GetIsPrivate() bool {
+	if x != nil && x.IsPrivate != nil {
+		return *x.IsPrivate
+	}
+	return false
+}
+
+func (x *UserData) GetCounts() *UserCountsData {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *UserData) GetEmailVerified() bool {
+	if x != nil && x.EmailVerified != nil {
+		return *x.EmailVerified
+	}
+	return false
+}
+
+type UserCountsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Followers     *int64                 `protobuf:"varint,1,req,name=followers" json:"followers,omitempty"`
+	Followings    *int64                 `protobuf:"varint,2,req,name=followings" json:"followings,omitempty"`
+	Posts         *int64                 `protobuf:"varint,3,req,name=posts" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserCountsData) Reset() {
+	*x = UserCountsData{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCountsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCountsData) ProtoMessage() {}
+
+func (x *UserCountsData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCountsData.ProtoReflect.Descriptor instead.
+func (*UserCountsData) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserCountsData) GetFollowers() int64 {
+	if x != nil && x.Followers != nil {
+		return *x.Followers
+	}
+	return 0
+}
+
+func (x *UserCountsData) GetFollowings() int64 {
+	if x != nil && x.Followings != nil {
+		return *x.Followings
+	}
+	return 0
+}
+
+func (x *UserCountsData) GetPosts() int64 {
+	if x != nil && x.Posts != nil {
+		return *x.Posts
+	}
+	return 0
+}
+
 type FollowData struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Follower        *UserData              `protobuf:"bytes,1,opt,name=follower" json:"follower,omitempty"`
@@ -108,7 +192,7 @@ type FollowData struct {
 
 func (x *FollowData) Reset() {
 	*x = FollowData{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[1]
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -120,7 +204,7 @@ func (x *FollowData) String() string {
 func (*FollowData) ProtoMessage() {}
 
 func (x *FollowData) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[1]
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -133,7 +217,7 @@ func (x *FollowData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FollowData.ProtoReflect.Descriptor instead.
 func (*FollowData) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{1}
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *FollowData) GetFollower() *UserData {
@@ -170,7 +254,7 @@ type SignupRequest struct {
 
 func (x *SignupRequest) Reset() {
 	*x = SignupRequest{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[2]
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -182,7 +266,7 @@ func (x *SignupRequest) String() string {
 func (*SignupRequest) ProtoMessage() {}
 
 func (x *SignupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[2]
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -195,7 +279,7 @@ func (x *SignupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignupRequest.ProtoReflect.Descriptor instead.
 func (*SignupRequest) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{2}
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SignupRequest) GetUserName() string {
@@ -242,7 +326,7 @@ type SignupResponse struct {
 
 func (x *SignupResponse) Reset() {
 	*x = SignupResponse{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[3]
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -254,7 +338,7 @@ func (x *SignupResponse) String() string {
 func (*SignupResponse) ProtoMessage() {}
 
 func (x *SignupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[3]
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +351,7 @@ func (x *SignupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignupResponse.ProtoReflect.Descriptor instead.
 func (*SignupResponse) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{3}
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SignupResponse) GetUser() *UserData {
@@ -287,7 +371,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[4]
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -299,7 +383,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[4]
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +396,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{4}
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LoginRequest) GetUserName() string {
@@ -332,13 +416,14 @@ func (x *LoginRequest) GetPassword() string {
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *UserData              `protobuf:"bytes,1,req,name=user" json:"user,omitempty"`
+	Session       *SessionData           `protobuf:"bytes,2,req,name=session" json:"session,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[5]
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -350,7 +435,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[5]
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,7 +448,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{5}
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LoginResponse) GetUser() *UserData {
@@ -373,31 +458,37 @@ func (x *LoginResponse) GetUser() *UserData {
 	return nil
 }
 
-type UserUserData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *int64                 `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
-	FollowerId    *int64                 `protobuf:"varint,2,req,name=follower_id,json=followerId" json:"follower_id,omitempty"`
-	FollowingId   *int64                 `protobuf:"varint,3,req,name=following_id,json=followingId" json:"following_id,omitempty"`
-	FollowTs      *int64                 `protobuf:"varint,4,req,name=follow_ts,json=followTs" json:"follow_ts,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LoginResponse) GetSession() *SessionData {
+	if x != nil {
+		return x.Session
+	}
+	return nil
 }
 
-func (x *UserUserData) Reset() {
-	*x = UserUserData{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[6]
+type SessionData struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              *string                `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	RefreshToken    *string                `protobuf:"bytes,2,req,name=refresh_token,json=refreshToken" json:"refresh_token,omitempty"`
+	RefreshExpireTs *int64                 `protobuf:"varint,3,req,name=refresh_expire_ts,json=refreshExpireTs" json:"refresh_expire_ts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SessionData) Reset() {
+	*x = SessionData{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserUserData) String() string {
+func (x *SessionData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserUserData) ProtoMessage() {}
+func (*SessionData) ProtoMessage() {}
 
-func (x *UserUserData) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[6]
+func (x *SessionData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,62 +499,54 @@ func (x *UserUserData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserUserData.ProtoReflect.Descriptor instead.
-func (*UserUserData) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SessionData.ProtoReflect.Descriptor instead.
+func (*SessionData) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UserUserData) GetId() int64 {
+func (x *SessionData) GetId() string {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
-	return 0
-}
-
-func (x *UserUserData) GetFollowerId() int64 {
-	if x != nil && x.FollowerId != nil {
-		return *x.FollowerId
-	}
-	return 0
+	return ""
 }
 
-func (x *UserUserData) GetFollowingId() int64 {
-	if x != nil && x.FollowingId != nil {
-		return *x.FollowingId
+func (x *SessionData) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
 	}
-	return 0
+	return ""
 }
 
-func (x *UserUserData) GetFollowTs() int64 {
-	if x != nil && x.FollowTs != nil {
-		return *x.FollowTs
+func (x *SessionData) GetRefreshExpireTs() int64 {
+	if x != nil && x.RefreshExpireTs != nil {
+		return *x.RefreshExpireTs
 	}
 	return 0
 }
 
-type FollowRequest struct {
+type RefreshSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
-	PeerId        *int64                 `protobuf:"varint,2,req,name=peer_id,json=peerId" json:"peer_id,omitempty"`
+	RefreshToken  *string                `protobuf:"bytes,1,req,name=refresh_token,json=refreshToken" json:"refresh_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FollowRequest) Reset() {
-	*x = FollowRequest{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[7]
+func (x *RefreshSessionRequest) Reset() {
+	*x = RefreshSessionRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowRequest) String() string {
+func (x *RefreshSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowRequest) ProtoMessage() {}
+func (*RefreshSessionRequest) ProtoMessage() {}
 
-func (x *FollowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[7]
+func (x *RefreshSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,49 +557,41 @@ func (x *FollowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
-func (*FollowRequest) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *FollowRequest) GetUserId() int64 {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
-	}
-	return 0
+// Deprecated: Use RefreshSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *FollowRequest) GetPeerId() int64 {
-	if x != nil && x.PeerId != nil {
-		return *x.PeerId
+func (x *RefreshSessionRequest) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
 	}
-	return 0
+	return ""
 }
 
-type FollowResponse struct {
+type RefreshSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsFollowed    *bool                  `protobuf:"varint,1,req,name=is_followed,json=isFollowed" json:"is_followed,omitempty"`
-	Pair          *UserUserData          `protobuf:"bytes,2,req,name=pair" json:"pair,omitempty"`
-	Following     *UserData              `protobuf:"bytes,3,req,name=following" json:"following,omitempty"`
+	User          *UserData              `protobuf:"bytes,1,req,name=user" json:"user,omitempty"`
+	Session       *SessionData           `protobuf:"bytes,2,req,name=session" json:"session,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FollowResponse) Reset() {
-	*x = FollowResponse{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[8]
+func (x *RefreshSessionResponse) Reset() {
+	*x = RefreshSessionResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowResponse) String() string {
+func (x *RefreshSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowResponse) ProtoMessage() {}
+func (*RefreshSessionResponse) ProtoMessage() {}
 
-func (x *FollowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[8]
+func (x *RefreshSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,55 +602,48 @@ func (x *FollowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowResponse.ProtoReflect.Descriptor instead.
-func (*FollowResponse) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *FollowResponse) GetIsFollowed() bool {
-	if x != nil && x.IsFollowed != nil {
-		return *x.IsFollowed
-	}
-	return false
+// Deprecated: Use RefreshSessionResponse.ProtoReflect.Descriptor instead.
+func (*RefreshSessionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *FollowResponse) GetPair() *UserUserData {
+func (x *RefreshSessionResponse) GetUser() *UserData {
 	if x != nil {
-		return x.Pair
+		return x.User
 	}
 	return nil
 }
 
-func (x *FollowResponse) GetFollowing() *UserData {
+func (x *RefreshSessionResponse) GetSession() *SessionData {
 	if x != nil {
-		return x.Following
+		return x.Session
 	}
 	return nil
 }
 
-type UnfollowRequest struct {
+type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
-	PeerId        *int64                 `protobuf:"varint,2,req,name=peer_id,json=peerId" json:"peer_id,omitempty"`
+	SessionId     *string                `protobuf:"bytes,2,req,name=session_id,json=sessionId" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfollowRequest) Reset() {
-	*x = UnfollowRequest{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[9]
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowRequest) String() string {
+func (x *LogoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowRequest) ProtoMessage() {}
+func (*LogoutRequest) ProtoMessage() {}
 
-func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[9]
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,47 +654,47 @@ func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowRequest.ProtoReflect.Descriptor instead.
-func (*UnfollowRequest) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UnfollowRequest) GetUserId() int64 {
+func (x *LogoutRequest) GetUserId() int64 {
 	if x != nil && x.UserId != nil {
 		return *x.UserId
 	}
 	return 0
 }
 
-func (x *UnfollowRequest) GetPeerId() int64 {
-	if x != nil && x.PeerId != nil {
-		return *x.PeerId
+func (x *LogoutRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
 	}
-	return 0
+	return ""
 }
 
-type UnfollowResponse struct {
+type LogoutResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsUnfollowed  *bool                  `protobuf:"varint,1,req,name=is_unfollowed,json=isUnfollowed" json:"is_unfollowed,omitempty"`
+	IsLoggedOut   *bool                  `protobuf:"varint,1,req,name=is_logged_out,json=isLoggedOut" json:"is_logged_out,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfollowResponse) Reset() {
-	*x = UnfollowResponse{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[10]
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowResponse) String() string {
+func (x *LogoutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowResponse) ProtoMessage() {}
+func (*LogoutResponse) ProtoMessage() {}
 
-func (x *UnfollowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[10]
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -637,41 +705,40 @@ func (x *UnfollowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowResponse.ProtoReflect.Descriptor instead.
-func (*UnfollowResponse) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UnfollowResponse) GetIsUnfollowed() bool {
-	if x != nil && x.IsUnfollowed != nil {
-		return *x.IsUnfollowed
+func (x *LogoutResponse) GetIsLoggedOut() bool {
+	if x != nil && x.IsLoggedOut != nil {
+		return *x.IsLoggedOut
 	}
 	return false
 }
 
-type FollowPaging struct {
+type LogoutAllRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LastValue     *int64                 `protobuf:"varint,1,req,name=last_value,json=lastValue" json:"last_value,omitempty"`
-	Limit         *int64                 `protobuf:"varint,2,req,name=limit" json:"limit,omitempty"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FollowPaging) Reset() {
-	*x = FollowPaging{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[11]
+func (x *LogoutAllRequest) Reset() {
+	*x = LogoutAllRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowPaging) String() string {
+func (x *LogoutAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowPaging) ProtoMessage() {}
+func (*LogoutAllRequest) ProtoMessage() {}
 
-func (x *FollowPaging) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[11]
+func (x *LogoutAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,48 +749,40 @@ func (x *FollowPaging) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowPaging.ProtoReflect.Descriptor instead.
-func (*FollowPaging) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *FollowPaging) GetLastValue() int64 {
-	if x != nil && x.LastValue != nil {
-		return *x.LastValue
-	}
-	return 0
+// Deprecated: Use LogoutAllRequest.ProtoReflect.Descriptor instead.
+func (*LogoutAllRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *FollowPaging) GetLimit() int64 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *LogoutAllRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
 	return 0
 }
 
-type GetFollowersRequest struct {
+type LogoutAllResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
-	Paging        *FollowPaging          `protobuf:"bytes,2,req,name=paging" json:"paging,omitempty"`
+	IsLoggedOut   *bool                  `protobuf:"varint,1,req,name=is_logged_out,json=isLoggedOut" json:"is_logged_out,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowersRequest) Reset() {
-	*x = GetFollowersRequest{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[12]
+func (x *LogoutAllResponse) Reset() {
+	*x = LogoutAllResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowersRequest) String() string {
+func (x *LogoutAllResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowersRequest) ProtoMessage() {}
+func (*LogoutAllResponse) ProtoMessage() {}
 
-func (x *GetFollowersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[12]
+func (x *LogoutAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,9 +793,979 @@ func (x *GetFollowersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowersRequest.ProtoReflect.Descriptor instead.
-func (*GetFollowersRequest) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use LogoutAllResponse.ProtoReflect.Descriptor instead.
+func (*LogoutAllResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LogoutAllResponse) GetIsLoggedOut() bool {
+	if x != nil && x.IsLoggedOut != nil {
+		return *x.IsLoggedOut
+	}
+	return false
+}
+
+type CheckSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	SessionId     *string                `protobuf:"bytes,2,req,name=session_id,json=sessionId" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckSessionRequest) Reset() {
+	*x = CheckSessionRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSessionRequest) ProtoMessage() {}
+
+func (x *CheckSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSessionRequest.ProtoReflect.Descriptor instead.
+func (*CheckSessionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckSessionRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *CheckSessionRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+type CheckSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Active        *bool                  `protobuf:"varint,1,req,name=active" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckSessionResponse) Reset() {
+	*x = CheckSessionResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSessionResponse) ProtoMessage() {}
+
+func (x *CheckSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSessionResponse.ProtoReflect.Descriptor instead.
+func (*CheckSessionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CheckSessionResponse) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         *string                `protobuf:"bytes,1,req,name=email" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsRequested   *bool                  `protobuf:"varint,1,req,name=is_requested,json=isRequested" json:"is_requested,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RequestPasswordResetResponse) GetIsRequested() bool {
+	if x != nil && x.IsRequested != nil {
+		return *x.IsRequested
+	}
+	return false
+}
+
+type ConfirmPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *string                `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	NewPassword   *string                `protobuf:"bytes,2,req,name=new_password,json=newPassword" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetRequest) Reset() {
+	*x = ConfirmPasswordResetRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetRequest) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ConfirmPasswordResetRequest) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetNewPassword() string {
+	if x != nil && x.NewPassword != nil {
+		return *x.NewPassword
+	}
+	return ""
+}
+
+type ConfirmPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsReset       *bool                  `protobuf:"varint,1,req,name=is_reset,json=isReset" json:"is_reset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetResponse) Reset() {
+	*x = ConfirmPasswordResetResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetResponse) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConfirmPasswordResetResponse) GetIsReset() bool {
+	if x != nil && x.IsReset != nil {
+		return *x.IsReset
+	}
+	return false
+}
+
+type VerifyEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *string                `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserData              `protobuf:"bytes,1,req,name=user" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *VerifyEmailResponse) GetUser() *UserData {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ResendEmailVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendEmailVerificationRequest) Reset() {
+	*x = ResendEmailVerificationRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendEmailVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendEmailVerificationRequest) ProtoMessage() {}
+
+func (x *ResendEmailVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendEmailVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ResendEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResendEmailVerificationRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type ResendEmailVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsSent        *bool                  `protobuf:"varint,1,req,name=is_sent,json=isSent" json:"is_sent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendEmailVerificationResponse) Reset() {
+	*x = ResendEmailVerificationResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendEmailVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendEmailVerificationResponse) ProtoMessage() {}
+
+func (x *ResendEmailVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendEmailVerificationResponse.ProtoReflect.Descriptor instead.
+func (*ResendEmailVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ResendEmailVerificationResponse) GetIsSent() bool {
+	if x != nil && x.IsSent != nil {
+		return *x.IsSent
+	}
+	return false
+}
+
+type SetPrivateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	IsPrivate     *bool                  `protobuf:"varint,2,req,name=is_private,json=isPrivate" json:"is_private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrivateRequest) Reset() {
+	*x = SetPrivateRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrivateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrivateRequest) ProtoMessage() {}
+
+func (x *SetPrivateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrivateRequest.ProtoReflect.Descriptor instead.
+func (*SetPrivateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetPrivateRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *SetPrivateRequest) GetIsPrivate() bool {
+	if x != nil && x.IsPrivate != nil {
+		return *x.IsPrivate
+	}
+	return false
+}
+
+type SetPrivateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserData              `protobuf:"bytes,1,req,name=user" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrivateResponse) Reset() {
+	*x = SetPrivateResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrivateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrivateResponse) ProtoMessage() {}
+
+func (x *SetPrivateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrivateResponse.ProtoReflect.Descriptor instead.
+func (*SetPrivateResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetPrivateResponse) GetUser() *UserData {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId      *int64                 `protobuf:"varint,1,req,name=viewer_id,json=viewerId" json:"viewer_id,omitempty"`
+	UserId        *int64                 `protobuf:"varint,2,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetProfileRequest) GetViewerId() int64 {
+	if x != nil && x.ViewerId != nil {
+		return *x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetProfileRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type GetProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserData              `protobuf:"bytes,1,req,name=user" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetProfileResponse) GetUser() *UserData {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UserUserData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int64                 `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	FollowerId    *int64                 `protobuf:"varint,2,req,name=follower_id,json=followerId" json:"follower_id,omitempty"`
+	FollowingId   *int64                 `protobuf:"varint,3,req,name=following_id,json=followingId" json:"following_id,omitempty"`
+	FollowTs      *int64                 `protobuf:"varint,4,req,name=follow_ts,json=followTs" json:"follow_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserUserData) Reset() {
+	*x = UserUserData{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserUserData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUserData) ProtoMessage() {}
+
+func (x *UserUserData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUserData.ProtoReflect.Descriptor instead.
+func (*UserUserData) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UserUserData) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *UserUserData) GetFollowerId() int64 {
+	if x != nil && x.FollowerId != nil {
+		return *x.FollowerId
+	}
+	return 0
+}
+
+func (x *UserUserData) GetFollowingId() int64 {
+	if x != nil && x.FollowingId != nil {
+		return *x.FollowingId
+	}
+	return 0
+}
+
+func (x *UserUserData) GetFollowTs() int64 {
+	if x != nil && x.FollowTs != nil {
+		return *x.FollowTs
+	}
+	return 0
+}
+
+type FollowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PeerId        *int64                 `protobuf:"varint,2,req,name=peer_id,json=peerId" json:"peer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowRequest) Reset() {
+	*x = FollowRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRequest) ProtoMessage() {}
+
+func (x *FollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
+func (*FollowRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FollowRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *FollowRequest) GetPeerId() int64 {
+	if x != nil && x.PeerId != nil {
+		return *x.PeerId
+	}
+	return 0
+}
+
+type FollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsFollowed    *bool                  `protobuf:"varint,1,req,name=is_followed,json=isFollowed" json:"is_followed,omitempty"` // false if peer is private and the follow is pending for approval
+	Pair          *UserUserData          `protobuf:"bytes,2,req,name=pair" json:"pair,omitempty"`                                // id and follow_ts are of the follow request if the follow is pending
+	Following     *UserData              `protobuf:"bytes,3,req,name=following" json:"following,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowResponse) Reset() {
+	*x = FollowResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowResponse) ProtoMessage() {}
+
+func (x *FollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowResponse.ProtoReflect.Descriptor instead.
+func (*FollowResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FollowResponse) GetIsFollowed() bool {
+	if x != nil && x.IsFollowed != nil {
+		return *x.IsFollowed
+	}
+	return false
+}
+
+func (x *FollowResponse) GetPair() *UserUserData {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FollowResponse) GetFollowing() *UserData {
+	if x != nil {
+		return x.Following
+	}
+	return nil
+}
+
+type UnfollowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PeerId        *int64                 `protobuf:"varint,2,req,name=peer_id,json=peerId" json:"peer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowRequest) Reset() {
+	*x = UnfollowRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowRequest) ProtoMessage() {}
+
+func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UnfollowRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *UnfollowRequest) GetPeerId() int64 {
+	if x != nil && x.PeerId != nil {
+		return *x.PeerId
+	}
+	return 0
+}
+
+type UnfollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsUnfollowed  *bool                  `protobuf:"varint,1,req,name=is_unfollowed,json=isUnfollowed" json:"is_unfollowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowResponse) Reset() {
+	*x = UnfollowResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowResponse) ProtoMessage() {}
+
+func (x *UnfollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UnfollowResponse) GetIsUnfollowed() bool {
+	if x != nil && x.IsUnfollowed != nil {
+		return *x.IsUnfollowed
+	}
+	return false
+}
+
+type GetFollowersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	Limit         *int64                 `protobuf:"varint,2,req,name=limit" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor" json:"cursor,omitempty"` // next_cursor of previous page, empty for the first page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowersRequest) Reset() {
+	*x = GetFollowersRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowersRequest) ProtoMessage() {}
+
+func (x *GetFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowersRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetFollowersRequest) GetUserId() int64 {
@@ -746,35 +1775,2906 @@ func (x *GetFollowersRequest) GetUserId() int64 {
 	return 0
 }
 
-func (x *GetFollowersRequest) GetPaging() *FollowPaging {
-	if x != nil {
-		return x.Paging
+func (x *GetFollowersRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetFollowersRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type GetFollowersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Followers     []*FollowData          `protobuf:"bytes,1,rep,name=followers" json:"followers,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"` // empty if there is no more follower
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowersResponse) Reset() {
+	*x = GetFollowersResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowersResponse) ProtoMessage() {}
+
+func (x *GetFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowersResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetFollowersResponse) GetFollowers() []*FollowData {
+	if x != nil {
+		return x.Followers
+	}
+	return nil
+}
+
+func (x *GetFollowersResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type GetFollowingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	Limit         *int64                 `protobuf:"varint,2,req,name=limit" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor" json:"cursor,omitempty"` // next_cursor of previous page, empty for the first page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowingsRequest) Reset() {
+	*x = GetFollowingsRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingsRequest) ProtoMessage() {}
+
+func (x *GetFollowingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowingsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetFollowingsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *GetFollowingsRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetFollowingsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type GetFollowingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Followings    []*FollowData          `protobuf:"bytes,1,rep,name=followings" json:"followings,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"` // empty if there is no more following
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowingsResponse) Reset() {
+	*x = GetFollowingsResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingsResponse) ProtoMessage() {}
+
+func (x *GetFollowingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowingsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetFollowingsResponse) GetFollowings() []*FollowData {
+	if x != nil {
+		return x.Followings
+	}
+	return nil
+}
+
+func (x *GetFollowingsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type FollowRequestData struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               *int64                 `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	Requester        *UserData              `protobuf:"bytes,2,req,name=requester" json:"requester,omitempty"`
+	CreatedTimestamp *int64                 `protobuf:"varint,3,req,name=created_timestamp,json=createdTimestamp" json:"created_timestamp,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FollowRequestData) Reset() {
+	*x = FollowRequestData{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowRequestData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRequestData) ProtoMessage() {}
+
+func (x *FollowRequestData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRequestData.ProtoReflect.Descriptor instead.
+func (*FollowRequestData) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FollowRequestData) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *FollowRequestData) GetRequester() *UserData {
+	if x != nil {
+		return x.Requester
+	}
+	return nil
+}
+
+func (x *FollowRequestData) GetCreatedTimestamp() int64 {
+	if x != nil && x.CreatedTimestamp != nil {
+		return *x.CreatedTimestamp
+	}
+	return 0
+}
+
+type GetFollowRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	Limit         *int64                 `protobuf:"varint,2,req,name=limit" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor" json:"cursor,omitempty"` // next_cursor of previous page, empty for the first page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowRequestsRequest) Reset() {
+	*x = GetFollowRequestsRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowRequestsRequest) ProtoMessage() {}
+
+func (x *GetFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetFollowRequestsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *GetFollowRequestsRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetFollowRequestsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type GetFollowRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*FollowRequestData   `protobuf:"bytes,1,rep,name=requests" json:"requests,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"` // empty if there is no more request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowRequestsResponse) Reset() {
+	*x = GetFollowRequestsResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowRequestsResponse) ProtoMessage() {}
+
+func (x *GetFollowRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetFollowRequestsResponse) GetRequests() []*FollowRequestData {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *GetFollowRequestsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type ApproveFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	RequesterId   *int64                 `protobuf:"varint,2,req,name=requester_id,json=requesterId" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveFollowRequestRequest) Reset() {
+	*x = ApproveFollowRequestRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestRequest) ProtoMessage() {}
+
+func (x *ApproveFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ApproveFollowRequestRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *ApproveFollowRequestRequest) GetRequesterId() int64 {
+	if x != nil && x.RequesterId != nil {
+		return *x.RequesterId
+	}
+	return 0
+}
+
+type ApproveFollowRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Follow        *FollowData            `protobuf:"bytes,1,req,name=follow" json:"follow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveFollowRequestResponse) Reset() {
+	*x = ApproveFollowRequestResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestResponse) ProtoMessage() {}
+
+func (x *ApproveFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ApproveFollowRequestResponse) GetFollow() *FollowData {
+	if x != nil {
+		return x.Follow
+	}
+	return nil
+}
+
+type RejectFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	RequesterId   *int64                 `protobuf:"varint,2,req,name=requester_id,json=requesterId" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFollowRequestRequest) Reset() {
+	*x = RejectFollowRequestRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestRequest) ProtoMessage() {}
+
+func (x *RejectFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RejectFollowRequestRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *RejectFollowRequestRequest) GetRequesterId() int64 {
+	if x != nil && x.RequesterId != nil {
+		return *x.RequesterId
+	}
+	return 0
+}
+
+type RejectFollowRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsRejected    *bool                  `protobuf:"varint,1,req,name=is_rejected,json=isRejected" json:"is_rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFollowRequestResponse) Reset() {
+	*x = RejectFollowRequestResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestResponse) ProtoMessage() {}
+
+func (x *RejectFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RejectFollowRequestResponse) GetIsRejected() bool {
+	if x != nil && x.IsRejected != nil {
+		return *x.IsRejected
+	}
+	return false
+}
+
+type CancelFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PeerId        *int64                 `protobuf:"varint,2,req,name=peer_id,json=peerId" json:"peer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFollowRequestRequest) Reset() {
+	*x = CancelFollowRequestRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFollowRequestRequest) ProtoMessage() {}
+
+func (x *CancelFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CancelFollowRequestRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *CancelFollowRequestRequest) GetPeerId() int64 {
+	if x != nil && x.PeerId != nil {
+		return *x.PeerId
+	}
+	return 0
+}
+
+type CancelFollowRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsCancelled   *bool                  `protobuf:"varint,1,req,name=is_cancelled,json=isCancelled" json:"is_cancelled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFollowRequestResponse) Reset() {
+	*x = CancelFollowRequestResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFollowRequestResponse) ProtoMessage() {}
+
+func (x *CancelFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CancelFollowRequestResponse) GetIsCancelled() bool {
+	if x != nil && x.IsCancelled != nil {
+		return *x.IsCancelled
+	}
+	return false
+}
+
+type AddCloseFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PeerId        *int64                 `protobuf:"varint,2,req,name=peer_id,json=peerId" json:"peer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCloseFriendRequest) Reset() {
+	*x = AddCloseFriendRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCloseFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCloseFriendRequest) ProtoMessage() {}
+
+func (x *AddCloseFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCloseFriendRequest.ProtoReflect.Descriptor instead.
+func (*AddCloseFriendRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddCloseFriendRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *AddCloseFriendRequest) GetPeerId() int64 {
+	if x != nil && x.PeerId != nil {
+		return *x.PeerId
+	}
+	return 0
+}
+
+type AddCloseFriendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Friend        *UserData              `protobuf:"bytes,1,req,name=friend" json:"friend,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCloseFriendResponse) Reset() {
+	*x = AddCloseFriendResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCloseFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCloseFriendResponse) ProtoMessage() {}
+
+func (x *AddCloseFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCloseFriendResponse.ProtoReflect.Descriptor instead.
+func (*AddCloseFriendResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddCloseFriendResponse) GetFriend() *UserData {
+	if x != nil {
+		return x.Friend
+	}
+	return nil
+}
+
+type RemoveCloseFriendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PeerId        *int64                 `protobuf:"varint,2,req,name=peer_id,json=peerId" json:"peer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCloseFriendRequest) Reset() {
+	*x = RemoveCloseFriendRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCloseFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCloseFriendRequest) ProtoMessage() {}
+
+func (x *RemoveCloseFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCloseFriendRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCloseFriendRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RemoveCloseFriendRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveCloseFriendRequest) GetPeerId() int64 {
+	if x != nil && x.PeerId != nil {
+		return *x.PeerId
+	}
+	return 0
+}
+
+type RemoveCloseFriendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsRemoved     *bool                  `protobuf:"varint,1,req,name=is_removed,json=isRemoved" json:"is_removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCloseFriendResponse) Reset() {
+	*x = RemoveCloseFriendResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCloseFriendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCloseFriendResponse) ProtoMessage() {}
+
+func (x *RemoveCloseFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCloseFriendResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCloseFriendResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RemoveCloseFriendResponse) GetIsRemoved() bool {
+	if x != nil && x.IsRemoved != nil {
+		return *x.IsRemoved
+	}
+	return false
+}
+
+type GetCloseFriendsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	Limit         *int64                 `protobuf:"varint,2,req,name=limit" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor" json:"cursor,omitempty"` // next_cursor of previous page, empty for the first page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloseFriendsRequest) Reset() {
+	*x = GetCloseFriendsRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloseFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloseFriendsRequest) ProtoMessage() {}
+
+func (x *GetCloseFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloseFriendsRequest.ProtoReflect.Descriptor instead.
+func (*GetCloseFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetCloseFriendsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *GetCloseFriendsRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetCloseFriendsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type GetCloseFriendsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Friends       []*UserData            `protobuf:"bytes,1,rep,name=friends" json:"friends,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"` // empty if there is no more friend
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCloseFriendsResponse) Reset() {
+	*x = GetCloseFriendsResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCloseFriendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCloseFriendsResponse) ProtoMessage() {}
+
+func (x *GetCloseFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCloseFriendsResponse.ProtoReflect.Descriptor instead.
+func (*GetCloseFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetCloseFriendsResponse) GetFriends() []*UserData {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+func (x *GetCloseFriendsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type BlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PeerId        *int64                 `protobuf:"varint,2,req,name=peer_id,json=peerId" json:"peer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BlockRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *BlockRequest) GetPeerId() int64 {
+	if x != nil && x.PeerId != nil {
+		return *x.PeerId
+	}
+	return 0
+}
+
+type BlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsBlocked     *bool                  `protobuf:"varint,1,req,name=is_blocked,json=isBlocked" json:"is_blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockResponse) Reset() {
+	*x = BlockResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockResponse) ProtoMessage() {}
+
+func (x *BlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BlockResponse) GetIsBlocked() bool {
+	if x != nil && x.IsBlocked != nil {
+		return *x.IsBlocked
+	}
+	return false
+}
+
+type UnblockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PeerId        *int64                 `protobuf:"varint,2,req,name=peer_id,json=peerId" json:"peer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockRequest) Reset() {
+	*x = UnblockRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockRequest) ProtoMessage() {}
+
+func (x *UnblockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockRequest.ProtoReflect.Descriptor instead.
+func (*UnblockRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UnblockRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *UnblockRequest) GetPeerId() int64 {
+	if x != nil && x.PeerId != nil {
+		return *x.PeerId
+	}
+	return 0
+}
+
+type UnblockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsUnblocked   *bool                  `protobuf:"varint,1,req,name=is_unblocked,json=isUnblocked" json:"is_unblocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockResponse) Reset() {
+	*x = UnblockResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockResponse) ProtoMessage() {}
+
+func (x *UnblockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockResponse.ProtoReflect.Descriptor instead.
+func (*UnblockResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UnblockResponse) GetIsUnblocked() bool {
+	if x != nil && x.IsUnblocked != nil {
+		return *x.IsUnblocked
+	}
+	return false
+}
+
+type MuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PeerId        *int64                 `protobuf:"varint,2,req,name=peer_id,json=peerId" json:"peer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteRequest) Reset() {
+	*x = MuteRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteRequest) ProtoMessage() {}
+
+func (x *MuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteRequest.ProtoReflect.Descriptor instead.
+func (*MuteRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MuteRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *MuteRequest) GetPeerId() int64 {
+	if x != nil && x.PeerId != nil {
+		return *x.PeerId
+	}
+	return 0
+}
+
+type MuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsMuted       *bool                  `protobuf:"varint,1,req,name=is_muted,json=isMuted" json:"is_muted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteResponse) Reset() {
+	*x = MuteResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteResponse) ProtoMessage() {}
+
+func (x *MuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteResponse.ProtoReflect.Descriptor instead.
+func (*MuteResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MuteResponse) GetIsMuted() bool {
+	if x != nil && x.IsMuted != nil {
+		return *x.IsMuted
+	}
+	return false
+}
+
+type UnmuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PeerId        *int64                 `protobuf:"varint,2,req,name=peer_id,json=peerId" json:"peer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteRequest) Reset() {
+	*x = UnmuteRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteRequest) ProtoMessage() {}
+
+func (x *UnmuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UnmuteRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *UnmuteRequest) GetPeerId() int64 {
+	if x != nil && x.PeerId != nil {
+		return *x.PeerId
+	}
+	return 0
+}
+
+type UnmuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsUnmuted     *bool                  `protobuf:"varint,1,req,name=is_unmuted,json=isUnmuted" json:"is_unmuted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteResponse) Reset() {
+	*x = UnmuteResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteResponse) ProtoMessage() {}
+
+func (x *UnmuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UnmuteResponse) GetIsUnmuted() bool {
+	if x != nil && x.IsUnmuted != nil {
+		return *x.IsUnmuted
+	}
+	return false
+}
+
+type RelationshipData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	Following     *bool                  `protobuf:"varint,2,req,name=following" json:"following,omitempty"`                     // viewer follows user
+	FollowedBy    *bool                  `protobuf:"varint,3,req,name=followed_by,json=followedBy" json:"followed_by,omitempty"` // user follows viewer
+	Blocking      *bool                  `protobuf:"varint,4,req,name=blocking" json:"blocking,omitempty"`                       // viewer blocked user
+	BlockedBy     *bool                  `protobuf:"varint,5,req,name=blocked_by,json=blockedBy" json:"blocked_by,omitempty"`    // user blocked viewer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationshipData) Reset() {
+	*x = RelationshipData{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipData) ProtoMessage() {}
+
+func (x *RelationshipData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipData.ProtoReflect.Descriptor instead.
+func (*RelationshipData) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RelationshipData) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *RelationshipData) GetFollowing() bool {
+	if x != nil && x.Following != nil {
+		return *x.Following
+	}
+	return false
+}
+
+func (x *RelationshipData) GetFollowedBy() bool {
+	if x != nil && x.FollowedBy != nil {
+		return *x.FollowedBy
+	}
+	return false
+}
+
+func (x *RelationshipData) GetBlocking() bool {
+	if x != nil && x.Blocking != nil {
+		return *x.Blocking
+	}
+	return false
+}
+
+func (x *RelationshipData) GetBlockedBy() bool {
+	if x != nil && x.BlockedBy != nil {
+		return *x.BlockedBy
+	}
+	return false
+}
+
+type GetRelationshipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId      *int64                 `protobuf:"varint,1,req,name=viewer_id,json=viewerId" json:"viewer_id,omitempty"`
+	UserIds       []int64                `protobuf:"varint,2,rep,name=user_ids,json=userIds" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationshipsRequest) Reset() {
+	*x = GetRelationshipsRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsRequest) ProtoMessage() {}
+
+func (x *GetRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetRelationshipsRequest) GetViewerId() int64 {
+	if x != nil && x.ViewerId != nil {
+		return *x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetRelationshipsRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetRelationshipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationships []*RelationshipData    `protobuf:"bytes,1,rep,name=relationships" json:"relationships,omitempty"` // in the same order as user_ids
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationshipsResponse) Reset() {
+	*x = GetRelationshipsResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsResponse) ProtoMessage() {}
+
+func (x *GetRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetRelationshipsResponse) GetRelationships() []*RelationshipData {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+type SuggestionData struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	User             *UserData              `protobuf:"bytes,1,req,name=user" json:"user,omitempty"`
+	Score            *float64               `protobuf:"fixed64,2,req,name=score" json:"score,omitempty"`
+	MutualFollowings *int64                 `protobuf:"varint,3,req,name=mutual_followings,json=mutualFollowings" json:"mutual_followings,omitempty"` // followings of the user following the suggested user
+	MutualFollowers  *int64                 `protobuf:"varint,4,req,name=mutual_followers,json=mutualFollowers" json:"mutual_followers,omitempty"`    // users following both the user and the suggested user
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SuggestionData) Reset() {
+	*x = SuggestionData{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestionData) ProtoMessage() {}
+
+func (x *SuggestionData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestionData.ProtoReflect.Descriptor instead.
+func (*SuggestionData) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SuggestionData) GetUser() *UserData {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SuggestionData) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *SuggestionData) GetMutualFollowings() int64 {
+	if x != nil && x.MutualFollowings != nil {
+		return *x.MutualFollowings
+	}
+	return 0
+}
+
+func (x *SuggestionData) GetMutualFollowers() int64 {
+	if x != nil && x.MutualFollowers != nil {
+		return *x.MutualFollowers
+	}
+	return 0
+}
+
+type GetSuggestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	Limit         *int64                 `protobuf:"varint,2,req,name=limit" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSuggestionsRequest) Reset() {
+	*x = GetSuggestionsRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuggestionsRequest) ProtoMessage() {}
+
+func (x *GetSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetSuggestionsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *GetSuggestionsRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type GetSuggestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*SuggestionData      `protobuf:"bytes,1,rep,name=suggestions" json:"suggestions,omitempty"` // best first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSuggestionsResponse) Reset() {
+	*x = GetSuggestionsResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuggestionsResponse) ProtoMessage() {}
+
+func (x *GetSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetSuggestionsResponse) GetSuggestions() []*SuggestionData {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type MediaData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int64                 `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	ContentType   *string                `protobuf:"bytes,2,req,name=content_type,json=contentType" json:"content_type,omitempty"`
+	Size          *int64                 `protobuf:"varint,3,req,name=size" json:"size,omitempty"`
+	Width         *int32                 `protobuf:"varint,4,req,name=width" json:"width,omitempty"`
+	Height        *int32                 `protobuf:"varint,5,req,name=height" json:"height,omitempty"`
+	Url           *string                `protobuf:"bytes,6,req,name=url" json:"url,omitempty"`
+	ThumbnailUrl  *string                `protobuf:"bytes,7,req,name=thumbnail_url,json=thumbnailUrl" json:"thumbnail_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaData) Reset() {
+	*x = MediaData{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaData) ProtoMessage() {}
+
+func (x *MediaData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaData.ProtoReflect.Descriptor instead.
+func (*MediaData) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MediaData) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *MediaData) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+func (x *MediaData) GetSize() int64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *MediaData) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+func (x *MediaData) GetHeight() int32 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return 0
+}
+
+func (x *MediaData) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *MediaData) GetThumbnailUrl() string {
+	if x != nil && x.ThumbnailUrl != nil {
+		return *x.ThumbnailUrl
+	}
+	return ""
+}
+
+type UploadMediaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,req,name=data" json:"data,omitempty"` // content type is detected from data
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMediaRequest) Reset() {
+	*x = UploadMediaRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMediaRequest) ProtoMessage() {}
+
+func (x *UploadMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMediaRequest.ProtoReflect.Descriptor instead.
+func (*UploadMediaRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UploadMediaRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *UploadMediaRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadMediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Media         *MediaData             `protobuf:"bytes,1,req,name=media" json:"media,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMediaResponse) Reset() {
+	*x = UploadMediaResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMediaResponse) ProtoMessage() {}
+
+func (x *UploadMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMediaResponse.ProtoReflect.Descriptor instead.
+func (*UploadMediaResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UploadMediaResponse) GetMedia() *MediaData {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type PostData struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               *int64                 `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	UserId           *int64                 `protobuf:"varint,2,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	Content          *string                `protobuf:"bytes,3,req,name=content" json:"content,omitempty"`
+	CreatedTimestamp *int64                 `protobuf:"varint,4,req,name=created_timestamp,json=createdTimestamp" json:"created_timestamp,omitempty"`
+	Media            []*MediaData           `protobuf:"bytes,5,rep,name=media" json:"media,omitempty"`
+	CommentCount     *int64                 `protobuf:"varint,6,opt,name=comment_count,json=commentCount" json:"comment_count,omitempty"`
+	Reactions        *ReactionsData         `protobuf:"bytes,7,opt,name=reactions" json:"reactions,omitempty"`                                     // seen by the requesting user
+	EditedTimestamp  *int64                 `protobuf:"varint,8,opt,name=edited_timestamp,json=editedTimestamp" json:"edited_timestamp,omitempty"` // 0 if the post has never been edited
+	Visibility       *string                `protobuf:"bytes,9,opt,name=visibility" json:"visibility,omitempty"`                                   // public, followers, close_friends
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PostData) Reset() {
+	*x = PostData{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostData) ProtoMessage() {}
+
+func (x *PostData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostData.ProtoReflect.Descriptor instead.
+func (*PostData) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PostData) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *PostData) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *PostData) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *PostData) GetCreatedTimestamp() int64 {
+	if x != nil && x.CreatedTimestamp != nil {
+		return *x.CreatedTimestamp
+	}
+	return 0
+}
+
+func (x *PostData) GetMedia() []*MediaData {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *PostData) GetCommentCount() int64 {
+	if x != nil && x.CommentCount != nil {
+		return *x.CommentCount
+	}
+	return 0
+}
+
+func (x *PostData) GetReactions() *ReactionsData {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *PostData) GetEditedTimestamp() int64 {
+	if x != nil && x.EditedTimestamp != nil {
+		return *x.EditedTimestamp
+	}
+	return 0
+}
+
+func (x *PostData) GetVisibility() string {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return ""
+}
+
+type ReactionCountData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReactionType  *string                `protobuf:"bytes,1,req,name=reaction_type,json=reactionType" json:"reaction_type,omitempty"`
+	Count         *int64                 `protobuf:"varint,2,req,name=count" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionCountData) Reset() {
+	*x = ReactionCountData{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionCountData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionCountData) ProtoMessage() {}
+
+func (x *ReactionCountData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionCountData.ProtoReflect.Descriptor instead.
+func (*ReactionCountData) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ReactionCountData) GetReactionType() string {
+	if x != nil && x.ReactionType != nil {
+		return *x.ReactionType
+	}
+	return ""
+}
+
+func (x *ReactionCountData) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type ReactionsData struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Counts         []*ReactionCountData   `protobuf:"bytes,1,rep,name=counts" json:"counts,omitempty"` // only reaction types having count > 0
+	Total          *int64                 `protobuf:"varint,2,req,name=total" json:"total,omitempty"`
+	ViewerReaction *string                `protobuf:"bytes,3,opt,name=viewer_reaction,json=viewerReaction" json:"viewer_reaction,omitempty"` // empty if viewer has not reacted
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReactionsData) Reset() {
+	*x = ReactionsData{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionsData) ProtoMessage() {}
+
+func (x *ReactionsData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionsData.ProtoReflect.Descriptor instead.
+func (*ReactionsData) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ReactionsData) GetCounts() []*ReactionCountData {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *ReactionsData) GetTotal() int64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ReactionsData) GetViewerReaction() string {
+	if x != nil && x.ViewerReaction != nil {
+		return *x.ViewerReaction
+	}
+	return ""
+}
+
+type CreatePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	Content       *string                `protobuf:"bytes,2,req,name=content" json:"content,omitempty"`
+	MediaIds      []int64                `protobuf:"varint,3,rep,name=media_ids,json=mediaIds" json:"media_ids,omitempty"` // uploaded by UploadMedia
+	Visibility    *string                `protobuf:"bytes,4,opt,name=visibility" json:"visibility,omitempty"`              // public, followers, close_friends, empty for public
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreatePostRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetMediaIds() []int64 {
+	if x != nil {
+		return x.MediaIds
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetVisibility() string {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return ""
+}
+
+type CreatePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *PostData              `protobuf:"bytes,1,req,name=post" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePostResponse) Reset() {
+	*x = CreatePostResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostResponse) ProtoMessage() {}
+
+func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreatePostResponse) GetPost() *PostData {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type UpdatePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PostId        *int64                 `protobuf:"varint,2,req,name=post_id,json=postId" json:"post_id,omitempty"`
+	Content       *string                `protobuf:"bytes,3,req,name=content" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostRequest) Reset() {
+	*x = UpdatePostRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostRequest) ProtoMessage() {}
+
+func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdatePostRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *UpdatePostRequest) GetPostId() int64 {
+	if x != nil && x.PostId != nil {
+		return *x.PostId
+	}
+	return 0
+}
+
+func (x *UpdatePostRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+type UpdatePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *PostData              `protobuf:"bytes,1,req,name=post" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostResponse) Reset() {
+	*x = UpdatePostResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostResponse) ProtoMessage() {}
+
+func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdatePostResponse) GetPost() *PostData {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type PostRevisionData struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               *int64                 `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	PostId           *int64                 `protobuf:"varint,2,req,name=post_id,json=postId" json:"post_id,omitempty"`
+	Content          *string                `protobuf:"bytes,3,req,name=content" json:"content,omitempty"`
+	CreatedTimestamp *int64                 `protobuf:"varint,4,req,name=created_timestamp,json=createdTimestamp" json:"created_timestamp,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PostRevisionData) Reset() {
+	*x = PostRevisionData{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostRevisionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevisionData) ProtoMessage() {}
+
+func (x *PostRevisionData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevisionData.ProtoReflect.Descriptor instead.
+func (*PostRevisionData) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PostRevisionData) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *PostRevisionData) GetPostId() int64 {
+	if x != nil && x.PostId != nil {
+		return *x.PostId
+	}
+	return 0
+}
+
+func (x *PostRevisionData) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *PostRevisionData) GetCreatedTimestamp() int64 {
+	if x != nil && x.CreatedTimestamp != nil {
+		return *x.CreatedTimestamp
+	}
+	return 0
+}
+
+type ListPostRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PostId        *int64                 `protobuf:"varint,2,req,name=post_id,json=postId" json:"post_id,omitempty"`
+	Limit         *int64                 `protobuf:"varint,3,req,name=limit" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor" json:"cursor,omitempty"` // next_cursor of previous page, empty for the first page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostRevisionsRequest) Reset() {
+	*x = ListPostRevisionsRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostRevisionsRequest) ProtoMessage() {}
+
+func (x *ListPostRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListPostRevisionsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *ListPostRevisionsRequest) GetPostId() int64 {
+	if x != nil && x.PostId != nil {
+		return *x.PostId
+	}
+	return 0
+}
+
+func (x *ListPostRevisionsRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListPostRevisionsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type ListPostRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*PostRevisionData    `protobuf:"bytes,1,rep,name=revisions" json:"revisions,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"` // empty if there is no more revision
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostRevisionsResponse) Reset() {
+	*x = ListPostRevisionsResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostRevisionsResponse) ProtoMessage() {}
+
+func (x *ListPostRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListPostRevisionsResponse) GetRevisions() []*PostRevisionData {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListPostRevisionsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type DeletePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PostId        *int64                 `protobuf:"varint,2,req,name=post_id,json=postId" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeletePostRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *DeletePostRequest) GetPostId() int64 {
+	if x != nil && x.PostId != nil {
+		return *x.PostId
+	}
+	return 0
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsDeleted     *bool                  `protobuf:"varint,1,req,name=is_deleted,json=isDeleted" json:"is_deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeletePostResponse) GetIsDeleted() bool {
+	if x != nil && x.IsDeleted != nil {
+		return *x.IsDeleted
+	}
+	return false
+}
+
+type GetPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId      *int64                 `protobuf:"varint,1,req,name=viewer_id,json=viewerId" json:"viewer_id,omitempty"`
+	UserId        *int64                 `protobuf:"varint,2,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	Limit         *int64                 `protobuf:"varint,3,req,name=limit" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor" json:"cursor,omitempty"` // next_cursor of previous page, empty for the first page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsRequest) Reset() {
+	*x = GetPostsRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsRequest) ProtoMessage() {}
+
+func (x *GetPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetPostsRequest) GetViewerId() int64 {
+	if x != nil && x.ViewerId != nil {
+		return *x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetPostsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *GetPostsRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetPostsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type GetPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*PostData            `protobuf:"bytes,1,rep,name=posts" json:"posts,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"` // empty if there is no more post
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsResponse) Reset() {
+	*x = GetPostsResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsResponse) ProtoMessage() {}
+
+func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetPostsResponse) GetPosts() []*PostData {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetPostsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type GetNewsfeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	Limit         *int64                 `protobuf:"varint,2,req,name=limit" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor" json:"cursor,omitempty"`   // next_cursor of previous page, empty for the first page
+	Ranking       *string                `protobuf:"bytes,4,opt,name=ranking" json:"ranking,omitempty"` // chronological, engagement, affinity, empty for the default one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewsfeedRequest) Reset() {
+	*x = GetNewsfeedRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewsfeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewsfeedRequest) ProtoMessage() {}
+
+func (x *GetNewsfeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewsfeedRequest.ProtoReflect.Descriptor instead.
+func (*GetNewsfeedRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetNewsfeedRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *GetNewsfeedRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetNewsfeedRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *GetNewsfeedRequest) GetRanking() string {
+	if x != nil && x.Ranking != nil {
+		return *x.Ranking
+	}
+	return ""
+}
+
+type GetNewsfeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*PostData            `protobuf:"bytes,1,rep,name=posts" json:"posts,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"`            // empty if there is no more post
+	RankingScores []float64              `protobuf:"fixed64,3,rep,name=ranking_scores,json=rankingScores" json:"ranking_scores,omitempty"` // score of each post in posts, for debugging
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewsfeedResponse) Reset() {
+	*x = GetNewsfeedResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewsfeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewsfeedResponse) ProtoMessage() {}
+
+func (x *GetNewsfeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewsfeedResponse.ProtoReflect.Descriptor instead.
+func (*GetNewsfeedResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetNewsfeedResponse) GetPosts() []*PostData {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetNewsfeedResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetNewsfeedResponse) GetRankingScores() []float64 {
+	if x != nil {
+		return x.RankingScores
+	}
+	return nil
+}
+
+type ReactPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PostId        *int64                 `protobuf:"varint,2,req,name=post_id,json=postId" json:"post_id,omitempty"`
+	ReactionType  *string                `protobuf:"bytes,3,opt,name=reaction_type,json=reactionType" json:"reaction_type,omitempty"` // like, love, haha, wow, sad, angry, empty to remove
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactPostRequest) Reset() {
+	*x = ReactPostRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactPostRequest) ProtoMessage() {}
+
+func (x *ReactPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactPostRequest.ProtoReflect.Descriptor instead.
+func (*ReactPostRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ReactPostRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *ReactPostRequest) GetPostId() int64 {
+	if x != nil && x.PostId != nil {
+		return *x.PostId
 	}
-	return nil
+	return 0
 }
 
-type GetFollowersResponse struct {
+func (x *ReactPostRequest) GetReactionType() string {
+	if x != nil && x.ReactionType != nil {
+		return *x.ReactionType
+	}
+	return ""
+}
+
+type ReactPostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Followers     []*FollowData          `protobuf:"bytes,1,rep,name=followers" json:"followers,omitempty"`
+	Reactions     *ReactionsData         `protobuf:"bytes,1,req,name=reactions" json:"reactions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowersResponse) Reset() {
-	*x = GetFollowersResponse{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[13]
+func (x *ReactPostResponse) Reset() {
+	*x = ReactPostResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowersResponse) String() string {
+func (x *ReactPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowersResponse) ProtoMessage() {}
+func (*ReactPostResponse) ProtoMessage() {}
 
-func (x *GetFollowersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[13]
+func (x *ReactPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,41 +4685,46 @@ func (x *GetFollowersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowersResponse.ProtoReflect.Descriptor instead.
-func (*GetFollowersResponse) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ReactPostResponse.ProtoReflect.Descriptor instead.
+func (*ReactPostResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetFollowersResponse) GetFollowers() []*FollowData {
+func (x *ReactPostResponse) GetReactions() *ReactionsData {
 	if x != nil {
-		return x.Followers
+		return x.Reactions
 	}
 	return nil
 }
 
-type GetFollowingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
-	Paging        *FollowPaging          `protobuf:"bytes,2,req,name=paging" json:"paging,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CommentData struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               *int64                 `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	PostId           *int64                 `protobuf:"varint,2,req,name=post_id,json=postId" json:"post_id,omitempty"`
+	UserId           *int64                 `protobuf:"varint,3,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	ParentId         *int64                 `protobuf:"varint,4,req,name=parent_id,json=parentId" json:"parent_id,omitempty"` // 0 for top-level comments
+	Content          *string                `protobuf:"bytes,5,req,name=content" json:"content,omitempty"`
+	ReplyCount       *int64                 `protobuf:"varint,6,req,name=reply_count,json=replyCount" json:"reply_count,omitempty"`
+	CreatedTimestamp *int64                 `protobuf:"varint,7,req,name=created_timestamp,json=createdTimestamp" json:"created_timestamp,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetFollowingsRequest) Reset() {
-	*x = GetFollowingsRequest{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[14]
+func (x *CommentData) Reset() {
+	*x = CommentData{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowingsRequest) String() string {
+func (x *CommentData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowingsRequest) ProtoMessage() {}
+func (*CommentData) ProtoMessage() {}
 
-func (x *GetFollowingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[14]
+func (x *CommentData) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,47 +4735,85 @@ func (x *GetFollowingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowingsRequest.ProtoReflect.Descriptor instead.
-func (*GetFollowingsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CommentData.ProtoReflect.Descriptor instead.
+func (*CommentData) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *GetFollowingsRequest) GetUserId() int64 {
+func (x *CommentData) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *CommentData) GetPostId() int64 {
+	if x != nil && x.PostId != nil {
+		return *x.PostId
+	}
+	return 0
+}
+
+func (x *CommentData) GetUserId() int64 {
 	if x != nil && x.UserId != nil {
 		return *x.UserId
 	}
 	return 0
 }
 
-func (x *GetFollowingsRequest) GetPaging() *FollowPaging {
-	if x != nil {
-		return x.Paging
+func (x *CommentData) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
 	}
-	return nil
+	return 0
 }
 
-type GetFollowingsResponse struct {
+func (x *CommentData) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *CommentData) GetReplyCount() int64 {
+	if x != nil && x.ReplyCount != nil {
+		return *x.ReplyCount
+	}
+	return 0
+}
+
+func (x *CommentData) GetCreatedTimestamp() int64 {
+	if x != nil && x.CreatedTimestamp != nil {
+		return *x.CreatedTimestamp
+	}
+	return 0
+}
+
+type CreateCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Followings    []*FollowData          `protobuf:"bytes,1,rep,name=followings" json:"followings,omitempty"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PostId        *int64                 `protobuf:"varint,2,req,name=post_id,json=postId" json:"post_id,omitempty"`
+	ParentId      *int64                 `protobuf:"varint,3,opt,name=parent_id,json=parentId" json:"parent_id,omitempty"` // set to reply to a top-level comment
+	Content       *string                `protobuf:"bytes,4,req,name=content" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowingsResponse) Reset() {
-	*x = GetFollowingsResponse{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[15]
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowingsResponse) String() string {
+func (x *CreateCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowingsResponse) ProtoMessage() {}
+func (*CreateCommentRequest) ProtoMessage() {}
 
-func (x *GetFollowingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[15]
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,39 +4824,61 @@ func (x *GetFollowingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowingsResponse.ProtoReflect.Descriptor instead.
-func (*GetFollowingsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetFollowingsResponse) GetFollowings() []*FollowData {
-	if x != nil {
-		return x.Followings
+func (x *CreateCommentRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
-	return nil
+	return 0
 }
 
-type CreatePostRequest struct {
+func (x *CreateCommentRequest) GetPostId() int64 {
+	if x != nil && x.PostId != nil {
+		return *x.PostId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+type CreateCommentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *CommentData           `protobuf:"bytes,1,req,name=comment" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[16]
+func (x *CreateCommentResponse) Reset() {
+	*x = CreateCommentResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostRequest) String() string {
+func (x *CreateCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+func (*CreateCommentResponse) ProtoMessage() {}
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[16]
+func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,32 +4889,44 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CreateCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{89}
 }
 
-type CreatePostResponse struct {
+func (x *CreateCommentResponse) GetComment() *CommentData {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type ListCommentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,5,req,name=user_id,json=userId" json:"user_id,omitempty"` // viewer
+	PostId        *int64                 `protobuf:"varint,1,req,name=post_id,json=postId" json:"post_id,omitempty"`
+	ParentId      *int64                 `protobuf:"varint,2,opt,name=parent_id,json=parentId" json:"parent_id,omitempty"` // set to list replies of a comment
+	Limit         *int64                 `protobuf:"varint,3,req,name=limit" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor" json:"cursor,omitempty"` // next_cursor of previous page, empty for the first page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostResponse) Reset() {
-	*x = CreatePostResponse{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[17]
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostResponse) String() string {
+func (x *ListCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostResponse) ProtoMessage() {}
+func (*ListCommentsRequest) ProtoMessage() {}
 
-func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[17]
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,68 +4937,69 @@ func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
-func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{90}
 }
 
-type GetPostsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListCommentsRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
 }
 
-func (x *GetPostsRequest) Reset() {
-	*x = GetPostsRequest{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListCommentsRequest) GetPostId() int64 {
+	if x != nil && x.PostId != nil {
+		return *x.PostId
+	}
+	return 0
 }
 
-func (x *GetPostsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListCommentsRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
 }
 
-func (*GetPostsRequest) ProtoMessage() {}
-
-func (x *GetPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[18]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListCommentsRequest) GetLimit() int64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetPostsRequest.ProtoReflect.Descriptor instead.
-func (*GetPostsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{18}
+func (x *ListCommentsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
 }
 
-type GetPostsResponse struct {
+type ListCommentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*CommentData         `protobuf:"bytes,1,rep,name=comments" json:"comments,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"` // empty if there is no more comment
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostsResponse) Reset() {
-	*x = GetPostsResponse{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[19]
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostsResponse) String() string {
+func (x *ListCommentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsResponse) ProtoMessage() {}
+func (*ListCommentsResponse) ProtoMessage() {}
 
-func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[19]
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,32 +5010,49 @@ func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
-func (*GetPostsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{91}
 }
 
-type GetNewsfeedRequest struct {
+func (x *ListCommentsResponse) GetComments() []*CommentData {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type DeleteCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int64                 `protobuf:"varint,1,req,name=user_id,json=userId" json:"user_id,omitempty"`
+	PostId        *int64                 `protobuf:"varint,2,req,name=post_id,json=postId" json:"post_id,omitempty"`
+	CommentId     *int64                 `protobuf:"varint,3,req,name=comment_id,json=commentId" json:"comment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNewsfeedRequest) Reset() {
-	*x = GetNewsfeedRequest{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[20]
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNewsfeedRequest) String() string {
+func (x *DeleteCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNewsfeedRequest) ProtoMessage() {}
+func (*DeleteCommentRequest) ProtoMessage() {}
 
-func (x *GetNewsfeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[20]
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,32 +5063,54 @@ func (x *GetNewsfeedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNewsfeedRequest.ProtoReflect.Descriptor instead.
-func (*GetNewsfeedRequest) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{92}
 }
 
-type GetNewsfeedResponse struct {
+func (x *DeleteCommentRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteCommentRequest) GetPostId() int64 {
+	if x != nil && x.PostId != nil {
+		return *x.PostId
+	}
+	return 0
+}
+
+func (x *DeleteCommentRequest) GetCommentId() int64 {
+	if x != nil && x.CommentId != nil {
+		return *x.CommentId
+	}
+	return 0
+}
+
+type DeleteCommentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsDeleted     *bool                  `protobuf:"varint,1,req,name=is_deleted,json=isDeleted" json:"is_deleted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNewsfeedResponse) Reset() {
-	*x = GetNewsfeedResponse{}
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[21]
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNewsfeedResponse) String() string {
+func (x *DeleteCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNewsfeedResponse) ProtoMessage() {}
+func (*DeleteCommentResponse) ProtoMessage() {}
 
-func (x *GetNewsfeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[21]
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_handler_proto_grpc_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1104,22 +5121,39 @@ func (x *GetNewsfeedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNewsfeedResponse.ProtoReflect.Descriptor instead.
-func (*GetNewsfeedResponse) Descriptor() ([]byte, []int) {
-	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_internal_handler_proto_grpc_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DeleteCommentResponse) GetIsDeleted() bool {
+	if x != nil && x.IsDeleted != nil {
+		return *x.IsDeleted
+	}
+	return false
 }
 
 var File_internal_handler_proto_grpc_service_proto protoreflect.FileDescriptor
 
 const file_internal_handler_proto_grpc_service_proto_rawDesc = "" +
 	"\n" +
-	")internal/handler/proto/grpc/service.proto\x12\x04grpc\"\x82\x01\n" +
+	")internal/handler/proto/grpc/service.proto\x12\x04grpc\"\xf6\x01\n" +
 	"\bUserData\x12\x0e\n" +
 	"\x02id\x18\x01 \x02(\x03R\x02id\x12\x1b\n" +
 	"\tuser_name\x18\x02 \x02(\tR\buserName\x12!\n" +
 	"\fdisplay_name\x18\x03 \x02(\tR\vdisplayName\x12\x14\n" +
 	"\x05email\x18\x04 \x02(\tR\x05email\x12\x10\n" +
-	"\x03dob\x18\x05 \x02(\tR\x03dob\"\x91\x01\n" +
+	"\x03dob\x18\x05 \x02(\tR\x03dob\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x06 \x01(\bR\tisPrivate\x12,\n" +
+	"\x06counts\x18\a \x01(\v2\x14.grpc.UserCountsDataR\x06counts\x12%\n" +
+	"\x0eemail_verified\x18\b \x01(\bR\remailVerified\"d\n" +
+	"\x0eUserCountsData\x12\x1c\n" +
+	"\tfollowers\x18\x01 \x02(\x03R\tfollowers\x12\x1e\n" +
+	"\n" +
+	"followings\x18\x02 \x02(\x03R\n" +
+	"followings\x12\x14\n" +
+	"\x05posts\x18\x03 \x02(\x03R\x05posts\"\x91\x01\n" +
 	"\n" +
 	"FollowData\x12*\n" +
 	"\bfollower\x18\x01 \x01(\v2\x0e.grpc.UserDataR\bfollower\x12,\n" +
@@ -1135,8 +5169,62 @@ const file_internal_handler_proto_grpc_service_proto_rawDesc = "" +
 	"\x04user\x18\x01 \x02(\v2\x0e.grpc.UserDataR\x04user\"G\n" +
 	"\fLoginRequest\x12\x1b\n" +
 	"\tuser_name\x18\x01 \x02(\tR\buserName\x12\x1a\n" +
-	"\bpassword\x18\x02 \x02(\tR\bpassword\"3\n" +
+	"\bpassword\x18\x02 \x02(\tR\bpassword\"`\n" +
 	"\rLoginResponse\x12\"\n" +
+	"\x04user\x18\x01 \x02(\v2\x0e.grpc.UserDataR\x04user\x12+\n" +
+	"\asession\x18\x02 \x02(\v2\x11.grpc.SessionDataR\asession\"n\n" +
+	"\vSessionData\x12\x0e\n" +
+	"\x02id\x18\x01 \x02(\tR\x02id\x12#\n" +
+	"\rrefresh_token\x18\x02 \x02(\tR\frefreshToken\x12*\n" +
+	"\x11refresh_expire_ts\x18\x03 \x02(\x03R\x0frefreshExpireTs\"<\n" +
+	"\x15RefreshSessionRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x02(\tR\frefreshToken\"i\n" +
+	"\x16RefreshSessionResponse\x12\"\n" +
+	"\x04user\x18\x01 \x02(\v2\x0e.grpc.UserDataR\x04user\x12+\n" +
+	"\asession\x18\x02 \x02(\v2\x11.grpc.SessionDataR\asession\"G\n" +
+	"\rLogoutRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x02(\tR\tsessionId\"4\n" +
+	"\x0eLogoutResponse\x12\"\n" +
+	"\ris_logged_out\x18\x01 \x02(\bR\visLoggedOut\"+\n" +
+	"\x10LogoutAllRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\"7\n" +
+	"\x11LogoutAllResponse\x12\"\n" +
+	"\ris_logged_out\x18\x01 \x02(\bR\visLoggedOut\"M\n" +
+	"\x13CheckSessionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x02(\tR\tsessionId\".\n" +
+	"\x14CheckSessionResponse\x12\x16\n" +
+	"\x06active\x18\x01 \x02(\bR\x06active\"3\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x02(\tR\x05email\"A\n" +
+	"\x1cRequestPasswordResetResponse\x12!\n" +
+	"\fis_requested\x18\x01 \x02(\bR\visRequested\"V\n" +
+	"\x1bConfirmPasswordResetRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x02(\tR\x05token\x12!\n" +
+	"\fnew_password\x18\x02 \x02(\tR\vnewPassword\"9\n" +
+	"\x1cConfirmPasswordResetResponse\x12\x19\n" +
+	"\bis_reset\x18\x01 \x02(\bR\aisReset\"*\n" +
+	"\x12VerifyEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x02(\tR\x05token\"9\n" +
+	"\x13VerifyEmailResponse\x12\"\n" +
+	"\x04user\x18\x01 \x02(\v2\x0e.grpc.UserDataR\x04user\"9\n" +
+	"\x1eResendEmailVerificationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\":\n" +
+	"\x1fResendEmailVerificationResponse\x12\x17\n" +
+	"\ais_sent\x18\x01 \x02(\bR\x06isSent\"K\n" +
+	"\x11SetPrivateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x02 \x02(\bR\tisPrivate\"8\n" +
+	"\x12SetPrivateResponse\x12\"\n" +
+	"\x04user\x18\x01 \x02(\v2\x0e.grpc.UserDataR\x04user\"I\n" +
+	"\x11GetProfileRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x02(\x03R\bviewerId\x12\x17\n" +
+	"\auser_id\x18\x02 \x02(\x03R\x06userId\"8\n" +
+	"\x12GetProfileResponse\x12\"\n" +
 	"\x04user\x18\x01 \x02(\v2\x0e.grpc.UserDataR\x04user\"\x7f\n" +
 	"\fUserUserData\x12\x0e\n" +
 	"\x02id\x18\x01 \x02(\x03R\x02id\x12\x1f\n" +
@@ -1156,40 +5244,289 @@ const file_internal_handler_proto_grpc_service_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
 	"\apeer_id\x18\x02 \x02(\x03R\x06peerId\"7\n" +
 	"\x10UnfollowResponse\x12#\n" +
-	"\ris_unfollowed\x18\x01 \x02(\bR\fisUnfollowed\"C\n" +
-	"\fFollowPaging\x12\x1d\n" +
-	"\n" +
-	"last_value\x18\x01 \x02(\x03R\tlastValue\x12\x14\n" +
-	"\x05limit\x18\x02 \x02(\x03R\x05limit\"Z\n" +
+	"\ris_unfollowed\x18\x01 \x02(\bR\fisUnfollowed\"\\\n" +
 	"\x13GetFollowersRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12*\n" +
-	"\x06paging\x18\x02 \x02(\v2\x12.grpc.FollowPagingR\x06paging\"F\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x02(\x03R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"g\n" +
 	"\x14GetFollowersResponse\x12.\n" +
-	"\tfollowers\x18\x01 \x03(\v2\x10.grpc.FollowDataR\tfollowers\"[\n" +
+	"\tfollowers\x18\x01 \x03(\v2\x10.grpc.FollowDataR\tfollowers\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"]\n" +
 	"\x14GetFollowingsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12*\n" +
-	"\x06paging\x18\x02 \x02(\v2\x12.grpc.FollowPagingR\x06paging\"I\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x02(\x03R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"j\n" +
 	"\x15GetFollowingsResponse\x120\n" +
 	"\n" +
 	"followings\x18\x01 \x03(\v2\x10.grpc.FollowDataR\n" +
-	"followings\"\x13\n" +
-	"\x11CreatePostRequest\"\x14\n" +
-	"\x12CreatePostResponse\"\x11\n" +
-	"\x0fGetPostsRequest\"\x12\n" +
-	"\x10GetPostsResponse\"\x14\n" +
-	"\x12GetNewsfeedRequest\"\x15\n" +
-	"\x13GetNewsfeedResponse2\xc3\x04\n" +
+	"followings\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"~\n" +
+	"\x11FollowRequestData\x12\x0e\n" +
+	"\x02id\x18\x01 \x02(\x03R\x02id\x12,\n" +
+	"\trequester\x18\x02 \x02(\v2\x0e.grpc.UserDataR\trequester\x12+\n" +
+	"\x11created_timestamp\x18\x03 \x02(\x03R\x10createdTimestamp\"a\n" +
+	"\x18GetFollowRequestsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x02(\x03R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"q\n" +
+	"\x19GetFollowRequestsResponse\x123\n" +
+	"\brequests\x18\x01 \x03(\v2\x17.grpc.FollowRequestDataR\brequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"Y\n" +
+	"\x1bApproveFollowRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12!\n" +
+	"\frequester_id\x18\x02 \x02(\x03R\vrequesterId\"H\n" +
+	"\x1cApproveFollowRequestResponse\x12(\n" +
+	"\x06follow\x18\x01 \x02(\v2\x10.grpc.FollowDataR\x06follow\"X\n" +
+	"\x1aRejectFollowRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12!\n" +
+	"\frequester_id\x18\x02 \x02(\x03R\vrequesterId\">\n" +
+	"\x1bRejectFollowRequestResponse\x12\x1f\n" +
+	"\vis_rejected\x18\x01 \x02(\bR\n" +
+	"isRejected\"N\n" +
+	"\x1aCancelFollowRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apeer_id\x18\x02 \x02(\x03R\x06peerId\"@\n" +
+	"\x1bCancelFollowRequestResponse\x12!\n" +
+	"\fis_cancelled\x18\x01 \x02(\bR\visCancelled\"I\n" +
+	"\x15AddCloseFriendRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apeer_id\x18\x02 \x02(\x03R\x06peerId\"@\n" +
+	"\x16AddCloseFriendResponse\x12&\n" +
+	"\x06friend\x18\x01 \x02(\v2\x0e.grpc.UserDataR\x06friend\"L\n" +
+	"\x18RemoveCloseFriendRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apeer_id\x18\x02 \x02(\x03R\x06peerId\":\n" +
+	"\x19RemoveCloseFriendResponse\x12\x1d\n" +
+	"\n" +
+	"is_removed\x18\x01 \x02(\bR\tisRemoved\"_\n" +
+	"\x16GetCloseFriendsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x02(\x03R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"d\n" +
+	"\x17GetCloseFriendsResponse\x12(\n" +
+	"\afriends\x18\x01 \x03(\v2\x0e.grpc.UserDataR\afriends\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"@\n" +
+	"\fBlockRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apeer_id\x18\x02 \x02(\x03R\x06peerId\".\n" +
+	"\rBlockResponse\x12\x1d\n" +
+	"\n" +
+	"is_blocked\x18\x01 \x02(\bR\tisBlocked\"B\n" +
+	"\x0eUnblockRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apeer_id\x18\x02 \x02(\x03R\x06peerId\"4\n" +
+	"\x0fUnblockResponse\x12!\n" +
+	"\fis_unblocked\x18\x01 \x02(\bR\visUnblocked\"?\n" +
+	"\vMuteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apeer_id\x18\x02 \x02(\x03R\x06peerId\")\n" +
+	"\fMuteResponse\x12\x19\n" +
+	"\bis_muted\x18\x01 \x02(\bR\aisMuted\"A\n" +
+	"\rUnmuteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apeer_id\x18\x02 \x02(\x03R\x06peerId\"/\n" +
+	"\x0eUnmuteResponse\x12\x1d\n" +
+	"\n" +
+	"is_unmuted\x18\x01 \x02(\bR\tisUnmuted\"\xa5\x01\n" +
+	"\x10RelationshipData\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x1c\n" +
+	"\tfollowing\x18\x02 \x02(\bR\tfollowing\x12\x1f\n" +
+	"\vfollowed_by\x18\x03 \x02(\bR\n" +
+	"followedBy\x12\x1a\n" +
+	"\bblocking\x18\x04 \x02(\bR\bblocking\x12\x1d\n" +
+	"\n" +
+	"blocked_by\x18\x05 \x02(\bR\tblockedBy\"Q\n" +
+	"\x17GetRelationshipsRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x02(\x03R\bviewerId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\x03R\auserIds\"X\n" +
+	"\x18GetRelationshipsResponse\x12<\n" +
+	"\rrelationships\x18\x01 \x03(\v2\x16.grpc.RelationshipDataR\rrelationships\"\xa2\x01\n" +
+	"\x0eSuggestionData\x12\"\n" +
+	"\x04user\x18\x01 \x02(\v2\x0e.grpc.UserDataR\x04user\x12\x14\n" +
+	"\x05score\x18\x02 \x02(\x01R\x05score\x12+\n" +
+	"\x11mutual_followings\x18\x03 \x02(\x03R\x10mutualFollowings\x12)\n" +
+	"\x10mutual_followers\x18\x04 \x02(\x03R\x0fmutualFollowers\"F\n" +
+	"\x15GetSuggestionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x02(\x03R\x05limit\"P\n" +
+	"\x16GetSuggestionsResponse\x126\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x14.grpc.SuggestionDataR\vsuggestions\"\xb7\x01\n" +
+	"\tMediaData\x12\x0e\n" +
+	"\x02id\x18\x01 \x02(\x03R\x02id\x12!\n" +
+	"\fcontent_type\x18\x02 \x02(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x03 \x02(\x03R\x04size\x12\x14\n" +
+	"\x05width\x18\x04 \x02(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x02(\x05R\x06height\x12\x10\n" +
+	"\x03url\x18\x06 \x02(\tR\x03url\x12#\n" +
+	"\rthumbnail_url\x18\a \x02(\tR\fthumbnailUrl\"A\n" +
+	"\x12UploadMediaRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x12\n" +
+	"\x04data\x18\x02 \x02(\fR\x04data\"<\n" +
+	"\x13UploadMediaResponse\x12%\n" +
+	"\x05media\x18\x01 \x02(\v2\x0f.grpc.MediaDataR\x05media\"\xc4\x02\n" +
+	"\bPostData\x12\x0e\n" +
+	"\x02id\x18\x01 \x02(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x02(\x03R\x06userId\x12\x18\n" +
+	"\acontent\x18\x03 \x02(\tR\acontent\x12+\n" +
+	"\x11created_timestamp\x18\x04 \x02(\x03R\x10createdTimestamp\x12%\n" +
+	"\x05media\x18\x05 \x03(\v2\x0f.grpc.MediaDataR\x05media\x12#\n" +
+	"\rcomment_count\x18\x06 \x01(\x03R\fcommentCount\x121\n" +
+	"\treactions\x18\a \x01(\v2\x13.grpc.ReactionsDataR\treactions\x12)\n" +
+	"\x10edited_timestamp\x18\b \x01(\x03R\x0feditedTimestamp\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\t \x01(\tR\n" +
+	"visibility\"N\n" +
+	"\x11ReactionCountData\x12#\n" +
+	"\rreaction_type\x18\x01 \x02(\tR\freactionType\x12\x14\n" +
+	"\x05count\x18\x02 \x02(\x03R\x05count\"\x7f\n" +
+	"\rReactionsData\x12/\n" +
+	"\x06counts\x18\x01 \x03(\v2\x17.grpc.ReactionCountDataR\x06counts\x12\x14\n" +
+	"\x05total\x18\x02 \x02(\x03R\x05total\x12'\n" +
+	"\x0fviewer_reaction\x18\x03 \x01(\tR\x0eviewerReaction\"\x83\x01\n" +
+	"\x11CreatePostRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x18\n" +
+	"\acontent\x18\x02 \x02(\tR\acontent\x12\x1b\n" +
+	"\tmedia_ids\x18\x03 \x03(\x03R\bmediaIds\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\tR\n" +
+	"visibility\"8\n" +
+	"\x12CreatePostResponse\x12\"\n" +
+	"\x04post\x18\x01 \x02(\v2\x0e.grpc.PostDataR\x04post\"_\n" +
+	"\x11UpdatePostRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x02(\x03R\x06postId\x12\x18\n" +
+	"\acontent\x18\x03 \x02(\tR\acontent\"8\n" +
+	"\x12UpdatePostResponse\x12\"\n" +
+	"\x04post\x18\x01 \x02(\v2\x0e.grpc.PostDataR\x04post\"\x82\x01\n" +
+	"\x10PostRevisionData\x12\x0e\n" +
+	"\x02id\x18\x01 \x02(\x03R\x02id\x12\x17\n" +
+	"\apost_id\x18\x02 \x02(\x03R\x06postId\x12\x18\n" +
+	"\acontent\x18\x03 \x02(\tR\acontent\x12+\n" +
+	"\x11created_timestamp\x18\x04 \x02(\x03R\x10createdTimestamp\"z\n" +
+	"\x18ListPostRevisionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x02(\x03R\x06postId\x12\x14\n" +
+	"\x05limit\x18\x03 \x02(\x03R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"r\n" +
+	"\x19ListPostRevisionsResponse\x124\n" +
+	"\trevisions\x18\x01 \x03(\v2\x16.grpc.PostRevisionDataR\trevisions\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"E\n" +
+	"\x11DeletePostRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x02(\x03R\x06postId\"3\n" +
+	"\x12DeletePostResponse\x12\x1d\n" +
+	"\n" +
+	"is_deleted\x18\x01 \x02(\bR\tisDeleted\"u\n" +
+	"\x0fGetPostsRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x02(\x03R\bviewerId\x12\x17\n" +
+	"\auser_id\x18\x02 \x02(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x03 \x02(\x03R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"Y\n" +
+	"\x10GetPostsResponse\x12$\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.grpc.PostDataR\x05posts\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"u\n" +
+	"\x12GetNewsfeedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x02(\x03R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x18\n" +
+	"\aranking\x18\x04 \x01(\tR\aranking\"\x83\x01\n" +
+	"\x13GetNewsfeedResponse\x12$\n" +
+	"\x05posts\x18\x01 \x03(\v2\x0e.grpc.PostDataR\x05posts\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12%\n" +
+	"\x0eranking_scores\x18\x03 \x03(\x01R\rrankingScores\"i\n" +
+	"\x10ReactPostRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x02(\x03R\x06postId\x12#\n" +
+	"\rreaction_type\x18\x03 \x01(\tR\freactionType\"F\n" +
+	"\x11ReactPostResponse\x121\n" +
+	"\treactions\x18\x01 \x02(\v2\x13.grpc.ReactionsDataR\treactions\"\xd4\x01\n" +
+	"\vCommentData\x12\x0e\n" +
+	"\x02id\x18\x01 \x02(\x03R\x02id\x12\x17\n" +
+	"\apost_id\x18\x02 \x02(\x03R\x06postId\x12\x17\n" +
+	"\auser_id\x18\x03 \x02(\x03R\x06userId\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x02(\x03R\bparentId\x12\x18\n" +
+	"\acontent\x18\x05 \x02(\tR\acontent\x12\x1f\n" +
+	"\vreply_count\x18\x06 \x02(\x03R\n" +
+	"replyCount\x12+\n" +
+	"\x11created_timestamp\x18\a \x02(\x03R\x10createdTimestamp\"\x7f\n" +
+	"\x14CreateCommentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x02(\x03R\x06postId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12\x18\n" +
+	"\acontent\x18\x04 \x02(\tR\acontent\"D\n" +
+	"\x15CreateCommentResponse\x12+\n" +
+	"\acomment\x18\x01 \x02(\v2\x11.grpc.CommentDataR\acomment\"\x92\x01\n" +
+	"\x13ListCommentsRequest\x12\x17\n" +
+	"\auser_id\x18\x05 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x01 \x02(\x03R\x06postId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\x12\x14\n" +
+	"\x05limit\x18\x03 \x02(\x03R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"f\n" +
+	"\x14ListCommentsResponse\x12-\n" +
+	"\bcomments\x18\x01 \x03(\v2\x11.grpc.CommentDataR\bcomments\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"g\n" +
+	"\x14DeleteCommentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x02(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x02(\x03R\x06postId\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x03 \x02(\x03R\tcommentId\"6\n" +
+	"\x15DeleteCommentResponse\x12\x1d\n" +
+	"\n" +
+	"is_deleted\x18\x01 \x02(\bR\tisDeleted2\xf7\x16\n" +
 	"\aService\x125\n" +
 	"\x06Signup\x12\x13.grpc.SignupRequest\x1a\x14.grpc.SignupResponse\"\x00\x122\n" +
-	"\x05Login\x12\x12.grpc.LoginRequest\x1a\x13.grpc.LoginResponse\"\x00\x125\n" +
+	"\x05Login\x12\x12.grpc.LoginRequest\x1a\x13.grpc.LoginResponse\"\x00\x12M\n" +
+	"\x0eRefreshSession\x12\x1b.grpc.RefreshSessionRequest\x1a\x1c.grpc.RefreshSessionResponse\"\x00\x125\n" +
+	"\x06Logout\x12\x13.grpc.LogoutRequest\x1a\x14.grpc.LogoutResponse\"\x00\x12>\n" +
+	"\tLogoutAll\x12\x16.grpc.LogoutAllRequest\x1a\x17.grpc.LogoutAllResponse\"\x00\x12G\n" +
+	"\fCheckSession\x12\x19.grpc.CheckSessionRequest\x1a\x1a.grpc.CheckSessionResponse\"\x00\x12_\n" +
+	"\x14RequestPasswordReset\x12!.grpc.RequestPasswordResetRequest\x1a\".grpc.RequestPasswordResetResponse\"\x00\x12_\n" +
+	"\x14ConfirmPasswordReset\x12!.grpc.ConfirmPasswordResetRequest\x1a\".grpc.ConfirmPasswordResetResponse\"\x00\x12D\n" +
+	"\vVerifyEmail\x12\x18.grpc.VerifyEmailRequest\x1a\x19.grpc.VerifyEmailResponse\"\x00\x12h\n" +
+	"\x17ResendEmailVerification\x12$.grpc.ResendEmailVerificationRequest\x1a%.grpc.ResendEmailVerificationResponse\"\x00\x12A\n" +
+	"\n" +
+	"SetPrivate\x12\x17.grpc.SetPrivateRequest\x1a\x18.grpc.SetPrivateResponse\"\x00\x12A\n" +
+	"\n" +
+	"GetProfile\x12\x17.grpc.GetProfileRequest\x1a\x18.grpc.GetProfileResponse\"\x00\x125\n" +
 	"\x06Follow\x12\x13.grpc.FollowRequest\x1a\x14.grpc.FollowResponse\"\x00\x12;\n" +
 	"\bUnfollow\x12\x15.grpc.UnfollowRequest\x1a\x16.grpc.UnfollowResponse\"\x00\x12G\n" +
 	"\fGetFollowers\x12\x19.grpc.GetFollowersRequest\x1a\x1a.grpc.GetFollowersResponse\"\x00\x12J\n" +
-	"\rGetFollowings\x12\x1a.grpc.GetFollowingsRequest\x1a\x1b.grpc.GetFollowingsResponse\"\x00\x12A\n" +
+	"\rGetFollowings\x12\x1a.grpc.GetFollowingsRequest\x1a\x1b.grpc.GetFollowingsResponse\"\x00\x12V\n" +
+	"\x11GetFollowRequests\x12\x1e.grpc.GetFollowRequestsRequest\x1a\x1f.grpc.GetFollowRequestsResponse\"\x00\x12_\n" +
+	"\x14ApproveFollowRequest\x12!.grpc.ApproveFollowRequestRequest\x1a\".grpc.ApproveFollowRequestResponse\"\x00\x12\\\n" +
+	"\x13RejectFollowRequest\x12 .grpc.RejectFollowRequestRequest\x1a!.grpc.RejectFollowRequestResponse\"\x00\x12\\\n" +
+	"\x13CancelFollowRequest\x12 .grpc.CancelFollowRequestRequest\x1a!.grpc.CancelFollowRequestResponse\"\x00\x12M\n" +
+	"\x0eAddCloseFriend\x12\x1b.grpc.AddCloseFriendRequest\x1a\x1c.grpc.AddCloseFriendResponse\"\x00\x12V\n" +
+	"\x11RemoveCloseFriend\x12\x1e.grpc.RemoveCloseFriendRequest\x1a\x1f.grpc.RemoveCloseFriendResponse\"\x00\x12P\n" +
+	"\x0fGetCloseFriends\x12\x1c.grpc.GetCloseFriendsRequest\x1a\x1d.grpc.GetCloseFriendsResponse\"\x00\x122\n" +
+	"\x05Block\x12\x12.grpc.BlockRequest\x1a\x13.grpc.BlockResponse\"\x00\x128\n" +
+	"\aUnblock\x12\x14.grpc.UnblockRequest\x1a\x15.grpc.UnblockResponse\"\x00\x12/\n" +
+	"\x04Mute\x12\x11.grpc.MuteRequest\x1a\x12.grpc.MuteResponse\"\x00\x125\n" +
+	"\x06Unmute\x12\x13.grpc.UnmuteRequest\x1a\x14.grpc.UnmuteResponse\"\x00\x12S\n" +
+	"\x10GetRelationships\x12\x1d.grpc.GetRelationshipsRequest\x1a\x1e.grpc.GetRelationshipsResponse\"\x00\x12M\n" +
+	"\x0eGetSuggestions\x12\x1b.grpc.GetSuggestionsRequest\x1a\x1c.grpc.GetSuggestionsResponse\"\x00\x12D\n" +
+	"\vUploadMedia\x12\x18.grpc.UploadMediaRequest\x1a\x19.grpc.UploadMediaResponse\"\x00\x12A\n" +
+	"\n" +
+	"CreatePost\x12\x17.grpc.CreatePostRequest\x1a\x18.grpc.CreatePostResponse\"\x00\x12A\n" +
+	"\n" +
+	"UpdatePost\x12\x17.grpc.UpdatePostRequest\x1a\x18.grpc.UpdatePostResponse\"\x00\x12V\n" +
+	"\x11ListPostRevisions\x12\x1e.grpc.ListPostRevisionsRequest\x1a\x1f.grpc.ListPostRevisionsResponse\"\x00\x12A\n" +
 	"\n" +
-	"CreatePost\x12\x17.grpc.CreatePostRequest\x1a\x18.grpc.CreatePostResponse\"\x00\x12;\n" +
+	"DeletePost\x12\x17.grpc.DeletePostRequest\x1a\x18.grpc.DeletePostResponse\"\x00\x12;\n" +
 	"\bGetPosts\x12\x15.grpc.GetPostsRequest\x1a\x16.grpc.GetPostsResponse\"\x00\x12D\n" +
-	"\vGetNewsfeed\x12\x18.grpc.GetNewsfeedRequest\x1a\x19.grpc.GetNewsfeedResponse\"\x00B-Z+ep.k16/newsfeed/internal/handler/proto/grpc"
+	"\vGetNewsfeed\x12\x18.grpc.GetNewsfeedRequest\x1a\x19.grpc.GetNewsfeedResponse\"\x00\x12>\n" +
+	"\tReactPost\x12\x16.grpc.ReactPostRequest\x1a\x17.grpc.ReactPostResponse\"\x00\x12J\n" +
+	"\rCreateComment\x12\x1a.grpc.CreateCommentRequest\x1a\x1b.grpc.CreateCommentResponse\"\x00\x12G\n" +
+	"\fListComments\x12\x19.grpc.ListCommentsRequest\x1a\x1a.grpc.ListCommentsResponse\"\x00\x12J\n" +
+	"\rDeleteComment\x12\x1a.grpc.DeleteCommentRequest\x1a\x1b.grpc.DeleteCommentResponse\"\x00B-Z+ep.k16/newsfeed/internal/handler/proto/grpc"
 
 var (
 	file_internal_handler_proto_grpc_service_proto_rawDescOnce sync.Once
@@ -1203,65 +5540,224 @@ func file_internal_handler_proto_grpc_service_proto_rawDescGZIP() []byte {
 	return file_internal_handler_proto_grpc_service_proto_rawDescData
 }
 
-var file_internal_handler_proto_grpc_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_internal_handler_proto_grpc_service_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
 var file_internal_handler_proto_grpc_service_proto_goTypes = []any{
-	(*UserData)(nil),              // 0: grpc.UserData
-	(*FollowData)(nil),            // 1: grpc.FollowData
-	(*SignupRequest)(nil),         // 2: grpc.SignupRequest
-	(*SignupResponse)(nil),        // 3: grpc.SignupResponse
-	(*LoginRequest)(nil),          // 4: grpc.LoginRequest
-	(*LoginResponse)(nil),         // 5: grpc.LoginResponse
-	(*UserUserData)(nil),          // 6: grpc.UserUserData
-	(*FollowRequest)(nil),         // 7: grpc.FollowRequest
-	(*FollowResponse)(nil),        // 8: grpc.FollowResponse
-	(*UnfollowRequest)(nil),       // 9: grpc.UnfollowRequest
-	(*UnfollowResponse)(nil),      // 10: grpc.UnfollowResponse
-	(*FollowPaging)(nil),          // 11: grpc.FollowPaging
-	(*GetFollowersRequest)(nil),   // 12: grpc.GetFollowersRequest
-	(*GetFollowersResponse)(nil),  // 13: grpc.GetFollowersResponse
-	(*GetFollowingsRequest)(nil),  // 14: grpc.GetFollowingsRequest
-	(*GetFollowingsResponse)(nil), // 15: grpc.GetFollowingsResponse
-	(*CreatePostRequest)(nil),     // 16: grpc.CreatePostRequest
-	(*CreatePostResponse)(nil),    // 17: grpc.CreatePostResponse
-	(*GetPostsRequest)(nil),       // 18: grpc.GetPostsRequest
-	(*GetPostsResponse)(nil),      // 19: grpc.GetPostsResponse
-	(*GetNewsfeedRequest)(nil),    // 20: grpc.GetNewsfeedRequest
-	(*GetNewsfeedResponse)(nil),   // 21: grpc.GetNewsfeedResponse
+	(*UserData)(nil),                        // 0: grpc.UserData
+	(*UserCountsData)(nil),                  // 1: grpc.UserCountsData
+	(*FollowData)(nil),                      // 2: grpc.FollowData
+	(*SignupRequest)(nil),                   // 3: grpc.SignupRequest
+	(*SignupResponse)(nil),                  // 4: grpc.SignupResponse
+	(*LoginRequest)(nil),                    // 5: grpc.LoginRequest
+	(*LoginResponse)(nil),                   // 6: grpc.LoginResponse
+	(*SessionData)(nil),                     // 7: grpc.SessionData
+	(*RefreshSessionRequest)(nil),           // 8: grpc.RefreshSessionRequest
+	(*RefreshSessionResponse)(nil),          // 9: grpc.RefreshSessionResponse
+	(*LogoutRequest)(nil),                   // 10: grpc.LogoutRequest
+	(*LogoutResponse)(nil),                  // 11: grpc.LogoutResponse
+	(*LogoutAllRequest)(nil),                // 12: grpc.LogoutAllRequest
+	(*LogoutAllResponse)(nil),               // 13: grpc.LogoutAllResponse
+	(*CheckSessionRequest)(nil),             // 14: grpc.CheckSessionRequest
+	(*CheckSessionResponse)(nil),            // 15: grpc.CheckSessionResponse
+	(*RequestPasswordResetRequest)(nil),     // 16: grpc.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),    // 17: grpc.RequestPasswordResetResponse
+	(*ConfirmPasswordResetRequest)(nil),     // 18: grpc.ConfirmPasswordResetRequest
+	(*ConfirmPasswordResetResponse)(nil),    // 19: grpc.ConfirmPasswordResetResponse
+	(*VerifyEmailRequest)(nil),              // 20: grpc.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),             // 21: grpc.VerifyEmailResponse
+	(*ResendEmailVerificationRequest)(nil),  // 22: grpc.ResendEmailVerificationRequest
+	(*ResendEmailVerificationResponse)(nil), // 23: grpc.ResendEmailVerificationResponse
+	(*SetPrivateRequest)(nil),               // 24: grpc.SetPrivateRequest
+	(*SetPrivateResponse)(nil),              // 25: grpc.SetPrivateResponse
+	(*GetProfileRequest)(nil),               // 26: grpc.GetProfileRequest
+	(*GetProfileResponse)(nil),              // 27: grpc.GetProfileResponse
+	(*UserUserData)(nil),                    // 28: grpc.UserUserData
+	(*FollowRequest)(nil),                   // 29: grpc.FollowRequest
+	(*FollowResponse)(nil),                  // 30: grpc.FollowResponse
+	(*UnfollowRequest)(nil),                 // 31: grpc.UnfollowRequest
+	(*UnfollowResponse)(nil),                // 32: grpc.UnfollowResponse
+	(*GetFollowersRequest)(nil),             // 33: grpc.GetFollowersRequest
+	(*GetFollowersResponse)(nil),            // 34: grpc.GetFollowersResponse
+	(*GetFollowingsRequest)(nil),            // 35: grpc.GetFollowingsRequest
+	(*GetFollowingsResponse)(nil),           // 36: grpc.GetFollowingsResponse
+	(*FollowRequestData)(nil),               // 37: grpc.FollowRequestData
+	(*GetFollowRequestsRequest)(nil),        // 38: grpc.GetFollowRequestsRequest
+	(*GetFollowRequestsResponse)(nil),       // 39: grpc.GetFollowRequestsResponse
+	(*ApproveFollowRequestRequest)(nil),     // 40: grpc.ApproveFollowRequestRequest
+	(*ApproveFollowRequestResponse)(nil),    // 41: grpc.ApproveFollowRequestResponse
+	(*RejectFollowRequestRequest)(nil),      // 42: grpc.RejectFollowRequestRequest
+	(*RejectFollowRequestResponse)(nil),     // 43: grpc.RejectFollowRequestResponse
+	(*CancelFollowRequestRequest)(nil),      // 44: grpc.CancelFollowRequestRequest
+	(*CancelFollowRequestResponse)(nil),     // 45: grpc.CancelFollowRequestResponse
+	(*AddCloseFriendRequest)(nil),           // 46: grpc.AddCloseFriendRequest
+	(*AddCloseFriendResponse)(nil),          // 47: grpc.AddCloseFriendResponse
+	(*RemoveCloseFriendRequest)(nil),        // 48: grpc.RemoveCloseFriendRequest
+	(*RemoveCloseFriendResponse)(nil),       // 49: grpc.RemoveCloseFriendResponse
+	(*GetCloseFriendsRequest)(nil),          // 50: grpc.GetCloseFriendsRequest
+	(*GetCloseFriendsResponse)(nil),         // 51: grpc.GetCloseFriendsResponse
+	(*BlockRequest)(nil),                    // 52: grpc.BlockRequest
+	(*BlockResponse)(nil),                   // 53: grpc.BlockResponse
+	(*UnblockRequest)(nil),                  // 54: grpc.UnblockRequest
+	(*UnblockResponse)(nil),                 // 55: grpc.UnblockResponse
+	(*MuteRequest)(nil),                     // 56: grpc.MuteRequest
+	(*MuteResponse)(nil),                    // 57: grpc.MuteResponse
+	(*UnmuteRequest)(nil),                   // 58: grpc.UnmuteRequest
+	(*UnmuteResponse)(nil),                  // 59: grpc.UnmuteResponse
+	(*RelationshipData)(nil),                // 60: grpc.RelationshipData
+	(*GetRelationshipsRequest)(nil),         // 61: grpc.GetRelationshipsRequest
+	(*GetRelationshipsResponse)(nil),        // 62: grpc.GetRelationshipsResponse
+	(*SuggestionData)(nil),                  // 63: grpc.SuggestionData
+	(*GetSuggestionsRequest)(nil),           // 64: grpc.GetSuggestionsRequest
+	(*GetSuggestionsResponse)(nil),          // 65: grpc.GetSuggestionsResponse
+	(*MediaData)(nil),                       // 66: grpc.MediaData
+	(*UploadMediaRequest)(nil),              // 67: grpc.UploadMediaRequest
+	(*UploadMediaResponse)(nil),             // 68: grpc.UploadMediaResponse
+	(*PostData)(nil),                        // 69: grpc.PostData
+	(*ReactionCountData)(nil),               // 70: grpc.ReactionCountData
+	(*ReactionsData)(nil),                   // 71: grpc.ReactionsData
+	(*CreatePostRequest)(nil),               // 72: grpc.CreatePostRequest
+	(*CreatePostResponse)(nil),              // 73: grpc.CreatePostResponse
+	(*UpdatePostRequest)(nil),               // 74: grpc.UpdatePostRequest
+	(*UpdatePostResponse)(nil),              // 75: grpc.UpdatePostResponse
+	(*PostRevisionData)(nil),                // 76: grpc.PostRevisionData
+	(*ListPostRevisionsRequest)(nil),        // 77: grpc.ListPostRevisionsRequest
+	(*ListPostRevisionsResponse)(nil),       // 78: grpc.ListPostRevisionsResponse
+	(*DeletePostRequest)(nil),               // 79: grpc.DeletePostRequest
+	(*DeletePostResponse)(nil),              // 80: grpc.DeletePostResponse
+	(*GetPostsRequest)(nil),                 // 81: grpc.GetPostsRequest
+	(*GetPostsResponse)(nil),                // 82: grpc.GetPostsResponse
+	(*GetNewsfeedRequest)(nil),              // 83: grpc.GetNewsfeedRequest
+	(*GetNewsfeedResponse)(nil),             // 84: grpc.GetNewsfeedResponse
+	(*ReactPostRequest)(nil),                // 85: grpc.ReactPostRequest
+	(*ReactPostResponse)(nil),               // 86: grpc.ReactPostResponse
+	(*CommentData)(nil),                     // 87: grpc.CommentData
+	(*CreateCommentRequest)(nil),            // 88: grpc.CreateCommentRequest
+	(*CreateCommentResponse)(nil),           // 89: grpc.CreateCommentResponse
+	(*ListCommentsRequest)(nil),             // 90: grpc.ListCommentsRequest
+	(*ListCommentsResponse)(nil),            // 91: grpc.ListCommentsResponse
+	(*DeleteCommentRequest)(nil),            // 92: grpc.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),           // 93: grpc.DeleteCommentResponse
 }
 var file_internal_handler_proto_grpc_service_proto_depIdxs = []int32{
-	0,  // 0: grpc.FollowData.follower:type_name -> grpc.UserData
-	0,  // 1: grpc.FollowData.following:type_name -> grpc.UserData
-	0,  // 2: grpc.SignupResponse.user:type_name -> grpc.UserData
-	0,  // 3: grpc.LoginResponse.user:type_name -> grpc.UserData
-	6,  // 4: grpc.FollowResponse.pair:type_name -> grpc.UserUserData
-	0,  // 5: grpc.FollowResponse.following:type_name -> grpc.UserData
-	11, // 6: grpc.GetFollowersRequest.paging:type_name -> grpc.FollowPaging
-	1,  // 7: grpc.GetFollowersResponse.followers:type_name -> grpc.FollowData
-	11, // 8: grpc.GetFollowingsRequest.paging:type_name -> grpc.FollowPaging
-	1,  // 9: grpc.GetFollowingsResponse.followings:type_name -> grpc.FollowData
-	2,  // 10: grpc.Service.Signup:input_type -> grpc.SignupRequest
-	4,  // 11: grpc.Service.Login:input_type -> grpc.LoginRequest
-	7,  // 12: grpc.Service.Follow:input_type -> grpc.FollowRequest
-	9,  // 13: grpc.Service.Unfollow:input_type -> grpc.UnfollowRequest
-	12, // 14: grpc.Service.GetFollowers:input_type -> grpc.GetFollowersRequest
-	14, // 15: grpc.Service.GetFollowings:input_type -> grpc.GetFollowingsRequest
-	16, // 16: grpc.Service.CreatePost:input_type -> grpc.CreatePostRequest
-	18, // 17: grpc.Service.GetPosts:input_type -> grpc.GetPostsRequest
-	20, // 18: grpc.Service.GetNewsfeed:input_type -> grpc.GetNewsfeedRequest
-	3,  // 19: grpc.Service.Signup:output_type -> grpc.SignupResponse
-	5,  // 20: grpc.Service.Login:output_type -> grpc.LoginResponse
-	8,  // 21: grpc.Service.Follow:output_type -> grpc.FollowResponse
-	10, // 22: grpc.Service.Unfollow:output_type -> grpc.UnfollowResponse
-	13, // 23: grpc.Service.GetFollowers:output_type -> grpc.GetFollowersResponse
-	15, // 24: grpc.Service.GetFollowings:output_type -> grpc.GetFollowingsResponse
-	17, // 25: grpc.Service.CreatePost:output_type -> grpc.CreatePostResponse
-	19, // 26: grpc.Service.GetPosts:output_type -> grpc.GetPostsResponse
-	21, // 27: grpc.Service.GetNewsfeed:output_type -> grpc.GetNewsfeedResponse
-	19, // [19:28] is the sub-list for method output_type
-	10, // [10:19] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	1,  // 0: grpc.UserData.counts:type_name -> grpc.UserCountsData
+	0,  // 1: grpc.FollowData.follower:type_name -> grpc.UserData
+	0,  // 2: grpc.FollowData.following:type_name -> grpc.UserData
+	0,  // 3: grpc.SignupResponse.user:type_name -> grpc.UserData
+	0,  // 4: grpc.LoginResponse.user:type_name -> grpc.UserData
+	7,  // 5: grpc.LoginResponse.session:type_name -> grpc.SessionData
+	0,  // 6: grpc.RefreshSessionResponse.user:type_name -> grpc.UserData
+	7,  // 7: grpc.RefreshSessionResponse.session:type_name -> grpc.SessionData
+	0,  // 8: grpc.VerifyEmailResponse.user:type_name -> grpc.UserData
+	0,  // 9: grpc.SetPrivateResponse.user:type_name -> grpc.UserData
+	0,  // 10: grpc.GetProfileResponse.user:type_name -> grpc.UserData
+	28, // 11: grpc.FollowResponse.pair:type_name -> grpc.UserUserData
+	0,  // 12: grpc.FollowResponse.following:type_name -> grpc.UserData
+	2,  // 13: grpc.GetFollowersResponse.followers:type_name -> grpc.FollowData
+	2,  // 14: grpc.GetFollowingsResponse.followings:type_name -> grpc.FollowData
+	0,  // 15: grpc.FollowRequestData.requester:type_name -> grpc.UserData
+	37, // 16: grpc.GetFollowRequestsResponse.requests:type_name -> grpc.FollowRequestData
+	2,  // 17: grpc.ApproveFollowRequestResponse.follow:type_name -> grpc.FollowData
+	0,  // 18: grpc.AddCloseFriendResponse.friend:type_name -> grpc.UserData
+	0,  // 19: grpc.GetCloseFriendsResponse.friends:type_name -> grpc.UserData
+	60, // 20: grpc.GetRelationshipsResponse.relationships:type_name -> grpc.RelationshipData
+	0,  // 21: grpc.SuggestionData.user:type_name -> grpc.UserData
+	63, // 22: grpc.GetSuggestionsResponse.suggestions:type_name -> grpc.SuggestionData
+	66, // 23: grpc.UploadMediaResponse.media:type_name -> grpc.MediaData
+	66, // 24: grpc.PostData.media:type_name -> grpc.MediaData
+	71, // 25: grpc.PostData.reactions:type_name -> grpc.ReactionsData
+	70, // 26: grpc.ReactionsData.counts:type_name -> grpc.ReactionCountData
+	69, // 27: grpc.CreatePostResponse.post:type_name -> grpc.PostData
+	69, // 28: grpc.UpdatePostResponse.post:type_name -> grpc.PostData
+	76, // 29: grpc.ListPostRevisionsResponse.revisions:type_name -> grpc.PostRevisionData
+	69, // 30: grpc.GetPostsResponse.posts:type_name -> grpc.PostData
+	69, // 31: grpc.GetNewsfeedResponse.posts:type_name -> grpc.PostData
+	71, // 32: grpc.ReactPostResponse.reactions:type_name -> grpc.ReactionsData
+	87, // 33: grpc.CreateCommentResponse.comment:type_name -> grpc.CommentData
+	87, // 34: grpc.ListCommentsResponse.comments:type_name -> grpc.CommentData
+	3,  // 35: grpc.Service.Signup:input_type -> grpc.SignupRequest
+	5,  // 36: grpc.Service.Login:input_type -> grpc.LoginRequest
+	8,  // 37: grpc.Service.RefreshSession:input_type -> grpc.RefreshSessionRequest
+	10, // 38: grpc.Service.Logout:input_type -> grpc.LogoutRequest
+	12, // 39: grpc.Service.LogoutAll:input_type -> grpc.LogoutAllRequest
+	14, // 40: grpc.Service.CheckSession:input_type -> grpc.CheckSessionRequest
+	16, // 41: grpc.Service.RequestPasswordReset:input_type -> grpc.RequestPasswordResetRequest
+	18, // 42: grpc.Service.ConfirmPasswordReset:input_type -> grpc.ConfirmPasswordResetRequest
+	20, // 43: grpc.Service.VerifyEmail:input_type -> grpc.VerifyEmailRequest
+	22, // 44: grpc.Service.ResendEmailVerification:input_type -> grpc.ResendEmailVerificationRequest
+	24, // 45: grpc.Service.SetPrivate:input_type -> grpc.SetPrivateRequest
+	26, // 46: grpc.Service.GetProfile:input_type -> grpc.GetProfileRequest
+	29, // 47: grpc.Service.Follow:input_type -> grpc.FollowRequest
+	31, // 48: grpc.Service.Unfollow:input_type -> grpc.UnfollowRequest
+	33, // 49: grpc.Service.GetFollowers:input_type -> grpc.GetFollowersRequest
+	35, // 50: grpc.Service.GetFollowings:input_type -> grpc.GetFollowingsRequest
+	38, // 51: grpc.Service.GetFollowRequests:input_type -> grpc.GetFollowRequestsRequest
+	40, // 52: grpc.Service.ApproveFollowRequest:input_type -> grpc.ApproveFollowRequestRequest
+	42, // 53: grpc.Service.RejectFollowRequest:input_type -> grpc.RejectFollowRequestRequest
+	44, // 54: grpc.Service.CancelFollowRequest:input_type -> grpc.CancelFollowRequestRequest
+	46, // 55: grpc.Service.AddCloseFriend:input_type -> grpc.AddCloseFriendRequest
+	48, // 56: grpc.Service.RemoveCloseFriend:input_type -> grpc.RemoveCloseFriendRequest
+	50, // 57: grpc.Service.GetCloseFriends:input_type -> grpc.GetCloseFriendsRequest
+	52, // 58: grpc.Service.Block:input_type -> grpc.BlockRequest
+	54, // 59: grpc.Service.Unblock:input_type -> grpc.UnblockRequest
+	56, // 60: grpc.Service.Mute:input_type -> grpc.MuteRequest
+	58, // 61: grpc.Service.Unmute:input_type -> grpc.UnmuteRequest
+	61, // 62: grpc.Service.GetRelationships:input_type -> grpc.GetRelationshipsRequest
+	64, // 63: grpc.Service.GetSuggestions:input_type -> grpc.GetSuggestionsRequest
+	67, // 64: grpc.Service.UploadMedia:input_type -> grpc.UploadMediaRequest
+	72, // 65: grpc.Service.CreatePost:input_type -> grpc.CreatePostRequest
+	74, // 66: grpc.Service.UpdatePost:input_type -> grpc.UpdatePostRequest
+	77, // 67: grpc.Service.ListPostRevisions:input_type -> grpc.ListPostRevisionsRequest
+	79, // 68: grpc.Service.DeletePost:input_type -> grpc.DeletePostRequest
+	81, // 69: grpc.Service.GetPosts:input_type -> grpc.GetPostsRequest
+	83, // 70: grpc.Service.GetNewsfeed:input_type -> grpc.GetNewsfeedRequest
+	85, // 71: grpc.Service.ReactPost:input_type -> grpc.ReactPostRequest
+	88, // 72: grpc.Service.CreateComment:input_type -> grpc.CreateCommentRequest
+	90, // 73: grpc.Service.ListComments:input_type -> grpc.ListCommentsRequest
+	92, // 74: grpc.Service.DeleteComment:input_type -> grpc.DeleteCommentRequest
+	4,  // 75: grpc.Service.Signup:output_type -> grpc.SignupResponse
+	6,  // 76: grpc.Service.Login:output_type -> grpc.LoginResponse
+	9,  // 77: grpc.Service.RefreshSession:output_type -> grpc.RefreshSessionResponse
+	11, // 78: grpc.Service.Logout:output_type -> grpc.LogoutResponse
+	13, // 79: grpc.Service.LogoutAll:output_type -> grpc.LogoutAllResponse
+	15, // 80: grpc.Service.CheckSession:output_type -> grpc.CheckSessionResponse
+	17, // 81: grpc.Service.RequestPasswordReset:output_type -> grpc.RequestPasswordResetResponse
+	19, // 82: grpc.Service.ConfirmPasswordReset:output_type -> grpc.ConfirmPasswordResetResponse
+	21, // 83: grpc.Service.VerifyEmail:output_type -> grpc.VerifyEmailResponse
+	23, // 84: grpc.Service.ResendEmailVerification:output_type -> grpc.ResendEmailVerificationResponse
+	25, // 85: grpc.Service.SetPrivate:output_type -> grpc.SetPrivateResponse
+	27, // 86: grpc.Service.GetProfile:output_type -> grpc.GetProfileResponse
+	30, // 87: grpc.Service.Follow:output_type -> grpc.FollowResponse
+	32, // 88: grpc.Service.Unfollow:output_type -> grpc.UnfollowResponse
+	34, // 89: grpc.Service.GetFollowers:output_type -> grpc.GetFollowersResponse
+	36, // 90: grpc.Service.GetFollowings:output_type -> grpc.GetFollowingsResponse
+	39, // 91: grpc.Service.GetFollowRequests:output_type -> grpc.GetFollowRequestsResponse
+	41, // 92: grpc.Service.ApproveFollowRequest:output_type -> grpc.ApproveFollowRequestResponse
+	43, // 93: grpc.Service.RejectFollowRequest:output_type -> grpc.RejectFollowRequestResponse
+	45, // 94: grpc.Service.CancelFollowRequest:output_type -> grpc.CancelFollowRequestResponse
+	47, // 95: grpc.Service.AddCloseFriend:output_type -> grpc.AddCloseFriendResponse
+	49, // 96: grpc.Service.RemoveCloseFriend:output_type -> grpc.RemoveCloseFriendResponse
+	51, // 97: grpc.Service.GetCloseFriends:output_type -> grpc.GetCloseFriendsResponse
+	53, // 98: grpc.Service.Block:output_type -> grpc.BlockResponse
+	55, // 99: grpc.Service.Unblock:output_type -> grpc.UnblockResponse
+	57, // 100: grpc.Service.Mute:output_type -> grpc.MuteResponse
+	59, // 101: grpc.Service.Unmute:output_type -> grpc.UnmuteResponse
+	62, // 102: grpc.Service.GetRelationships:output_type -> grpc.GetRelationshipsResponse
+	65, // 103: grpc.Service.GetSuggestions:output_type -> grpc.GetSuggestionsResponse
+	68, // 104: grpc.Service.UploadMedia:output_type -> grpc.UploadMediaResponse
+	73, // 105: grpc.Service.CreatePost:output_type -> grpc.CreatePostResponse
+	75, // 106: grpc.Service.UpdatePost:output_type -> grpc.UpdatePostResponse
+	78, // 107: grpc.Service.ListPostRevisions:output_type -> grpc.ListPostRevisionsResponse
+	80, // 108: grpc.Service.DeletePost:output_type -> grpc.DeletePostResponse
+	82, // 109: grpc.Service.GetPosts:output_type -> grpc.GetPostsResponse
+	84, // 110: grpc.Service.GetNewsfeed:output_type -> grpc.GetNewsfeedResponse
+	86, // 111: grpc.Service.ReactPost:output_type -> grpc.ReactPostResponse
+	89, // 112: grpc.Service.CreateComment:output_type -> grpc.CreateCommentResponse
+	91, // 113: grpc.Service.ListComments:output_type -> grpc.ListCommentsResponse
+	93, // 114: grpc.Service.DeleteComment:output_type -> grpc.DeleteCommentResponse
+	75, // [75:115] is the sub-list for method output_type
+	35, // [35:75] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_internal_handler_proto_grpc_service_proto_init() }
@@ -1275,7 +5771,7 @@ func file_internal_handler_proto_grpc_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_handler_proto_grpc_service_proto_rawDesc), len(file_internal_handler_proto_grpc_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   94,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -38,6 +38,587 @@ func (_m *MockServiceClient) EXPECT() *MockServiceClient_Expecter {
 	return &MockServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// AddCloseFriend provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) AddCloseFriend(ctx context.Context, in *AddCloseFriendRequest, opts ...grpc.CallOption) (*AddCloseFriendResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCloseFriend")
+	}
+
+	var r0 *AddCloseFriendResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *AddCloseFriendRequest, ...grpc.CallOption) (*AddCloseFriendResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *AddCloseFriendRequest, ...grpc.CallOption) *AddCloseFriendResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AddCloseFriendResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *AddCloseFriendRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_AddCloseFriend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCloseFriend'
+type MockServiceClient_AddCloseFriend_Call struct {
+	*mock.Call
+}
+
+// AddCloseFriend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *AddCloseFriendRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) AddCloseFriend(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_AddCloseFriend_Call {
+	return &MockServiceClient_AddCloseFriend_Call{Call: _e.mock.On("AddCloseFriend",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_AddCloseFriend_Call) Run(run func(ctx context.Context, in *AddCloseFriendRequest, opts ...grpc.CallOption)) *MockServiceClient_AddCloseFriend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *AddCloseFriendRequest
+		if args[1] != nil {
+			arg1 = args[1].(*AddCloseFriendRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_AddCloseFriend_Call) Return(addCloseFriendResponse *AddCloseFriendResponse, err error) *MockServiceClient_AddCloseFriend_Call {
+	_c.Call.Return(addCloseFriendResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_AddCloseFriend_Call) RunAndReturn(run func(ctx context.Context, in *AddCloseFriendRequest, opts ...grpc.CallOption) (*AddCloseFriendResponse, error)) *MockServiceClient_AddCloseFriend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApproveFollowRequest provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ApproveFollowRequestResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveFollowRequest")
+	}
+
+	var r0 *ApproveFollowRequestResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ApproveFollowRequestRequest, ...grpc.CallOption) (*ApproveFollowRequestResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ApproveFollowRequestRequest, ...grpc.CallOption) *ApproveFollowRequestResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ApproveFollowRequestResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ApproveFollowRequestRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_ApproveFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveFollowRequest'
+type MockServiceClient_ApproveFollowRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *ApproveFollowRequestRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) ApproveFollowRequest(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_ApproveFollowRequest_Call {
+	return &MockServiceClient_ApproveFollowRequest_Call{Call: _e.mock.On("ApproveFollowRequest",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_ApproveFollowRequest_Call) Run(run func(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption)) *MockServiceClient_ApproveFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *ApproveFollowRequestRequest
+		if args[1] != nil {
+			arg1 = args[1].(*ApproveFollowRequestRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_ApproveFollowRequest_Call) Return(approveFollowRequestResponse *ApproveFollowRequestResponse, err error) *MockServiceClient_ApproveFollowRequest_Call {
+	_c.Call.Return(approveFollowRequestResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_ApproveFollowRequest_Call) RunAndReturn(run func(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ApproveFollowRequestResponse, error)) *MockServiceClient_ApproveFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Block provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 *BlockResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *BlockRequest, ...grpc.CallOption) (*BlockResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *BlockRequest, ...grpc.CallOption) *BlockResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*BlockResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *BlockRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_Block_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Block'
+type MockServiceClient_Block_Call struct {
+	*mock.Call
+}
+
+// Block is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *BlockRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) Block(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Block_Call {
+	return &MockServiceClient_Block_Call{Call: _e.mock.On("Block",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_Block_Call) Run(run func(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption)) *MockServiceClient_Block_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *BlockRequest
+		if args[1] != nil {
+			arg1 = args[1].(*BlockRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_Block_Call) Return(blockResponse *BlockResponse, err error) *MockServiceClient_Block_Call {
+	_c.Call.Return(blockResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_Block_Call) RunAndReturn(run func(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)) *MockServiceClient_Block_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelFollowRequest provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) CancelFollowRequest(ctx context.Context, in *CancelFollowRequestRequest, opts ...grpc.CallOption) (*CancelFollowRequestResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelFollowRequest")
+	}
+
+	var r0 *CancelFollowRequestResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CancelFollowRequestRequest, ...grpc.CallOption) (*CancelFollowRequestResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CancelFollowRequestRequest, ...grpc.CallOption) *CancelFollowRequestResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CancelFollowRequestResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *CancelFollowRequestRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_CancelFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelFollowRequest'
+type MockServiceClient_CancelFollowRequest_Call struct {
+	*mock.Call
+}
+
+// CancelFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *CancelFollowRequestRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) CancelFollowRequest(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_CancelFollowRequest_Call {
+	return &MockServiceClient_CancelFollowRequest_Call{Call: _e.mock.On("CancelFollowRequest",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_CancelFollowRequest_Call) Run(run func(ctx context.Context, in *CancelFollowRequestRequest, opts ...grpc.CallOption)) *MockServiceClient_CancelFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *CancelFollowRequestRequest
+		if args[1] != nil {
+			arg1 = args[1].(*CancelFollowRequestRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_CancelFollowRequest_Call) Return(cancelFollowRequestResponse *CancelFollowRequestResponse, err error) *MockServiceClient_CancelFollowRequest_Call {
+	_c.Call.Return(cancelFollowRequestResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_CancelFollowRequest_Call) RunAndReturn(run func(ctx context.Context, in *CancelFollowRequestRequest, opts ...grpc.CallOption) (*CancelFollowRequestResponse, error)) *MockServiceClient_CancelFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckSession provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) CheckSession(ctx context.Context, in *CheckSessionRequest, opts ...grpc.CallOption) (*CheckSessionResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckSession")
+	}
+
+	var r0 *CheckSessionResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CheckSessionRequest, ...grpc.CallOption) (*CheckSessionResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CheckSessionRequest, ...grpc.CallOption) *CheckSessionResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CheckSessionResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *CheckSessionRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_CheckSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckSession'
+type MockServiceClient_CheckSession_Call struct {
+	*mock.Call
+}
+
+// CheckSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *CheckSessionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) CheckSession(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_CheckSession_Call {
+	return &MockServiceClient_CheckSession_Call{Call: _e.mock.On("CheckSession",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_CheckSession_Call) Run(run func(ctx context.Context, in *CheckSessionRequest, opts ...grpc.CallOption)) *MockServiceClient_CheckSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *CheckSessionRequest
+		if args[1] != nil {
+			arg1 = args[1].(*CheckSessionRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_CheckSession_Call) Return(checkSessionResponse *CheckSessionResponse, err error) *MockServiceClient_CheckSession_Call {
+	_c.Call.Return(checkSessionResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_CheckSession_Call) RunAndReturn(run func(ctx context.Context, in *CheckSessionRequest, opts ...grpc.CallOption) (*CheckSessionResponse, error)) *MockServiceClient_CheckSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmPasswordReset provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*ConfirmPasswordResetResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmPasswordReset")
+	}
+
+	var r0 *ConfirmPasswordResetResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ConfirmPasswordResetRequest, ...grpc.CallOption) (*ConfirmPasswordResetResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ConfirmPasswordResetRequest, ...grpc.CallOption) *ConfirmPasswordResetResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ConfirmPasswordResetResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ConfirmPasswordResetRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_ConfirmPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmPasswordReset'
+type MockServiceClient_ConfirmPasswordReset_Call struct {
+	*mock.Call
+}
+
+// ConfirmPasswordReset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *ConfirmPasswordResetRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) ConfirmPasswordReset(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_ConfirmPasswordReset_Call {
+	return &MockServiceClient_ConfirmPasswordReset_Call{Call: _e.mock.On("ConfirmPasswordReset",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_ConfirmPasswordReset_Call) Run(run func(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption)) *MockServiceClient_ConfirmPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *ConfirmPasswordResetRequest
+		if args[1] != nil {
+			arg1 = args[1].(*ConfirmPasswordResetRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_ConfirmPasswordReset_Call) Return(confirmPasswordResetResponse *ConfirmPasswordResetResponse, err error) *MockServiceClient_ConfirmPasswordReset_Call {
+	_c.Call.Return(confirmPasswordResetResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_ConfirmPasswordReset_Call) RunAndReturn(run func(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*ConfirmPasswordResetResponse, error)) *MockServiceClient_ConfirmPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateComment provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 *CreateCommentResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CreateCommentRequest, ...grpc.CallOption) (*CreateCommentResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CreateCommentRequest, ...grpc.CallOption) *CreateCommentResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CreateCommentResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *CreateCommentRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type MockServiceClient_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *CreateCommentRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) CreateComment(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_CreateComment_Call {
+	return &MockServiceClient_CreateComment_Call{Call: _e.mock.On("CreateComment",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_CreateComment_Call) Run(run func(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption)) *MockServiceClient_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *CreateCommentRequest
+		if args[1] != nil {
+			arg1 = args[1].(*CreateCommentRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_CreateComment_Call) Return(createCommentResponse *CreateCommentResponse, err error) *MockServiceClient_CreateComment_Call {
+	_c.Call.Return(createCommentResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_CreateComment_Call) RunAndReturn(run func(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)) *MockServiceClient_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreatePost provides a mock function for the type MockServiceClient
 func (_mock *MockServiceClient) CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error) {
 	var tmpRet mock.Arguments
@@ -49,22 +630,2014 @@ func (_mock *MockServiceClient) CreatePost(ctx context.Context, in *CreatePostRe
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePost")
+		panic("no return value specified for CreatePost")
+	}
+
+	var r0 *CreatePostResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CreatePostRequest, ...grpc.CallOption) (*CreatePostResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *CreatePostRequest, ...grpc.CallOption) *CreatePostResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CreatePostResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *CreatePostRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_CreatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePost'
+type MockServiceClient_CreatePost_Call struct {
+	*mock.Call
+}
+
+// CreatePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *CreatePostRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) CreatePost(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_CreatePost_Call {
+	return &MockServiceClient_CreatePost_Call{Call: _e.mock.On("CreatePost",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_CreatePost_Call) Run(run func(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption)) *MockServiceClient_CreatePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *CreatePostRequest
+		if args[1] != nil {
+			arg1 = args[1].(*CreatePostRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_CreatePost_Call) Return(createPostResponse *CreatePostResponse, err error) *MockServiceClient_CreatePost_Call {
+	_c.Call.Return(createPostResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_CreatePost_Call) RunAndReturn(run func(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error)) *MockServiceClient_CreatePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 *DeleteCommentResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *DeleteCommentRequest, ...grpc.CallOption) (*DeleteCommentResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *DeleteCommentRequest, ...grpc.CallOption) *DeleteCommentResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DeleteCommentResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *DeleteCommentRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type MockServiceClient_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *DeleteCommentRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) DeleteComment(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_DeleteComment_Call {
+	return &MockServiceClient_DeleteComment_Call{Call: _e.mock.On("DeleteComment",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_DeleteComment_Call) Run(run func(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption)) *MockServiceClient_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *DeleteCommentRequest
+		if args[1] != nil {
+			arg1 = args[1].(*DeleteCommentRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_DeleteComment_Call) Return(deleteCommentResponse *DeleteCommentResponse, err error) *MockServiceClient_DeleteComment_Call {
+	_c.Call.Return(deleteCommentResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_DeleteComment_Call) RunAndReturn(run func(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)) *MockServiceClient_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePost provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePost")
+	}
+
+	var r0 *DeletePostResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *DeletePostRequest, ...grpc.CallOption) (*DeletePostResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *DeletePostRequest, ...grpc.CallOption) *DeletePostResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DeletePostResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *DeletePostRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_DeletePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePost'
+type MockServiceClient_DeletePost_Call struct {
+	*mock.Call
+}
+
+// DeletePost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *DeletePostRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) DeletePost(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_DeletePost_Call {
+	return &MockServiceClient_DeletePost_Call{Call: _e.mock.On("DeletePost",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_DeletePost_Call) Run(run func(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption)) *MockServiceClient_DeletePost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *DeletePostRequest
+		if args[1] != nil {
+			arg1 = args[1].(*DeletePostRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_DeletePost_Call) Return(deletePostResponse *DeletePostResponse, err error) *MockServiceClient_DeletePost_Call {
+	_c.Call.Return(deletePostResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_DeletePost_Call) RunAndReturn(run func(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)) *MockServiceClient_DeletePost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Follow provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 *FollowResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *FollowRequest, ...grpc.CallOption) (*FollowResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *FollowRequest, ...grpc.CallOption) *FollowResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FollowResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *FollowRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockServiceClient_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *FollowRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) Follow(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Follow_Call {
+	return &MockServiceClient_Follow_Call{Call: _e.mock.On("Follow",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_Follow_Call) Run(run func(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption)) *MockServiceClient_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *FollowRequest
+		if args[1] != nil {
+			arg1 = args[1].(*FollowRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_Follow_Call) Return(followResponse *FollowResponse, err error) *MockServiceClient_Follow_Call {
+	_c.Call.Return(followResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_Follow_Call) RunAndReturn(run func(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)) *MockServiceClient_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCloseFriends provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) GetCloseFriends(ctx context.Context, in *GetCloseFriendsRequest, opts ...grpc.CallOption) (*GetCloseFriendsResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCloseFriends")
+	}
+
+	var r0 *GetCloseFriendsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetCloseFriendsRequest, ...grpc.CallOption) (*GetCloseFriendsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetCloseFriendsRequest, ...grpc.CallOption) *GetCloseFriendsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetCloseFriendsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetCloseFriendsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_GetCloseFriends_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCloseFriends'
+type MockServiceClient_GetCloseFriends_Call struct {
+	*mock.Call
+}
+
+// GetCloseFriends is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetCloseFriendsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) GetCloseFriends(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetCloseFriends_Call {
+	return &MockServiceClient_GetCloseFriends_Call{Call: _e.mock.On("GetCloseFriends",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_GetCloseFriends_Call) Run(run func(ctx context.Context, in *GetCloseFriendsRequest, opts ...grpc.CallOption)) *MockServiceClient_GetCloseFriends_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetCloseFriendsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetCloseFriendsRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_GetCloseFriends_Call) Return(getCloseFriendsResponse *GetCloseFriendsResponse, err error) *MockServiceClient_GetCloseFriends_Call {
+	_c.Call.Return(getCloseFriendsResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_GetCloseFriends_Call) RunAndReturn(run func(ctx context.Context, in *GetCloseFriendsRequest, opts ...grpc.CallOption) (*GetCloseFriendsResponse, error)) *MockServiceClient_GetCloseFriends_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowRequests provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) GetFollowRequests(ctx context.Context, in *GetFollowRequestsRequest, opts ...grpc.CallOption) (*GetFollowRequestsResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowRequests")
+	}
+
+	var r0 *GetFollowRequestsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetFollowRequestsRequest, ...grpc.CallOption) (*GetFollowRequestsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetFollowRequestsRequest, ...grpc.CallOption) *GetFollowRequestsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetFollowRequestsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetFollowRequestsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_GetFollowRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowRequests'
+type MockServiceClient_GetFollowRequests_Call struct {
+	*mock.Call
+}
+
+// GetFollowRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetFollowRequestsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) GetFollowRequests(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetFollowRequests_Call {
+	return &MockServiceClient_GetFollowRequests_Call{Call: _e.mock.On("GetFollowRequests",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_GetFollowRequests_Call) Run(run func(ctx context.Context, in *GetFollowRequestsRequest, opts ...grpc.CallOption)) *MockServiceClient_GetFollowRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetFollowRequestsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetFollowRequestsRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_GetFollowRequests_Call) Return(getFollowRequestsResponse *GetFollowRequestsResponse, err error) *MockServiceClient_GetFollowRequests_Call {
+	_c.Call.Return(getFollowRequestsResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_GetFollowRequests_Call) RunAndReturn(run func(ctx context.Context, in *GetFollowRequestsRequest, opts ...grpc.CallOption) (*GetFollowRequestsResponse, error)) *MockServiceClient_GetFollowRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) GetFollowers(ctx context.Context, in *GetFollowersRequest, opts ...grpc.CallOption) (*GetFollowersResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 *GetFollowersResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetFollowersRequest, ...grpc.CallOption) (*GetFollowersResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetFollowersRequest, ...grpc.CallOption) *GetFollowersResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetFollowersResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetFollowersRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type MockServiceClient_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetFollowersRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) GetFollowers(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetFollowers_Call {
+	return &MockServiceClient_GetFollowers_Call{Call: _e.mock.On("GetFollowers",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_GetFollowers_Call) Run(run func(ctx context.Context, in *GetFollowersRequest, opts ...grpc.CallOption)) *MockServiceClient_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetFollowersRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetFollowersRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_GetFollowers_Call) Return(getFollowersResponse *GetFollowersResponse, err error) *MockServiceClient_GetFollowers_Call {
+	_c.Call.Return(getFollowersResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_GetFollowers_Call) RunAndReturn(run func(ctx context.Context, in *GetFollowersRequest, opts ...grpc.CallOption) (*GetFollowersResponse, error)) *MockServiceClient_GetFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowings provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) GetFollowings(ctx context.Context, in *GetFollowingsRequest, opts ...grpc.CallOption) (*GetFollowingsResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowings")
+	}
+
+	var r0 *GetFollowingsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetFollowingsRequest, ...grpc.CallOption) (*GetFollowingsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetFollowingsRequest, ...grpc.CallOption) *GetFollowingsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetFollowingsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetFollowingsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_GetFollowings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowings'
+type MockServiceClient_GetFollowings_Call struct {
+	*mock.Call
+}
+
+// GetFollowings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetFollowingsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) GetFollowings(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetFollowings_Call {
+	return &MockServiceClient_GetFollowings_Call{Call: _e.mock.On("GetFollowings",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_GetFollowings_Call) Run(run func(ctx context.Context, in *GetFollowingsRequest, opts ...grpc.CallOption)) *MockServiceClient_GetFollowings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetFollowingsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetFollowingsRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_GetFollowings_Call) Return(getFollowingsResponse *GetFollowingsResponse, err error) *MockServiceClient_GetFollowings_Call {
+	_c.Call.Return(getFollowingsResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_GetFollowings_Call) RunAndReturn(run func(ctx context.Context, in *GetFollowingsRequest, opts ...grpc.CallOption) (*GetFollowingsResponse, error)) *MockServiceClient_GetFollowings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNewsfeed provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) GetNewsfeed(ctx context.Context, in *GetNewsfeedRequest, opts ...grpc.CallOption) (*GetNewsfeedResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNewsfeed")
+	}
+
+	var r0 *GetNewsfeedResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetNewsfeedRequest, ...grpc.CallOption) (*GetNewsfeedResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetNewsfeedRequest, ...grpc.CallOption) *GetNewsfeedResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetNewsfeedResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetNewsfeedRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_GetNewsfeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNewsfeed'
+type MockServiceClient_GetNewsfeed_Call struct {
+	*mock.Call
+}
+
+// GetNewsfeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetNewsfeedRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) GetNewsfeed(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetNewsfeed_Call {
+	return &MockServiceClient_GetNewsfeed_Call{Call: _e.mock.On("GetNewsfeed",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_GetNewsfeed_Call) Run(run func(ctx context.Context, in *GetNewsfeedRequest, opts ...grpc.CallOption)) *MockServiceClient_GetNewsfeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetNewsfeedRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetNewsfeedRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_GetNewsfeed_Call) Return(getNewsfeedResponse *GetNewsfeedResponse, err error) *MockServiceClient_GetNewsfeed_Call {
+	_c.Call.Return(getNewsfeedResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_GetNewsfeed_Call) RunAndReturn(run func(ctx context.Context, in *GetNewsfeedRequest, opts ...grpc.CallOption) (*GetNewsfeedResponse, error)) *MockServiceClient_GetNewsfeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPosts provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) GetPosts(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPosts")
+	}
+
+	var r0 *GetPostsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetPostsRequest, ...grpc.CallOption) (*GetPostsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetPostsRequest, ...grpc.CallOption) *GetPostsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetPostsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetPostsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_GetPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPosts'
+type MockServiceClient_GetPosts_Call struct {
+	*mock.Call
+}
+
+// GetPosts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetPostsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) GetPosts(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetPosts_Call {
+	return &MockServiceClient_GetPosts_Call{Call: _e.mock.On("GetPosts",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_GetPosts_Call) Run(run func(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption)) *MockServiceClient_GetPosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetPostsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetPostsRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_GetPosts_Call) Return(getPostsResponse *GetPostsResponse, err error) *MockServiceClient_GetPosts_Call {
+	_c.Call.Return(getPostsResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_GetPosts_Call) RunAndReturn(run func(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)) *MockServiceClient_GetPosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfile provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfile")
+	}
+
+	var r0 *GetProfileResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetProfileRequest, ...grpc.CallOption) (*GetProfileResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetProfileRequest, ...grpc.CallOption) *GetProfileResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetProfileResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetProfileRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
+type MockServiceClient_GetProfile_Call struct {
+	*mock.Call
+}
+
+// GetProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetProfileRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) GetProfile(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetProfile_Call {
+	return &MockServiceClient_GetProfile_Call{Call: _e.mock.On("GetProfile",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_GetProfile_Call) Run(run func(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption)) *MockServiceClient_GetProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetProfileRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetProfileRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_GetProfile_Call) Return(getProfileResponse *GetProfileResponse, err error) *MockServiceClient_GetProfile_Call {
+	_c.Call.Return(getProfileResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_GetProfile_Call) RunAndReturn(run func(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)) *MockServiceClient_GetProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRelationships provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRelationships")
+	}
+
+	var r0 *GetRelationshipsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetRelationshipsRequest, ...grpc.CallOption) (*GetRelationshipsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetRelationshipsRequest, ...grpc.CallOption) *GetRelationshipsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetRelationshipsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetRelationshipsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_GetRelationships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRelationships'
+type MockServiceClient_GetRelationships_Call struct {
+	*mock.Call
+}
+
+// GetRelationships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetRelationshipsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) GetRelationships(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetRelationships_Call {
+	return &MockServiceClient_GetRelationships_Call{Call: _e.mock.On("GetRelationships",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_GetRelationships_Call) Run(run func(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption)) *MockServiceClient_GetRelationships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetRelationshipsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetRelationshipsRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_GetRelationships_Call) Return(getRelationshipsResponse *GetRelationshipsResponse, err error) *MockServiceClient_GetRelationships_Call {
+	_c.Call.Return(getRelationshipsResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_GetRelationships_Call) RunAndReturn(run func(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error)) *MockServiceClient_GetRelationships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSuggestions provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) GetSuggestions(ctx context.Context, in *GetSuggestionsRequest, opts ...grpc.CallOption) (*GetSuggestionsResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSuggestions")
+	}
+
+	var r0 *GetSuggestionsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetSuggestionsRequest, ...grpc.CallOption) (*GetSuggestionsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetSuggestionsRequest, ...grpc.CallOption) *GetSuggestionsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*GetSuggestionsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetSuggestionsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_GetSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSuggestions'
+type MockServiceClient_GetSuggestions_Call struct {
+	*mock.Call
+}
+
+// GetSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *GetSuggestionsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) GetSuggestions(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetSuggestions_Call {
+	return &MockServiceClient_GetSuggestions_Call{Call: _e.mock.On("GetSuggestions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_GetSuggestions_Call) Run(run func(ctx context.Context, in *GetSuggestionsRequest, opts ...grpc.CallOption)) *MockServiceClient_GetSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *GetSuggestionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*GetSuggestionsRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_GetSuggestions_Call) Return(getSuggestionsResponse *GetSuggestionsResponse, err error) *MockServiceClient_GetSuggestions_Call {
+	_c.Call.Return(getSuggestionsResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_GetSuggestions_Call) RunAndReturn(run func(ctx context.Context, in *GetSuggestionsRequest, opts ...grpc.CallOption) (*GetSuggestionsResponse, error)) *MockServiceClient_GetSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListComments provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComments")
+	}
+
+	var r0 *ListCommentsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListCommentsRequest, ...grpc.CallOption) (*ListCommentsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListCommentsRequest, ...grpc.CallOption) *ListCommentsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListCommentsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ListCommentsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type MockServiceClient_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *ListCommentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) ListComments(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_ListComments_Call {
+	return &MockServiceClient_ListComments_Call{Call: _e.mock.On("ListComments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_ListComments_Call) Run(run func(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption)) *MockServiceClient_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *ListCommentsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*ListCommentsRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_ListComments_Call) Return(listCommentsResponse *ListCommentsResponse, err error) *MockServiceClient_ListComments_Call {
+	_c.Call.Return(listCommentsResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_ListComments_Call) RunAndReturn(run func(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)) *MockServiceClient_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPostRevisions provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) ListPostRevisions(ctx context.Context, in *ListPostRevisionsRequest, opts ...grpc.CallOption) (*ListPostRevisionsResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPostRevisions")
+	}
+
+	var r0 *ListPostRevisionsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListPostRevisionsRequest, ...grpc.CallOption) (*ListPostRevisionsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ListPostRevisionsRequest, ...grpc.CallOption) *ListPostRevisionsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListPostRevisionsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ListPostRevisionsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_ListPostRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPostRevisions'
+type MockServiceClient_ListPostRevisions_Call struct {
+	*mock.Call
+}
+
+// ListPostRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *ListPostRevisionsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) ListPostRevisions(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_ListPostRevisions_Call {
+	return &MockServiceClient_ListPostRevisions_Call{Call: _e.mock.On("ListPostRevisions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_ListPostRevisions_Call) Run(run func(ctx context.Context, in *ListPostRevisionsRequest, opts ...grpc.CallOption)) *MockServiceClient_ListPostRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *ListPostRevisionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*ListPostRevisionsRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_ListPostRevisions_Call) Return(listPostRevisionsResponse *ListPostRevisionsResponse, err error) *MockServiceClient_ListPostRevisions_Call {
+	_c.Call.Return(listPostRevisionsResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_ListPostRevisions_Call) RunAndReturn(run func(ctx context.Context, in *ListPostRevisionsRequest, opts ...grpc.CallOption) (*ListPostRevisionsResponse, error)) *MockServiceClient_ListPostRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *LoginResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *LoginRequest, ...grpc.CallOption) (*LoginResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *LoginRequest, ...grpc.CallOption) *LoginResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*LoginResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *LoginRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockServiceClient_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *LoginRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) Login(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Login_Call {
+	return &MockServiceClient_Login_Call{Call: _e.mock.On("Login",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_Login_Call) Run(run func(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption)) *MockServiceClient_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *LoginRequest
+		if args[1] != nil {
+			arg1 = args[1].(*LoginRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_Login_Call) Return(loginResponse *LoginResponse, err error) *MockServiceClient_Login_Call {
+	_c.Call.Return(loginResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_Login_Call) RunAndReturn(run func(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)) *MockServiceClient_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 *LogoutResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *LogoutRequest, ...grpc.CallOption) (*LogoutResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *LogoutRequest, ...grpc.CallOption) *LogoutResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*LogoutResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *LogoutRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockServiceClient_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *LogoutRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) Logout(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Logout_Call {
+	return &MockServiceClient_Logout_Call{Call: _e.mock.On("Logout",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_Logout_Call) Run(run func(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption)) *MockServiceClient_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *LogoutRequest
+		if args[1] != nil {
+			arg1 = args[1].(*LogoutRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_Logout_Call) Return(logoutResponse *LogoutResponse, err error) *MockServiceClient_Logout_Call {
+	_c.Call.Return(logoutResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_Logout_Call) RunAndReturn(run func(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)) *MockServiceClient_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogoutAll provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...grpc.CallOption) (*LogoutAllResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogoutAll")
+	}
+
+	var r0 *LogoutAllResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *LogoutAllRequest, ...grpc.CallOption) (*LogoutAllResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *LogoutAllRequest, ...grpc.CallOption) *LogoutAllResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*LogoutAllResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *LogoutAllRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_LogoutAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogoutAll'
+type MockServiceClient_LogoutAll_Call struct {
+	*mock.Call
+}
+
+// LogoutAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *LogoutAllRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) LogoutAll(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_LogoutAll_Call {
+	return &MockServiceClient_LogoutAll_Call{Call: _e.mock.On("LogoutAll",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_LogoutAll_Call) Run(run func(ctx context.Context, in *LogoutAllRequest, opts ...grpc.CallOption)) *MockServiceClient_LogoutAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *LogoutAllRequest
+		if args[1] != nil {
+			arg1 = args[1].(*LogoutAllRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_LogoutAll_Call) Return(logoutAllResponse *LogoutAllResponse, err error) *MockServiceClient_LogoutAll_Call {
+	_c.Call.Return(logoutAllResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_LogoutAll_Call) RunAndReturn(run func(ctx context.Context, in *LogoutAllRequest, opts ...grpc.CallOption) (*LogoutAllResponse, error)) *MockServiceClient_LogoutAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mute provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mute")
+	}
+
+	var r0 *MuteResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *MuteRequest, ...grpc.CallOption) (*MuteResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *MuteRequest, ...grpc.CallOption) *MuteResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MuteResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *MuteRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_Mute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mute'
+type MockServiceClient_Mute_Call struct {
+	*mock.Call
+}
+
+// Mute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *MuteRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) Mute(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Mute_Call {
+	return &MockServiceClient_Mute_Call{Call: _e.mock.On("Mute",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_Mute_Call) Run(run func(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption)) *MockServiceClient_Mute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *MuteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*MuteRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_Mute_Call) Return(muteResponse *MuteResponse, err error) *MockServiceClient_Mute_Call {
+	_c.Call.Return(muteResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_Mute_Call) RunAndReturn(run func(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error)) *MockServiceClient_Mute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReactPost provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) ReactPost(ctx context.Context, in *ReactPostRequest, opts ...grpc.CallOption) (*ReactPostResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReactPost")
+	}
+
+	var r0 *ReactPostResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ReactPostRequest, ...grpc.CallOption) (*ReactPostResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ReactPostRequest, ...grpc.CallOption) *ReactPostResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ReactPostResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ReactPostRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_ReactPost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReactPost'
+type MockServiceClient_ReactPost_Call struct {
+	*mock.Call
+}
+
+// ReactPost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *ReactPostRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) ReactPost(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_ReactPost_Call {
+	return &MockServiceClient_ReactPost_Call{Call: _e.mock.On("ReactPost",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_ReactPost_Call) Run(run func(ctx context.Context, in *ReactPostRequest, opts ...grpc.CallOption)) *MockServiceClient_ReactPost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *ReactPostRequest
+		if args[1] != nil {
+			arg1 = args[1].(*ReactPostRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_ReactPost_Call) Return(reactPostResponse *ReactPostResponse, err error) *MockServiceClient_ReactPost_Call {
+	_c.Call.Return(reactPostResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_ReactPost_Call) RunAndReturn(run func(ctx context.Context, in *ReactPostRequest, opts ...grpc.CallOption) (*ReactPostResponse, error)) *MockServiceClient_ReactPost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshSession provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshSession")
+	}
+
+	var r0 *RefreshSessionResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RefreshSessionRequest, ...grpc.CallOption) (*RefreshSessionResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RefreshSessionRequest, ...grpc.CallOption) *RefreshSessionResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RefreshSessionResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *RefreshSessionRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_RefreshSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshSession'
+type MockServiceClient_RefreshSession_Call struct {
+	*mock.Call
+}
+
+// RefreshSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *RefreshSessionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) RefreshSession(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_RefreshSession_Call {
+	return &MockServiceClient_RefreshSession_Call{Call: _e.mock.On("RefreshSession",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_RefreshSession_Call) Run(run func(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption)) *MockServiceClient_RefreshSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *RefreshSessionRequest
+		if args[1] != nil {
+			arg1 = args[1].(*RefreshSessionRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_RefreshSession_Call) Return(refreshSessionResponse *RefreshSessionResponse, err error) *MockServiceClient_RefreshSession_Call {
+	_c.Call.Return(refreshSessionResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_RefreshSession_Call) RunAndReturn(run func(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error)) *MockServiceClient_RefreshSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectFollowRequest provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectFollowRequest")
+	}
+
+	var r0 *RejectFollowRequestResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RejectFollowRequestRequest, ...grpc.CallOption) (*RejectFollowRequestResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RejectFollowRequestRequest, ...grpc.CallOption) *RejectFollowRequestResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RejectFollowRequestResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *RejectFollowRequestRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_RejectFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectFollowRequest'
+type MockServiceClient_RejectFollowRequest_Call struct {
+	*mock.Call
+}
+
+// RejectFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *RejectFollowRequestRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) RejectFollowRequest(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_RejectFollowRequest_Call {
+	return &MockServiceClient_RejectFollowRequest_Call{Call: _e.mock.On("RejectFollowRequest",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_RejectFollowRequest_Call) Run(run func(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption)) *MockServiceClient_RejectFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *RejectFollowRequestRequest
+		if args[1] != nil {
+			arg1 = args[1].(*RejectFollowRequestRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_RejectFollowRequest_Call) Return(rejectFollowRequestResponse *RejectFollowRequestResponse, err error) *MockServiceClient_RejectFollowRequest_Call {
+	_c.Call.Return(rejectFollowRequestResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_RejectFollowRequest_Call) RunAndReturn(run func(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error)) *MockServiceClient_RejectFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCloseFriend provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) RemoveCloseFriend(ctx context.Context, in *RemoveCloseFriendRequest, opts ...grpc.CallOption) (*RemoveCloseFriendResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCloseFriend")
+	}
+
+	var r0 *RemoveCloseFriendResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RemoveCloseFriendRequest, ...grpc.CallOption) (*RemoveCloseFriendResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RemoveCloseFriendRequest, ...grpc.CallOption) *RemoveCloseFriendResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RemoveCloseFriendResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *RemoveCloseFriendRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_RemoveCloseFriend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCloseFriend'
+type MockServiceClient_RemoveCloseFriend_Call struct {
+	*mock.Call
+}
+
+// RemoveCloseFriend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *RemoveCloseFriendRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) RemoveCloseFriend(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_RemoveCloseFriend_Call {
+	return &MockServiceClient_RemoveCloseFriend_Call{Call: _e.mock.On("RemoveCloseFriend",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_RemoveCloseFriend_Call) Run(run func(ctx context.Context, in *RemoveCloseFriendRequest, opts ...grpc.CallOption)) *MockServiceClient_RemoveCloseFriend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *RemoveCloseFriendRequest
+		if args[1] != nil {
+			arg1 = args[1].(*RemoveCloseFriendRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_RemoveCloseFriend_Call) Return(removeCloseFriendResponse *RemoveCloseFriendResponse, err error) *MockServiceClient_RemoveCloseFriend_Call {
+	_c.Call.Return(removeCloseFriendResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_RemoveCloseFriend_Call) RunAndReturn(run func(ctx context.Context, in *RemoveCloseFriendRequest, opts ...grpc.CallOption) (*RemoveCloseFriendResponse, error)) *MockServiceClient_RemoveCloseFriend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestPasswordReset provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestPasswordReset")
+	}
+
+	var r0 *RequestPasswordResetResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RequestPasswordResetRequest, ...grpc.CallOption) (*RequestPasswordResetResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *RequestPasswordResetRequest, ...grpc.CallOption) *RequestPasswordResetResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RequestPasswordResetResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *RequestPasswordResetRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockServiceClient_RequestPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestPasswordReset'
+type MockServiceClient_RequestPasswordReset_Call struct {
+	*mock.Call
+}
+
+// RequestPasswordReset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *RequestPasswordResetRequest
+//   - opts ...grpc.CallOption
+func (_e *MockServiceClient_Expecter) RequestPasswordReset(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_RequestPasswordReset_Call {
+	return &MockServiceClient_RequestPasswordReset_Call{Call: _e.mock.On("RequestPasswordReset",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockServiceClient_RequestPasswordReset_Call) Run(run func(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption)) *MockServiceClient_RequestPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *RequestPasswordResetRequest
+		if args[1] != nil {
+			arg1 = args[1].(*RequestPasswordResetRequest)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockServiceClient_RequestPasswordReset_Call) Return(requestPasswordResetResponse *RequestPasswordResetResponse, err error) *MockServiceClient_RequestPasswordReset_Call {
+	_c.Call.Return(requestPasswordResetResponse, err)
+	return _c
+}
+
+func (_c *MockServiceClient_RequestPasswordReset_Call) RunAndReturn(run func(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)) *MockServiceClient_RequestPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResendEmailVerification provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) ResendEmailVerification(ctx context.Context, in *ResendEmailVerificationRequest, opts ...grpc.CallOption) (*ResendEmailVerificationResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendEmailVerification")
 	}
 
-	var r0 *CreatePostResponse
+	var r0 *ResendEmailVerificationResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *CreatePostRequest, ...grpc.CallOption) (*CreatePostResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ResendEmailVerificationRequest, ...grpc.CallOption) (*ResendEmailVerificationResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *CreatePostRequest, ...grpc.CallOption) *CreatePostResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *ResendEmailVerificationRequest, ...grpc.CallOption) *ResendEmailVerificationResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*CreatePostResponse)
+			r0 = ret.Get(0).(*ResendEmailVerificationResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *CreatePostRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *ResendEmailVerificationRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -72,29 +2645,29 @@ func (_mock *MockServiceClient) CreatePost(ctx context.Context, in *CreatePostRe
 	return r0, r1
 }
 
-// MockServiceClient_CreatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePost'
-type MockServiceClient_CreatePost_Call struct {
+// MockServiceClient_ResendEmailVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResendEmailVerification'
+type MockServiceClient_ResendEmailVerification_Call struct {
 	*mock.Call
 }
 
-// CreatePost is a helper method to define mock.On call
+// ResendEmailVerification is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *CreatePostRequest
+//   - in *ResendEmailVerificationRequest
 //   - opts ...grpc.CallOption
-func (_e *MockServiceClient_Expecter) CreatePost(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_CreatePost_Call {
-	return &MockServiceClient_CreatePost_Call{Call: _e.mock.On("CreatePost",
+func (_e *MockServiceClient_Expecter) ResendEmailVerification(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_ResendEmailVerification_Call {
+	return &MockServiceClient_ResendEmailVerification_Call{Call: _e.mock.On("ResendEmailVerification",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockServiceClient_CreatePost_Call) Run(run func(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption)) *MockServiceClient_CreatePost_Call {
+func (_c *MockServiceClient_ResendEmailVerification_Call) Run(run func(ctx context.Context, in *ResendEmailVerificationRequest, opts ...grpc.CallOption)) *MockServiceClient_ResendEmailVerification_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *CreatePostRequest
+		var arg1 *ResendEmailVerificationRequest
 		if args[1] != nil {
-			arg1 = args[1].(*CreatePostRequest)
+			arg1 = args[1].(*ResendEmailVerificationRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -111,18 +2684,18 @@ func (_c *MockServiceClient_CreatePost_Call) Run(run func(ctx context.Context, i
 	return _c
 }
 
-func (_c *MockServiceClient_CreatePost_Call) Return(createPostResponse *CreatePostResponse, err error) *MockServiceClient_CreatePost_Call {
-	_c.Call.Return(createPostResponse, err)
+func (_c *MockServiceClient_ResendEmailVerification_Call) Return(resendEmailVerificationResponse *ResendEmailVerificationResponse, err error) *MockServiceClient_ResendEmailVerification_Call {
+	_c.Call.Return(resendEmailVerificationResponse, err)
 	return _c
 }
 
-func (_c *MockServiceClient_CreatePost_Call) RunAndReturn(run func(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error)) *MockServiceClient_CreatePost_Call {
+func (_c *MockServiceClient_ResendEmailVerification_Call) RunAndReturn(run func(ctx context.Context, in *ResendEmailVerificationRequest, opts ...grpc.CallOption) (*ResendEmailVerificationResponse, error)) *MockServiceClient_ResendEmailVerification_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Follow provides a mock function for the type MockServiceClient
-func (_mock *MockServiceClient) Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+// SetPrivate provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) SetPrivate(ctx context.Context, in *SetPrivateRequest, opts ...grpc.CallOption) (*SetPrivateResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, in, opts)
@@ -132,22 +2705,22 @@ func (_mock *MockServiceClient) Follow(ctx context.Context, in *FollowRequest, o
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for Follow")
+		panic("no return value specified for SetPrivate")
 	}
 
-	var r0 *FollowResponse
+	var r0 *SetPrivateResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *FollowRequest, ...grpc.CallOption) (*FollowResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *SetPrivateRequest, ...grpc.CallOption) (*SetPrivateResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *FollowRequest, ...grpc.CallOption) *FollowResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *SetPrivateRequest, ...grpc.CallOption) *SetPrivateResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*FollowResponse)
+			r0 = ret.Get(0).(*SetPrivateResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *FollowRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *SetPrivateRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -155,29 +2728,29 @@ func (_mock *MockServiceClient) Follow(ctx context.Context, in *FollowRequest, o
 	return r0, r1
 }
 
-// MockServiceClient_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
-type MockServiceClient_Follow_Call struct {
+// MockServiceClient_SetPrivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPrivate'
+type MockServiceClient_SetPrivate_Call struct {
 	*mock.Call
 }
 
-// Follow is a helper method to define mock.On call
+// SetPrivate is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *FollowRequest
+//   - in *SetPrivateRequest
 //   - opts ...grpc.CallOption
-func (_e *MockServiceClient_Expecter) Follow(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Follow_Call {
-	return &MockServiceClient_Follow_Call{Call: _e.mock.On("Follow",
+func (_e *MockServiceClient_Expecter) SetPrivate(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_SetPrivate_Call {
+	return &MockServiceClient_SetPrivate_Call{Call: _e.mock.On("SetPrivate",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockServiceClient_Follow_Call) Run(run func(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption)) *MockServiceClient_Follow_Call {
+func (_c *MockServiceClient_SetPrivate_Call) Run(run func(ctx context.Context, in *SetPrivateRequest, opts ...grpc.CallOption)) *MockServiceClient_SetPrivate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *FollowRequest
+		var arg1 *SetPrivateRequest
 		if args[1] != nil {
-			arg1 = args[1].(*FollowRequest)
+			arg1 = args[1].(*SetPrivateRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -194,18 +2767,18 @@ func (_c *MockServiceClient_Follow_Call) Run(run func(ctx context.Context, in *F
 	return _c
 }
 
-func (_c *MockServiceClient_Follow_Call) Return(followResponse *FollowResponse, err error) *MockServiceClient_Follow_Call {
-	_c.Call.Return(followResponse, err)
+func (_c *MockServiceClient_SetPrivate_Call) Return(setPrivateResponse *SetPrivateResponse, err error) *MockServiceClient_SetPrivate_Call {
+	_c.Call.Return(setPrivateResponse, err)
 	return _c
 }
 
-func (_c *MockServiceClient_Follow_Call) RunAndReturn(run func(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)) *MockServiceClient_Follow_Call {
+func (_c *MockServiceClient_SetPrivate_Call) RunAndReturn(run func(ctx context.Context, in *SetPrivateRequest, opts ...grpc.CallOption) (*SetPrivateResponse, error)) *MockServiceClient_SetPrivate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFollowers provides a mock function for the type MockServiceClient
-func (_mock *MockServiceClient) GetFollowers(ctx context.Context, in *GetFollowersRequest, opts ...grpc.CallOption) (*GetFollowersResponse, error) {
+// Signup provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) Signup(ctx context.Context, in *SignupRequest, opts ...grpc.CallOption) (*SignupResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, in, opts)
@@ -215,22 +2788,22 @@ func (_mock *MockServiceClient) GetFollowers(ctx context.Context, in *GetFollowe
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFollowers")
+		panic("no return value specified for Signup")
 	}
 
-	var r0 *GetFollowersResponse
+	var r0 *SignupResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetFollowersRequest, ...grpc.CallOption) (*GetFollowersResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *SignupRequest, ...grpc.CallOption) (*SignupResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetFollowersRequest, ...grpc.CallOption) *GetFollowersResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *SignupRequest, ...grpc.CallOption) *SignupResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*GetFollowersResponse)
+			r0 = ret.Get(0).(*SignupResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetFollowersRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *SignupRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -238,29 +2811,29 @@ func (_mock *MockServiceClient) GetFollowers(ctx context.Context, in *GetFollowe
 	return r0, r1
 }
 
-// MockServiceClient_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
-type MockServiceClient_GetFollowers_Call struct {
+// MockServiceClient_Signup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Signup'
+type MockServiceClient_Signup_Call struct {
 	*mock.Call
 }
 
-// GetFollowers is a helper method to define mock.On call
+// Signup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *GetFollowersRequest
+//   - in *SignupRequest
 //   - opts ...grpc.CallOption
-func (_e *MockServiceClient_Expecter) GetFollowers(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetFollowers_Call {
-	return &MockServiceClient_GetFollowers_Call{Call: _e.mock.On("GetFollowers",
+func (_e *MockServiceClient_Expecter) Signup(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Signup_Call {
+	return &MockServiceClient_Signup_Call{Call: _e.mock.On("Signup",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockServiceClient_GetFollowers_Call) Run(run func(ctx context.Context, in *GetFollowersRequest, opts ...grpc.CallOption)) *MockServiceClient_GetFollowers_Call {
+func (_c *MockServiceClient_Signup_Call) Run(run func(ctx context.Context, in *SignupRequest, opts ...grpc.CallOption)) *MockServiceClient_Signup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *GetFollowersRequest
+		var arg1 *SignupRequest
 		if args[1] != nil {
-			arg1 = args[1].(*GetFollowersRequest)
+			arg1 = args[1].(*SignupRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -277,18 +2850,18 @@ func (_c *MockServiceClient_GetFollowers_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockServiceClient_GetFollowers_Call) Return(getFollowersResponse *GetFollowersResponse, err error) *MockServiceClient_GetFollowers_Call {
-	_c.Call.Return(getFollowersResponse, err)
+func (_c *MockServiceClient_Signup_Call) Return(signupResponse *SignupResponse, err error) *MockServiceClient_Signup_Call {
+	_c.Call.Return(signupResponse, err)
 	return _c
 }
 
-func (_c *MockServiceClient_GetFollowers_Call) RunAndReturn(run func(ctx context.Context, in *GetFollowersRequest, opts ...grpc.CallOption) (*GetFollowersResponse, error)) *MockServiceClient_GetFollowers_Call {
+func (_c *MockServiceClient_Signup_Call) RunAndReturn(run func(ctx context.Context, in *SignupRequest, opts ...grpc.CallOption) (*SignupResponse, error)) *MockServiceClient_Signup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFollowings provides a mock function for the type MockServiceClient
-func (_mock *MockServiceClient) GetFollowings(ctx context.Context, in *GetFollowingsRequest, opts ...grpc.CallOption) (*GetFollowingsResponse, error) {
+// Unblock provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) Unblock(ctx context.Context, in *UnblockRequest, opts ...grpc.CallOption) (*UnblockResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, in, opts)
@@ -298,22 +2871,22 @@ func (_mock *MockServiceClient) GetFollowings(ctx context.Context, in *GetFollow
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFollowings")
+		panic("no return value specified for Unblock")
 	}
 
-	var r0 *GetFollowingsResponse
+	var r0 *UnblockResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetFollowingsRequest, ...grpc.CallOption) (*GetFollowingsResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *UnblockRequest, ...grpc.CallOption) (*UnblockResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetFollowingsRequest, ...grpc.CallOption) *GetFollowingsResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *UnblockRequest, ...grpc.CallOption) *UnblockResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*GetFollowingsResponse)
+			r0 = ret.Get(0).(*UnblockResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetFollowingsRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *UnblockRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -321,29 +2894,29 @@ func (_mock *MockServiceClient) GetFollowings(ctx context.Context, in *GetFollow
 	return r0, r1
 }
 
-// MockServiceClient_GetFollowings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowings'
-type MockServiceClient_GetFollowings_Call struct {
+// MockServiceClient_Unblock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unblock'
+type MockServiceClient_Unblock_Call struct {
 	*mock.Call
 }
 
-// GetFollowings is a helper method to define mock.On call
+// Unblock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *GetFollowingsRequest
+//   - in *UnblockRequest
 //   - opts ...grpc.CallOption
-func (_e *MockServiceClient_Expecter) GetFollowings(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetFollowings_Call {
-	return &MockServiceClient_GetFollowings_Call{Call: _e.mock.On("GetFollowings",
+func (_e *MockServiceClient_Expecter) Unblock(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Unblock_Call {
+	return &MockServiceClient_Unblock_Call{Call: _e.mock.On("Unblock",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockServiceClient_GetFollowings_Call) Run(run func(ctx context.Context, in *GetFollowingsRequest, opts ...grpc.CallOption)) *MockServiceClient_GetFollowings_Call {
+func (_c *MockServiceClient_Unblock_Call) Run(run func(ctx context.Context, in *UnblockRequest, opts ...grpc.CallOption)) *MockServiceClient_Unblock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *GetFollowingsRequest
+		var arg1 *UnblockRequest
 		if args[1] != nil {
-			arg1 = args[1].(*GetFollowingsRequest)
+			arg1 = args[1].(*UnblockRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -360,18 +2933,18 @@ func (_c *MockServiceClient_GetFollowings_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockServiceClient_GetFollowings_Call) Return(getFollowingsResponse *GetFollowingsResponse, err error) *MockServiceClient_GetFollowings_Call {
-	_c.Call.Return(getFollowingsResponse, err)
+func (_c *MockServiceClient_Unblock_Call) Return(unblockResponse *UnblockResponse, err error) *MockServiceClient_Unblock_Call {
+	_c.Call.Return(unblockResponse, err)
 	return _c
 }
 
-func (_c *MockServiceClient_GetFollowings_Call) RunAndReturn(run func(ctx context.Context, in *GetFollowingsRequest, opts ...grpc.CallOption) (*GetFollowingsResponse, error)) *MockServiceClient_GetFollowings_Call {
+func (_c *MockServiceClient_Unblock_Call) RunAndReturn(run func(ctx context.Context, in *UnblockRequest, opts ...grpc.CallOption) (*UnblockResponse, error)) *MockServiceClient_Unblock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetNewsfeed provides a mock function for the type MockServiceClient
-func (_mock *MockServiceClient) GetNewsfeed(ctx context.Context, in *GetNewsfeedRequest, opts ...grpc.CallOption) (*GetNewsfeedResponse, error) {
+// Unfollow provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, in, opts)
@@ -381,22 +2954,22 @@ func (_mock *MockServiceClient) GetNewsfeed(ctx context.Context, in *GetNewsfeed
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetNewsfeed")
+		panic("no return value specified for Unfollow")
 	}
 
-	var r0 *GetNewsfeedResponse
+	var r0 *UnfollowResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetNewsfeedRequest, ...grpc.CallOption) (*GetNewsfeedResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *UnfollowRequest, ...grpc.CallOption) (*UnfollowResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetNewsfeedRequest, ...grpc.CallOption) *GetNewsfeedResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *UnfollowRequest, ...grpc.CallOption) *UnfollowResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*GetNewsfeedResponse)
+			r0 = ret.Get(0).(*UnfollowResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetNewsfeedRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *UnfollowRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -404,29 +2977,29 @@ func (_mock *MockServiceClient) GetNewsfeed(ctx context.Context, in *GetNewsfeed
 	return r0, r1
 }
 
-// MockServiceClient_GetNewsfeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNewsfeed'
-type MockServiceClient_GetNewsfeed_Call struct {
+// MockServiceClient_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockServiceClient_Unfollow_Call struct {
 	*mock.Call
 }
 
-// GetNewsfeed is a helper method to define mock.On call
+// Unfollow is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *GetNewsfeedRequest
+//   - in *UnfollowRequest
 //   - opts ...grpc.CallOption
-func (_e *MockServiceClient_Expecter) GetNewsfeed(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetNewsfeed_Call {
-	return &MockServiceClient_GetNewsfeed_Call{Call: _e.mock.On("GetNewsfeed",
+func (_e *MockServiceClient_Expecter) Unfollow(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Unfollow_Call {
+	return &MockServiceClient_Unfollow_Call{Call: _e.mock.On("Unfollow",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockServiceClient_GetNewsfeed_Call) Run(run func(ctx context.Context, in *GetNewsfeedRequest, opts ...grpc.CallOption)) *MockServiceClient_GetNewsfeed_Call {
+func (_c *MockServiceClient_Unfollow_Call) Run(run func(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption)) *MockServiceClient_Unfollow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *GetNewsfeedRequest
+		var arg1 *UnfollowRequest
 		if args[1] != nil {
-			arg1 = args[1].(*GetNewsfeedRequest)
+			arg1 = args[1].(*UnfollowRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -443,18 +3016,18 @@ func (_c *MockServiceClient_GetNewsfeed_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockServiceClient_GetNewsfeed_Call) Return(getNewsfeedResponse *GetNewsfeedResponse, err error) *MockServiceClient_GetNewsfeed_Call {
-	_c.Call.Return(getNewsfeedResponse, err)
+func (_c *MockServiceClient_Unfollow_Call) Return(unfollowResponse *UnfollowResponse, err error) *MockServiceClient_Unfollow_Call {
+	_c.Call.Return(unfollowResponse, err)
 	return _c
 }
 
-func (_c *MockServiceClient_GetNewsfeed_Call) RunAndReturn(run func(ctx context.Context, in *GetNewsfeedRequest, opts ...grpc.CallOption) (*GetNewsfeedResponse, error)) *MockServiceClient_GetNewsfeed_Call {
+func (_c *MockServiceClient_Unfollow_Call) RunAndReturn(run func(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error)) *MockServiceClient_Unfollow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPosts provides a mock function for the type MockServiceClient
-func (_mock *MockServiceClient) GetPosts(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error) {
+// Unmute provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) Unmute(ctx context.Context, in *UnmuteRequest, opts ...grpc.CallOption) (*UnmuteResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, in, opts)
@@ -464,22 +3037,22 @@ func (_mock *MockServiceClient) GetPosts(ctx context.Context, in *GetPostsReques
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPosts")
+		panic("no return value specified for Unmute")
 	}
 
-	var r0 *GetPostsResponse
+	var r0 *UnmuteResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetPostsRequest, ...grpc.CallOption) (*GetPostsResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *UnmuteRequest, ...grpc.CallOption) (*UnmuteResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *GetPostsRequest, ...grpc.CallOption) *GetPostsResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *UnmuteRequest, ...grpc.CallOption) *UnmuteResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*GetPostsResponse)
+			r0 = ret.Get(0).(*UnmuteResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *GetPostsRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *UnmuteRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -487,29 +3060,29 @@ func (_mock *MockServiceClient) GetPosts(ctx context.Context, in *GetPostsReques
 	return r0, r1
 }
 
-// MockServiceClient_GetPosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPosts'
-type MockServiceClient_GetPosts_Call struct {
+// MockServiceClient_Unmute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmute'
+type MockServiceClient_Unmute_Call struct {
 	*mock.Call
 }
 
-// GetPosts is a helper method to define mock.On call
+// Unmute is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *GetPostsRequest
+//   - in *UnmuteRequest
 //   - opts ...grpc.CallOption
-func (_e *MockServiceClient_Expecter) GetPosts(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_GetPosts_Call {
-	return &MockServiceClient_GetPosts_Call{Call: _e.mock.On("GetPosts",
+func (_e *MockServiceClient_Expecter) Unmute(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Unmute_Call {
+	return &MockServiceClient_Unmute_Call{Call: _e.mock.On("Unmute",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockServiceClient_GetPosts_Call) Run(run func(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption)) *MockServiceClient_GetPosts_Call {
+func (_c *MockServiceClient_Unmute_Call) Run(run func(ctx context.Context, in *UnmuteRequest, opts ...grpc.CallOption)) *MockServiceClient_Unmute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *GetPostsRequest
+		var arg1 *UnmuteRequest
 		if args[1] != nil {
-			arg1 = args[1].(*GetPostsRequest)
+			arg1 = args[1].(*UnmuteRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -526,18 +3099,18 @@ func (_c *MockServiceClient_GetPosts_Call) Run(run func(ctx context.Context, in
 	return _c
 }
 
-func (_c *MockServiceClient_GetPosts_Call) Return(getPostsResponse *GetPostsResponse, err error) *MockServiceClient_GetPosts_Call {
-	_c.Call.Return(getPostsResponse, err)
+func (_c *MockServiceClient_Unmute_Call) Return(unmuteResponse *UnmuteResponse, err error) *MockServiceClient_Unmute_Call {
+	_c.Call.Return(unmuteResponse, err)
 	return _c
 }
 
-func (_c *MockServiceClient_GetPosts_Call) RunAndReturn(run func(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)) *MockServiceClient_GetPosts_Call {
+func (_c *MockServiceClient_Unmute_Call) RunAndReturn(run func(ctx context.Context, in *UnmuteRequest, opts ...grpc.CallOption) (*UnmuteResponse, error)) *MockServiceClient_Unmute_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Login provides a mock function for the type MockServiceClient
-func (_mock *MockServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+// UpdatePost provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, in, opts)
@@ -547,22 +3120,22 @@ func (_mock *MockServiceClient) Login(ctx context.Context, in *LoginRequest, opt
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for UpdatePost")
 	}
 
-	var r0 *LoginResponse
+	var r0 *UpdatePostResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *LoginRequest, ...grpc.CallOption) (*LoginResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *UpdatePostRequest, ...grpc.CallOption) (*UpdatePostResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *LoginRequest, ...grpc.CallOption) *LoginResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *UpdatePostRequest, ...grpc.CallOption) *UpdatePostResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*LoginResponse)
+			r0 = ret.Get(0).(*UpdatePostResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *LoginRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *UpdatePostRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -570,29 +3143,29 @@ func (_mock *MockServiceClient) Login(ctx context.Context, in *LoginRequest, opt
 	return r0, r1
 }
 
-// MockServiceClient_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type MockServiceClient_Login_Call struct {
+// MockServiceClient_UpdatePost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePost'
+type MockServiceClient_UpdatePost_Call struct {
 	*mock.Call
 }
 
-// Login is a helper method to define mock.On call
+// UpdatePost is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *LoginRequest
+//   - in *UpdatePostRequest
 //   - opts ...grpc.CallOption
-func (_e *MockServiceClient_Expecter) Login(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Login_Call {
-	return &MockServiceClient_Login_Call{Call: _e.mock.On("Login",
+func (_e *MockServiceClient_Expecter) UpdatePost(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_UpdatePost_Call {
+	return &MockServiceClient_UpdatePost_Call{Call: _e.mock.On("UpdatePost",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockServiceClient_Login_Call) Run(run func(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption)) *MockServiceClient_Login_Call {
+func (_c *MockServiceClient_UpdatePost_Call) Run(run func(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption)) *MockServiceClient_UpdatePost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *LoginRequest
+		var arg1 *UpdatePostRequest
 		if args[1] != nil {
-			arg1 = args[1].(*LoginRequest)
+			arg1 = args[1].(*UpdatePostRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -609,18 +3182,18 @@ func (_c *MockServiceClient_Login_Call) Run(run func(ctx context.Context, in *Lo
 	return _c
 }
 
-func (_c *MockServiceClient_Login_Call) Return(loginResponse *LoginResponse, err error) *MockServiceClient_Login_Call {
-	_c.Call.Return(loginResponse, err)
+func (_c *MockServiceClient_UpdatePost_Call) Return(updatePostResponse *UpdatePostResponse, err error) *MockServiceClient_UpdatePost_Call {
+	_c.Call.Return(updatePostResponse, err)
 	return _c
 }
 
-func (_c *MockServiceClient_Login_Call) RunAndReturn(run func(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)) *MockServiceClient_Login_Call {
+func (_c *MockServiceClient_UpdatePost_Call) RunAndReturn(run func(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error)) *MockServiceClient_UpdatePost_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Signup provides a mock function for the type MockServiceClient
-func (_mock *MockServiceClient) Signup(ctx context.Context, in *SignupRequest, opts ...grpc.CallOption) (*SignupResponse, error) {
+// UploadMedia provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) UploadMedia(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption) (*UploadMediaResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, in, opts)
@@ -630,22 +3203,22 @@ func (_mock *MockServiceClient) Signup(ctx context.Context, in *SignupRequest, o
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for Signup")
+		panic("no return value specified for UploadMedia")
 	}
 
-	var r0 *SignupResponse
+	var r0 *UploadMediaResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *SignupRequest, ...grpc.CallOption) (*SignupResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *UploadMediaRequest, ...grpc.CallOption) (*UploadMediaResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *SignupRequest, ...grpc.CallOption) *SignupResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *UploadMediaRequest, ...grpc.CallOption) *UploadMediaResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*SignupResponse)
+			r0 = ret.Get(0).(*UploadMediaResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *SignupRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *UploadMediaRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -653,29 +3226,29 @@ func (_mock *MockServiceClient) Signup(ctx context.Context, in *SignupRequest, o
 	return r0, r1
 }
 
-// MockServiceClient_Signup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Signup'
-type MockServiceClient_Signup_Call struct {
+// MockServiceClient_UploadMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadMedia'
+type MockServiceClient_UploadMedia_Call struct {
 	*mock.Call
 }
 
-// Signup is a helper method to define mock.On call
+// UploadMedia is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *SignupRequest
+//   - in *UploadMediaRequest
 //   - opts ...grpc.CallOption
-func (_e *MockServiceClient_Expecter) Signup(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Signup_Call {
-	return &MockServiceClient_Signup_Call{Call: _e.mock.On("Signup",
+func (_e *MockServiceClient_Expecter) UploadMedia(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_UploadMedia_Call {
+	return &MockServiceClient_UploadMedia_Call{Call: _e.mock.On("UploadMedia",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockServiceClient_Signup_Call) Run(run func(ctx context.Context, in *SignupRequest, opts ...grpc.CallOption)) *MockServiceClient_Signup_Call {
+func (_c *MockServiceClient_UploadMedia_Call) Run(run func(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption)) *MockServiceClient_UploadMedia_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *SignupRequest
+		var arg1 *UploadMediaRequest
 		if args[1] != nil {
-			arg1 = args[1].(*SignupRequest)
+			arg1 = args[1].(*UploadMediaRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -692,18 +3265,18 @@ func (_c *MockServiceClient_Signup_Call) Run(run func(ctx context.Context, in *S
 	return _c
 }
 
-func (_c *MockServiceClient_Signup_Call) Return(signupResponse *SignupResponse, err error) *MockServiceClient_Signup_Call {
-	_c.Call.Return(signupResponse, err)
+func (_c *MockServiceClient_UploadMedia_Call) Return(uploadMediaResponse *UploadMediaResponse, err error) *MockServiceClient_UploadMedia_Call {
+	_c.Call.Return(uploadMediaResponse, err)
 	return _c
 }
 
-func (_c *MockServiceClient_Signup_Call) RunAndReturn(run func(ctx context.Context, in *SignupRequest, opts ...grpc.CallOption) (*SignupResponse, error)) *MockServiceClient_Signup_Call {
+func (_c *MockServiceClient_UploadMedia_Call) RunAndReturn(run func(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption) (*UploadMediaResponse, error)) *MockServiceClient_UploadMedia_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Unfollow provides a mock function for the type MockServiceClient
-func (_mock *MockServiceClient) Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error) {
+// VerifyEmail provides a mock function for the type MockServiceClient
+func (_mock *MockServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, in, opts)
@@ -713,22 +3286,22 @@ func (_mock *MockServiceClient) Unfollow(ctx context.Context, in *UnfollowReques
 	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for Unfollow")
+		panic("no return value specified for VerifyEmail")
 	}
 
-	var r0 *UnfollowResponse
+	var r0 *VerifyEmailResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *UnfollowRequest, ...grpc.CallOption) (*UnfollowResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *VerifyEmailRequest, ...grpc.CallOption) (*VerifyEmailResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *UnfollowRequest, ...grpc.CallOption) *UnfollowResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *VerifyEmailRequest, ...grpc.CallOption) *VerifyEmailResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*UnfollowResponse)
+			r0 = ret.Get(0).(*VerifyEmailResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *UnfollowRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *VerifyEmailRequest, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -736,29 +3309,29 @@ func (_mock *MockServiceClient) Unfollow(ctx context.Context, in *UnfollowReques
 	return r0, r1
 }
 
-// MockServiceClient_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
-type MockServiceClient_Unfollow_Call struct {
+// MockServiceClient_VerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmail'
+type MockServiceClient_VerifyEmail_Call struct {
 	*mock.Call
 }
 
-// Unfollow is a helper method to define mock.On call
+// VerifyEmail is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *UnfollowRequest
+//   - in *VerifyEmailRequest
 //   - opts ...grpc.CallOption
-func (_e *MockServiceClient_Expecter) Unfollow(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_Unfollow_Call {
-	return &MockServiceClient_Unfollow_Call{Call: _e.mock.On("Unfollow",
+func (_e *MockServiceClient_Expecter) VerifyEmail(ctx interface{}, in interface{}, opts ...interface{}) *MockServiceClient_VerifyEmail_Call {
+	return &MockServiceClient_VerifyEmail_Call{Call: _e.mock.On("VerifyEmail",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockServiceClient_Unfollow_Call) Run(run func(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption)) *MockServiceClient_Unfollow_Call {
+func (_c *MockServiceClient_VerifyEmail_Call) Run(run func(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption)) *MockServiceClient_VerifyEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *UnfollowRequest
+		var arg1 *VerifyEmailRequest
 		if args[1] != nil {
-			arg1 = args[1].(*UnfollowRequest)
+			arg1 = args[1].(*VerifyEmailRequest)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -775,12 +3348,12 @@ func (_c *MockServiceClient_Unfollow_Call) Run(run func(ctx context.Context, in
 	return _c
 }
 
-func (_c *MockServiceClient_Unfollow_Call) Return(unfollowResponse *UnfollowResponse, err error) *MockServiceClient_Unfollow_Call {
-	_c.Call.Return(unfollowResponse, err)
+func (_c *MockServiceClient_VerifyEmail_Call) Return(verifyEmailResponse *VerifyEmailResponse, err error) *MockServiceClient_VerifyEmail_Call {
+	_c.Call.Return(verifyEmailResponse, err)
 	return _c
 }
 
-func (_c *MockServiceClient_Unfollow_Call) RunAndReturn(run func(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error)) *MockServiceClient_Unfollow_Call {
+func (_c *MockServiceClient_VerifyEmail_Call) RunAndReturn(run func(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)) *MockServiceClient_VerifyEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,94 @@
+package reaction_flusher
+
+import (
+	"context"
+	"time"
+
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+const (
+	defaultInterval  = 5 * time.Second
+	defaultBatchSize = 1000
+)
+
+type ReactionService interface {
+	FlushReactions(ctx context.Context, batchSize int64) (int, error)
+}
+
+type Config struct {
+	Interval  time.Duration // time between 2 flushes
+	BatchSize int64         // max number of reactions persisted per transaction
+}
+
+// ReactionFlusher periodically persists reactions changed in cache to db
+type ReactionFlusher struct {
+	cfg Config
+
+	reactionService ReactionService
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func New(cfg Config, reactionService ReactionService) (*ReactionFlusher, error) {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+
+	return &ReactionFlusher{
+		cfg:             cfg,
+		reactionService: reactionService,
+		stopCh:          make(chan struct{}),
+		doneCh:          make(chan struct{}),
+	}, nil
+}
+
+// Start flushes reactions until Stop is called, it is blocking
+func (f *ReactionFlusher) Start() {
+	defer close(f.doneCh)
+
+	ticker := time.NewTicker(f.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-f.stopCh:
+			f.flush() // persist what is changed since the last tick before shutting down
+			return
+		case <-ticker.C:
+			f.flush()
+		}
+	}
+}
+
+// Stop stops flushing and waits for the last flush
+func (f *ReactionFlusher) Stop() {
+	close(f.stopCh)
+	<-f.doneCh
+}
+
+// flush keeps flushing while batches are full, so a burst of reactions does not wait for many ticks
+func (f *ReactionFlusher) flush() {
+	ctx := context.Background()
+
+	var total int
+	for {
+		n, err := f.reactionService.FlushReactions(ctx, f.cfg.BatchSize)
+		if err != nil {
+			logger.Error("failed to flush reactions", logger.E(err))
+			return
+		}
+		total += n
+		if int64(n) < f.cfg.BatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		logger.Debug("flushed reactions", logger.F("count", total))
+	}
+}
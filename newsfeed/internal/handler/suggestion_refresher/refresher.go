@@ -0,0 +1,103 @@
+package suggestion_refresher
+
+import (
+	"context"
+	"time"
+
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+const (
+	defaultInterval  = time.Hour
+	defaultBatchSize = 100
+)
+
+type SuggestionService interface {
+	RefreshSuggestions(ctx context.Context, afterId int64, batchSize int) (int64, int, error)
+}
+
+type Config struct {
+	Interval  time.Duration // time between 2 refreshes of all users
+	BatchSize int           // number of users refreshed per batch
+}
+
+// SuggestionRefresher periodically recomputes follow suggestions of all users into cache
+type SuggestionRefresher struct {
+	cfg Config
+
+	suggestionService SuggestionService
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func New(cfg Config, suggestionService SuggestionService) (*SuggestionRefresher, error) {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+
+	return &SuggestionRefresher{
+		cfg:               cfg,
+		suggestionService: suggestionService,
+		stopCh:            make(chan struct{}),
+		doneCh:            make(chan struct{}),
+	}, nil
+}
+
+// Start refreshes suggestions until Stop is called, it is blocking
+func (r *SuggestionRefresher) Start() {
+	defer close(r.doneCh)
+
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.C:
+			r.refresh()
+		}
+	}
+}
+
+// Stop stops refreshing and waits for the running refresh to stop at its next batch
+func (r *SuggestionRefresher) Stop() {
+	close(r.stopCh)
+	<-r.doneCh
+}
+
+// refresh visits all users batch by batch, it stops early if Stop is called
+func (r *SuggestionRefresher) refresh() {
+	ctx := context.Background()
+	start := time.Now()
+
+	var (
+		afterId int64
+		total   int
+	)
+	for {
+		select {
+		case <-r.stopCh:
+			logger.Info("suggestion refresh is stopped", logger.F("users", total))
+			return
+		default:
+		}
+
+		lastId, n, err := r.suggestionService.RefreshSuggestions(ctx, afterId, r.cfg.BatchSize)
+		if err != nil {
+			logger.Error("failed to refresh suggestions", logger.E(err), logger.F("after_id", afterId))
+			return
+		}
+		total += n
+		if n < r.cfg.BatchSize {
+			break
+		}
+		afterId = lastId
+	}
+
+	logger.Info("refreshed suggestions", logger.F("users", total), logger.F("duration", time.Since(start).String()))
+}
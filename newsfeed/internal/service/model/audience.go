@@ -0,0 +1,22 @@
+package model
+
+// audience lists of a user, posts shared with a list are only visible to its members
+const (
+	AudienceListCloseFriends = "close_friends"
+)
+
+// lists of users that a user does not want to interact with, they share storage with audience lists
+const (
+	AudienceListBlocked = "blocked" // blocked users and the owner are hidden from each other
+	AudienceListMuted   = "muted"   // posts of muted users are hidden from newsfeed of the owner
+)
+
+// AudienceMember is a user added to an audience list of its owner
+type AudienceMember struct {
+	ID               int64
+	OwnerID          int64
+	List             string
+	MemberID         int64
+	Member           *User // optional
+	CreatedTimestamp int64
+}
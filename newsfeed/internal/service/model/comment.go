@@ -0,0 +1,13 @@
+package model
+
+// Comment is a comment on a post, or a reply to a top-level comment if ParentID is set
+type Comment struct {
+	ID       int64
+	PostID   int64
+	UserID   int64
+	ParentID int64 // 0 for top-level comments
+
+	Content          string
+	ReplyCount       int64 // only for top-level comments
+	CreatedTimestamp int64
+}
@@ -1,8 +1,10 @@
 package model
 
+import "ep.k16/newsfeed/pkg/cursor"
+
 type Paging struct {
-	LastValue any
-	Limit     int64
+	Cursor *cursor.Cursor // points to the last item of previous page, nil for the first page
+	Limit  int64
 
 	// optional
 	OrderBy   string
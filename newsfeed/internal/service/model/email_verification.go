@@ -0,0 +1,17 @@
+package model
+
+// EmailVerificationToken verifies the email of a user once before it expires, db keeps the hash of the token only
+type EmailVerificationToken struct {
+	UserID           int64
+	TokenHash        string
+	CreatedTimestamp int64
+	ExpiresTimestamp int64
+}
+
+// actions which can be denied to users who have not verified their email
+const (
+	ActionPost    = "post" // create posts and upload media
+	ActionComment = "comment"
+	ActionReact   = "react"
+	ActionFollow  = "follow"
+)
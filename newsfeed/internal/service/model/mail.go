@@ -0,0 +1,8 @@
+package model
+
+// Mail is a plain text email sent to a user
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
@@ -0,0 +1,22 @@
+package model
+
+// Media is a file uploaded by a user, it can be attached to 1 post
+type Media struct {
+	ID     int64
+	UserID int64
+	PostID int64 // 0 if not attached to any post yet
+
+	ContentType string
+	Size        int64
+	Width       int
+	Height      int
+
+	BlobKey      string
+	ThumbnailKey string
+
+	// filled by service from blob keys, they are not stored
+	URL          string
+	ThumbnailURL string
+
+	CreatedTimestamp int64
+}
@@ -0,0 +1,9 @@
+package model
+
+// PasswordResetToken lets a user set a new password once before it expires, db keeps the hash of the token only
+type PasswordResetToken struct {
+	UserID           int64
+	TokenHash        string
+	CreatedTimestamp int64
+	ExpiresTimestamp int64
+}
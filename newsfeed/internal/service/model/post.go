@@ -7,4 +7,60 @@ type Post struct {
 
 	Content          string
 	CreatedTimestamp int64
+	EditedTimestamp  int64 // 0 if content has never been edited
+	Visibility       string
+
+	MediaIDs []int64 // ids of attached media, in display order
+	Media    []*Media
+
+	// engagement counters, used by engagement-weighted ranking
+	CommentCount  int64
+	ReactionCount int64
+
+	// reactions are kept in their own cache, so they are filled per request and not cached with the post
+	Reactions *PostReactions `json:"-"`
+
+	// Removed marks a deleted post in cache until newsfeed worker retracts it from newsfeeds
+	Removed bool `json:",omitempty"`
+}
+
+// audiences that a post can be shared with
+const (
+	VisibilityPublic       = "public"
+	VisibilityFollowers    = "followers"     // followers of the author
+	VisibilityCloseFriends = "close_friends" // members of close friends list of the author, see AudienceListCloseFriends
+)
+
+func IsValidVisibility(visibility string) bool {
+	switch visibility {
+	case VisibilityPublic, VisibilityFollowers, VisibilityCloseFriends:
+		return true
+	}
+	return false
+}
+
+// events of posts sent to newsfeed worker, see PostMsgProducer
+const (
+	PostEventCreated = "created"
+	PostEventDeleted = "deleted"
+)
+
+// PostRevision is a previous version of post content, kept when the post is edited
+type PostRevision struct {
+	ID               int64
+	PostID           int64
+	Content          string
+	CreatedTimestamp int64 // when this version was written
+}
+
+// RankedPost is a newsfeed post with its ranking score, posts with higher score are shown first
+type RankedPost struct {
+	Post  *Post
+	Score float64
+}
+
+// FeedItem is a post reference stored in cached newsfeeds and cached posts of a user
+type FeedItem struct {
+	PostID    int64
+	Timestamp int64
 }
@@ -0,0 +1,44 @@
+package model
+
+const (
+	ReactionLike  = "like"
+	ReactionLove  = "love"
+	ReactionHaha  = "haha"
+	ReactionWow   = "wow"
+	ReactionSad   = "sad"
+	ReactionAngry = "angry"
+)
+
+// ReactionTypes is the fixed set of supported reactions, in display order
+var ReactionTypes = []string{ReactionLike, ReactionLove, ReactionHaha, ReactionWow, ReactionSad, ReactionAngry}
+
+func IsValidReactionType(reactionType string) bool {
+	for _, t := range ReactionTypes {
+		if t == reactionType {
+			return true
+		}
+	}
+	return false
+}
+
+// Reaction is the reaction of a user on a post, empty Type means the user has removed the reaction
+type Reaction struct {
+	PostID           int64
+	UserID           int64
+	Type             string
+	UpdatedTimestamp int64
+}
+
+// PostReactions is reaction counters of a post seen by a viewer
+type PostReactions struct {
+	Counts         map[string]int64 // reaction type -> count
+	ViewerReaction string           // empty if viewer has not reacted
+}
+
+func (r *PostReactions) Total() int64 {
+	var total int64
+	for _, count := range r.Counts {
+		total += count
+	}
+	return total
+}
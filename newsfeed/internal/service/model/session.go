@@ -0,0 +1,23 @@
+package model
+
+// Session is a login of a user, access tokens carry its id so they stop working once it is revoked.
+// Its refresh tokens form a family: each refresh uses the latest token and replaces it with a new one,
+// a used token presented again means it was leaked, so the whole session is revoked.
+type Session struct {
+	ID               string
+	UserID           int64
+	User             *User // optional
+	CreatedTimestamp int64
+	Revoked          bool
+
+	RefreshToken    string // only set when the token is issued, db keeps its hash
+	RefreshExpireTs int64
+}
+
+// RefreshToken is a stored refresh token of a session, used means it was already exchanged for a new one
+type RefreshToken struct {
+	SessionID        string
+	TokenHash        string
+	ExpiresTimestamp int64
+	Used             bool
+}
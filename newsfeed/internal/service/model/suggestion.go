@@ -0,0 +1,10 @@
+package model
+
+// Suggestion is a user that may be followed, ranked by how close it is in the follow graph
+type Suggestion struct {
+	UserID           int64
+	User             *User `json:"-"` // optional, filled when suggestions are read
+	Score            float64
+	MutualFollowings int64 // number of followings of the viewer that follow this user
+	MutualFollowers  int64 // number of users following both the viewer and this user
+}
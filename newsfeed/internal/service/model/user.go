@@ -8,6 +8,16 @@ type User struct {
 	DisplayName    string
 	Email          string
 	Dob            string
+	IsPrivate      bool        // followers of private users must be approved, see FollowRequest
+	EmailVerified  bool        // unverified users may be denied some actions, see ActionPost
+	Counts         *UserCounts `json:"-"` // optional, counts are cached apart from user data
+}
+
+// UserCounts are denormalized counters of a user, they change together with follows and posts
+type UserCounts struct {
+	Followers  int64
+	Followings int64
+	Posts      int64
 }
 
 type Follow struct {
@@ -15,4 +25,28 @@ type Follow struct {
 	Follower  *User // optional
 	Following *User // optional
 	FollowTs  int64
+	Pending   bool `json:"-"` // following user is private, so the follow waits for approval as a FollowRequest
+}
+
+// FollowRequest is a pending follow to a private user, it is removed once approved, rejected or cancelled
+type FollowRequest struct {
+	ID               int64
+	RequesterID      int64
+	Requester        *User // optional
+	TargetID         int64
+	CreatedTimestamp int64
+}
+
+// events of follows sent to newsfeed worker, together with events of posts, see PostEventCreated
+const (
+	FollowEventUnfollowed = "unfollowed"
+)
+
+// Relationship is how a viewer and another user are related
+type Relationship struct {
+	UserID     int64
+	Following  bool // viewer follows user
+	FollowedBy bool // user follows viewer
+	Blocking   bool // viewer blocked user
+	BlockedBy  bool // user blocked viewer
 }
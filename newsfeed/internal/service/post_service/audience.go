@@ -0,0 +1,148 @@
+package post_service
+
+import (
+	"context"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+// filterBlocked returns the given users that are blocked by user or blocking user, keeping their order.
+// Cached blocks are used if audience sets of user are complete, otherwise db is checked.
+func (s *PostService) filterBlocked(ctx context.Context, userId int64, userIds []int64) ([]int64, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	cached, err := s.ensureAudienceCached(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !cached {
+		return s.filterDbAudience(ctx, userId, model.AudienceListBlocked, userIds, true, true)
+	}
+
+	ids, err := s.userCacheDai.FilterBlocked(ctx, userId, userIds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to check blocks", err)
+	}
+	return ids, nil
+}
+
+// filterCloseFriends returns the given users that are close friends of owner, same as filterBlocked
+func (s *PostService) filterCloseFriends(ctx context.Context, ownerId int64, userIds []int64) ([]int64, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	cached, err := s.ensureAudienceCached(ctx, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	if !cached {
+		return s.filterDbAudience(ctx, ownerId, model.AudienceListCloseFriends, userIds, true, false)
+	}
+
+	ids, err := s.userCacheDai.FilterCloseFriends(ctx, ownerId, userIds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to check close friends", err)
+	}
+	return ids, nil
+}
+
+// filterMuted returns the given users that are muted by user, same as filterBlocked
+func (s *PostService) filterMuted(ctx context.Context, userId int64, userIds []int64) ([]int64, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	cached, err := s.ensureAudienceCached(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !cached {
+		return s.filterDbAudience(ctx, userId, model.AudienceListMuted, userIds, true, false)
+	}
+
+	ids, err := s.userCacheDai.FilterMuted(ctx, userId, userIds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to check mutes", err)
+	}
+	return ids, nil
+}
+
+// filterMutedBy returns the given users that are muting user, same as filterBlocked
+func (s *PostService) filterMutedBy(ctx context.Context, userId int64, userIds []int64) ([]int64, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+	cached, err := s.ensureAudienceCached(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !cached {
+		return s.filterDbAudience(ctx, userId, model.AudienceListMuted, userIds, false, true)
+	}
+
+	ids, err := s.userCacheDai.FilterMutedBy(ctx, userId, userIds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to check mutes", err)
+	}
+	return ids, nil
+}
+
+// filterDbAudience returns the given users related to user by a kind of list in db, keeping their order.
+// listedByUser picks users in the list of user, listingUser picks users having user in their list.
+func (s *PostService) filterDbAudience(ctx context.Context, userId int64, list string, userIds []int64, listedByUser, listingUser bool) ([]int64, error) {
+	members, err := s.audienceDai.GetAudienceMembersBetween(ctx, userId, list, userIds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	isRelated := make(map[int64]bool)
+	for _, member := range members {
+		if listedByUser && member.OwnerID == userId {
+			isRelated[member.MemberID] = true
+		}
+		if listingUser && member.MemberID == userId {
+			isRelated[member.OwnerID] = true
+		}
+	}
+
+	ids := make([]int64, 0)
+	for _, id := range userIds {
+		if isRelated[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// ensureAudienceCached rebuilds audience sets of user from db if they are not complete, e.g. after cache is flushed.
+// It returns false if they are still not complete, because another request is rebuilding them or a change raced the rebuild.
+func (s *PostService) ensureAudienceCached(ctx context.Context, userId int64) (bool, error) {
+	cached, err := s.userCacheDai.IsAudienceCached(ctx, userId)
+	if err != nil {
+		return false, common.WrapError(common.CodeInternal, "failed to check cached audience", err)
+	}
+	if cached {
+		return true, nil
+	}
+
+	token, err := s.userCacheDai.LockAudienceRebuild(ctx, userId)
+	if err != nil {
+		return false, common.WrapError(common.CodeInternal, "failed to lock cached audience", err)
+	}
+	if len(token) == 0 {
+		return false, nil
+	}
+
+	members, err := s.audienceDai.GetAudienceMembersOfUser(ctx, userId)
+	if err != nil {
+		return false, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	rebuilt, err := s.userCacheDai.SetCachedAudience(ctx, userId, token, members)
+	if err != nil {
+		return false, common.WrapError(common.CodeInternal, "failed to cache audience", err)
+	}
+	logger.Debug("rebuilt cached audience", logger.F("user_id", userId), logger.F("rebuilt", rebuilt))
+	return rebuilt, nil
+}
@@ -0,0 +1,73 @@
+package post_service
+
+import (
+	"context"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+// filterBlockedAndMutedPosts removes posts whose authors are blocked by viewer, blocking viewer or muted by viewer.
+// Newsfeeds may still have such posts, because they were pushed before, or pulled from high-follower authors.
+func (s *PostService) filterBlockedAndMutedPosts(ctx context.Context, viewerId int64, posts []*model.Post) ([]*model.Post, error) {
+	authorIds := make([]int64, 0)
+	seen := make(map[int64]bool)
+	for _, post := range posts {
+		if post.UserID != viewerId && !seen[post.UserID] {
+			seen[post.UserID] = true
+			authorIds = append(authorIds, post.UserID)
+		}
+	}
+	if len(authorIds) == 0 {
+		return posts, nil
+	}
+
+	blockedIds, err := s.filterBlocked(ctx, viewerId, authorIds)
+	if err != nil {
+		return nil, err
+	}
+	mutedIds, err := s.filterMuted(ctx, viewerId, authorIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(blockedIds) == 0 && len(mutedIds) == 0 {
+		return posts, nil
+	}
+
+	isHidden := make(map[int64]bool)
+	for _, id := range append(blockedIds, mutedIds...) {
+		isHidden[id] = true
+	}
+
+	res := make([]*model.Post, 0, len(posts))
+	for _, post := range posts {
+		if !isHidden[post.UserID] {
+			res = append(res, post)
+		}
+	}
+	return res, nil
+}
+
+// excludeMutingFollowers removes followers muting the author from receivers of a fanned out post.
+// Blocks are not checked, because blocking removes follows in both directions.
+func (s *PostService) excludeMutingFollowers(ctx context.Context, authorId int64, followerIds []int64) ([]int64, error) {
+	mutingIds, err := s.filterMutedBy(ctx, authorId, followerIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(mutingIds) == 0 {
+		return followerIds, nil
+	}
+
+	isMuting := make(map[int64]bool)
+	for _, id := range mutingIds {
+		isMuting[id] = true
+	}
+
+	res := make([]int64, 0, len(followerIds))
+	for _, id := range followerIds {
+		if !isMuting[id] {
+			res = append(res, id)
+		}
+	}
+	return res, nil
+}
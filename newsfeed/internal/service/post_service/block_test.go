@@ -0,0 +1,130 @@
+package post_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func TestPostService_filterBlockedAndMutedPosts(t *testing.T) {
+	ctx := context.Background()
+	posts := []*model.Post{
+		{ID: 5, UserID: 1}, // own post
+		{ID: 4, UserID: 2},
+		{ID: 3, UserID: 3}, // muted
+		{ID: 2, UserID: 4}, // blocked
+		{ID: 1, UserID: 2},
+	}
+
+	mockUserCache := new(MockUserCacheDAI)
+	mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+	mockUserCache.On("FilterBlocked", ctx, int64(1), []int64{2, 3, 4}).Return([]int64{4}, nil)
+	mockUserCache.On("FilterMuted", ctx, int64(1), []int64{2, 3, 4}).Return([]int64{3}, nil)
+
+	service, err := New(Config{}, nil, nil, nil, mockUserCache, nil, nil, nil)
+	assert.NoError(t, err)
+
+	res, err := service.filterBlockedAndMutedPosts(ctx, 1, posts)
+
+	assert.NoError(t, err)
+	resIds := make([]int64, len(res))
+	for i := range res {
+		resIds[i] = res[i].ID
+	}
+	assert.Equal(t, []int64{5, 4, 1}, resIds)
+}
+
+func TestPostService_GetPostByUserID_Blocked(t *testing.T) {
+	ctx := context.Background()
+
+	mockUserCache := new(MockUserCacheDAI)
+	mockUserCache.On("IsAudienceCached", ctx, int64(2)).Return(true, nil)
+	mockUserCache.On("FilterBlocked", ctx, int64(2), []int64{1}).Return([]int64{1}, nil)
+	mockDAI := new(MockPostDAI)
+
+	service, err := New(Config{}, mockDAI, nil, nil, mockUserCache, nil, nil, nil)
+	assert.NoError(t, err)
+
+	posts, err := service.GetPostByUserID(ctx, 2, 1, &model.Paging{Limit: 10})
+
+	assert.Nil(t, posts)
+	assertAppError(t, err, common.CodeNotExistedUserID)
+	mockDAI.AssertNotCalled(t, "GetPostsByUserID")
+}
+
+func TestPostService_filterBlocked(t *testing.T) {
+	ctx := context.Background()
+	members := []*model.AudienceMember{
+		{OwnerID: 3, List: model.AudienceListBlocked, MemberID: 2}, // 3 blocks viewer
+		{OwnerID: 2, List: model.AudienceListMuted, MemberID: 4},
+	}
+
+	t.Run("rebuild audience after cache loss", func(t *testing.T) {
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsAudienceCached", ctx, int64(2)).Return(false, nil)
+		mockUserCache.On("LockAudienceRebuild", ctx, int64(2)).Return("token", nil)
+		mockUserCache.On("SetCachedAudience", ctx, int64(2), "token", members).Return(true, nil)
+		mockUserCache.On("FilterBlocked", ctx, int64(2), []int64{3, 4}).Return([]int64{3}, nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("GetAudienceMembersOfUser", ctx, int64(2)).Return(members, nil)
+
+		service, err := New(Config{}, nil, nil, mockAudienceDAI, mockUserCache, nil, nil, nil)
+		assert.NoError(t, err)
+
+		blockedIds, err := service.filterBlocked(ctx, 2, []int64{3, 4})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3}, blockedIds)
+		mockUserCache.AssertExpectations(t)
+	})
+
+	t.Run("check db while another request rebuilds audience", func(t *testing.T) {
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsAudienceCached", ctx, int64(2)).Return(false, nil)
+		mockUserCache.On("LockAudienceRebuild", ctx, int64(2)).Return("", nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("GetAudienceMembersBetween", ctx, int64(2), model.AudienceListBlocked, []int64{3, 4}).
+			Return(members[:1], nil)
+
+		service, err := New(Config{}, nil, nil, mockAudienceDAI, mockUserCache, nil, nil, nil)
+		assert.NoError(t, err)
+
+		blockedIds, err := service.filterBlocked(ctx, 2, []int64{3, 4})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3}, blockedIds)
+		mockUserCache.AssertNotCalled(t, "FilterBlocked", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostService_AppendPostToNewsfeed_MutingFollowers(t *testing.T) {
+	ctx := context.Background()
+	post := &model.Post{ID: 10, UserID: 1, Content: "hello", CreatedTimestamp: 1700000000}
+
+	mockPostCache := new(MockPostCacheDAI)
+	mockPostCache.On("AddCachedPost", ctx, post).Return(true, nil)
+	mockPostCache.On("AddPostToUserPosts", ctx, post, int64(defaultNewsfeedMaxLength)).Return(nil)
+	mockPostCache.On("AddPostToNewsfeeds", ctx, []int64{2, 4}, post, int64(defaultNewsfeedMaxLength)).Return(nil)
+
+	mockUserCache := new(MockUserCacheDAI)
+	mockUserCache.On("IsHighFollowerUser", ctx, int64(1)).Return(false, nil)
+	mockUserCache.On("IsFollowersCached", ctx, int64(1)).Return(true, nil)
+	mockUserCache.On("CountFollowers", ctx, int64(1)).Return(int64(3), nil)
+	mockUserCache.On("GetFollowerIDs", ctx, int64(1), int64(0), int64(defaultFanoutBatchSize)).Return([]int64{2, 3, 4}, nil)
+	mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+	mockUserCache.On("FilterMutedBy", ctx, int64(1), []int64{2, 3, 4}).Return([]int64{3}, nil)
+
+	service, err := New(Config{}, nil, nil, nil, mockUserCache, mockPostCache, nil, nil)
+	assert.NoError(t, err)
+
+	err = service.AppendPostToNewsfeed(ctx, post)
+
+	assert.NoError(t, err)
+	mockPostCache.AssertExpectations(t)
+	mockUserCache.AssertExpectations(t)
+}
@@ -0,0 +1,176 @@
+package post_service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+const (
+	maxCommentContentLength = 2000
+	maxCommentPageSize      = 100
+)
+
+// CreateComment adds a comment to a post, or a reply if comment.ParentID is set.
+// Only 1 level of replies is supported, so replying to a reply is rejected.
+func (s *PostService) CreateComment(ctx context.Context, comment *model.Comment) (*model.Comment, error) {
+	if err := validateComment(comment); err != nil {
+		return nil, common.WrapError(common.CodeInvalidRequest, "invalid comment", err)
+	}
+
+	if _, err := s.getAccessiblePost(ctx, comment.UserID, comment.PostID); err != nil {
+		return nil, err
+	}
+
+	if comment.ParentID > 0 {
+		parent, err := s.dai.GetCommentByID(ctx, comment.ParentID)
+		if err != nil {
+			return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+		}
+		if parent == nil || parent.PostID != comment.PostID {
+			return nil, common.NewError(common.CodeNotFound, "parent comment not found")
+		}
+		if parent.ParentID > 0 {
+			return nil, common.NewError(common.CodeInvalidRequest, "cannot reply to a reply")
+		}
+	}
+
+	comment.CreatedTimestamp = time.Now().Unix()
+	createdComment, err := s.dai.CreateComment(ctx, comment)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	s.refreshCachedPost(ctx, comment.PostID)
+	return createdComment, nil
+}
+
+// ListComments returns top-level comments of a post if parentId is 0, otherwise replies of that comment, oldest first.
+// paging.Cursor holds the last comment id of the previous page, nil means the first page.
+func (s *PostService) ListComments(ctx context.Context, viewerId, postId, parentId int64, paging *model.Paging) ([]*model.Comment, error) {
+	if paging.Limit <= 0 || paging.Limit > maxCommentPageSize {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid limit")
+	}
+
+	if _, err := s.getAccessiblePost(ctx, viewerId, postId); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.dai.GetCommentsByPostID(ctx, postId, parentId, paging)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	return comments, nil
+}
+
+// DeleteComment removes a comment with its replies, it can be done by the comment author or the post author
+func (s *PostService) DeleteComment(ctx context.Context, userId, postId, commentId int64) error {
+	comment, err := s.dai.GetCommentByID(ctx, commentId)
+	if err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if comment == nil || comment.PostID != postId {
+		return common.NewError(common.CodeNotFound, "comment not found")
+	}
+
+	if comment.UserID != userId {
+		post, err := s.getExistingPost(ctx, postId)
+		if err != nil {
+			return err
+		}
+		if post.UserID != userId {
+			return common.NewError(common.CodeForbidden, "not allowed to delete comment")
+		}
+	}
+
+	if err := s.dai.DeleteComment(ctx, comment); err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	s.refreshCachedPost(ctx, postId)
+	return nil
+}
+
+func validateComment(comment *model.Comment) error {
+	if comment == nil {
+		return errors.New("comment is required")
+	}
+	if comment.UserID <= 0 {
+		return errors.New("user_id is required")
+	}
+	if comment.PostID <= 0 {
+		return errors.New("post_id is required")
+	}
+	if comment.ParentID < 0 {
+		return errors.New("invalid parent_id")
+	}
+	if len(comment.Content) == 0 {
+		return errors.New("content is required")
+	}
+	if len(comment.Content) > maxCommentContentLength {
+		return errors.New("content is too long")
+	}
+	return nil
+}
+
+func (s *PostService) getExistingPost(ctx context.Context, postId int64) (*model.Post, error) {
+	post, err := s.dai.GetPostByID(ctx, postId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if post == nil {
+		return nil, common.NewError(common.CodeNotFound, "post not found")
+	}
+	return post, nil
+}
+
+// getAccessiblePost returns an existing post that viewer can comment or react on.
+// Posts of users blocked by or blocking viewer and posts not shared with viewer are reported as not found,
+// same as hidden profiles.
+func (s *PostService) getAccessiblePost(ctx context.Context, viewerId, postId int64) (*model.Post, error) {
+	post, err := s.getExistingPost(ctx, postId)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID == viewerId {
+		return post, nil
+	}
+
+	blockedIds, err := s.filterBlocked(ctx, viewerId, []int64{post.UserID})
+	if err != nil {
+		return nil, err
+	}
+	if len(blockedIds) > 0 {
+		return nil, common.NewError(common.CodeNotFound, "post not found")
+	}
+
+	visibilities, err := s.getAllowedVisibilities(ctx, viewerId, post.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(visibilities, getVisibility(post)) {
+		return nil, common.NewError(common.CodeNotFound, "post not found")
+	}
+	return post, nil
+}
+
+// refreshCachedPost reloads post from db into cache, so newsfeed readers see its latest counters.
+// Failing to refresh only makes the cached post stale, so the error is logged only.
+func (s *PostService) refreshCachedPost(ctx context.Context, postId int64) {
+	post, err := s.dai.GetPostByID(ctx, postId)
+	if err != nil {
+		logger.Error("failed to load post to refresh cache", logger.E(err), logger.F("post_id", postId))
+		return
+	}
+	if post == nil {
+		return
+	}
+	if err := s.postCacheDai.SetCachedPost(ctx, post); err != nil {
+		logger.Error("failed to refresh cached post", logger.E(err), logger.F("post_id", postId))
+	}
+}
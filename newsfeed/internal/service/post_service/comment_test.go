@@ -0,0 +1,194 @@
+package post_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func TestPostService_CreateComment(t *testing.T) {
+	ctx := context.Background()
+	post := &model.Post{ID: 10, UserID: 2}
+
+	t.Run("post not found", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(nil, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.CreateComment(ctx, &model.Comment{PostID: 10, UserID: 1, Content: "nice"})
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeNotFound)
+	})
+
+	t.Run("post author blocked commenter", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("FilterBlocked", ctx, int64(1), []int64{2}).Return([]int64{2}, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, mockUserCache, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.CreateComment(ctx, &model.Comment{PostID: 10, UserID: 1, Content: "nice"})
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeNotFound)
+		mockDAI.AssertNotCalled(t, "CreateComment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("post is not shared with commenter", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(&model.Post{ID: 10, UserID: 2, Visibility: model.VisibilityFollowers}, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, newAccessibleUserCache(ctx), nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.CreateComment(ctx, &model.Comment{PostID: 10, UserID: 1, Content: "nice"})
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeNotFound)
+		mockDAI.AssertNotCalled(t, "CreateComment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reply to a reply", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+		mockDAI.On("GetCommentByID", ctx, int64(6)).Return(&model.Comment{ID: 6, PostID: 10, ParentID: 5}, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, newAccessibleUserCache(ctx), nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.CreateComment(ctx, &model.Comment{PostID: 10, UserID: 1, ParentID: 6, Content: "nice"})
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+
+	t.Run("reply and refresh cached post", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+		mockDAI.On("GetCommentByID", ctx, int64(5)).Return(&model.Comment{ID: 5, PostID: 10}, nil)
+		mockDAI.On("CreateComment", ctx, mock.AnythingOfType("*model.Comment")).
+			Return(&model.Comment{ID: 6, PostID: 10, UserID: 1, ParentID: 5, Content: "nice"}, nil)
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("SetCachedPost", ctx, post).Return(nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, newAccessibleUserCache(ctx), mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.CreateComment(ctx, &model.Comment{PostID: 10, UserID: 1, ParentID: 5, Content: "nice"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(6), res.ID)
+		mockPostCache.AssertExpectations(t)
+	})
+}
+
+func TestPostService_ListComments(t *testing.T) {
+	ctx := context.Background()
+	post := &model.Post{ID: 10, UserID: 2}
+	paging := &model.Paging{Limit: 10}
+
+	t.Run("viewer blocked post author", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("FilterBlocked", ctx, int64(1), []int64{2}).Return([]int64{2}, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, mockUserCache, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.ListComments(ctx, 1, 10, 0, paging)
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeNotFound)
+		mockDAI.AssertNotCalled(t, "GetCommentsByPostID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("post is not shared with viewer", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(&model.Post{ID: 10, UserID: 2, Visibility: model.VisibilityCloseFriends}, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, newAccessibleUserCache(ctx), nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.ListComments(ctx, 1, 10, 0, paging)
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeNotFound)
+		mockDAI.AssertNotCalled(t, "GetCommentsByPostID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("list comments", func(t *testing.T) {
+		comments := []*model.Comment{{ID: 5, PostID: 10, UserID: 3, Content: "nice"}}
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+		mockDAI.On("GetCommentsByPostID", ctx, int64(10), int64(0), paging).Return(comments, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, newAccessibleUserCache(ctx), nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.ListComments(ctx, 1, 10, 0, paging)
+
+		assert.NoError(t, err)
+		assert.Equal(t, comments, res)
+	})
+}
+
+// newAccessibleUserCache mocks cache of a viewer not blocked with anyone, following nobody and in no close friends list
+func newAccessibleUserCache(ctx context.Context) *MockUserCacheDAI {
+	mockUserCache := new(MockUserCacheDAI)
+	mockUserCache.On("IsAudienceCached", ctx, mock.Anything).Return(true, nil)
+	mockUserCache.On("FilterBlocked", ctx, mock.Anything, mock.Anything).Return([]int64{}, nil)
+	mockUserCache.On("IsFollowingsCached", ctx, mock.Anything).Return(true, nil)
+	mockUserCache.On("GetFollowTimestamps", ctx, mock.Anything, mock.Anything).Return(map[int64]int64{}, nil)
+	mockUserCache.On("FilterCloseFriends", ctx, mock.Anything, mock.Anything).Return([]int64{}, nil)
+	return mockUserCache
+}
+
+func TestPostService_DeleteComment(t *testing.T) {
+	ctx := context.Background()
+	post := &model.Post{ID: 10, UserID: 2}
+	comment := &model.Comment{ID: 5, PostID: 10, UserID: 1}
+
+	t.Run("not allowed", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetCommentByID", ctx, int64(5)).Return(comment, nil)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.DeleteComment(ctx, 3, 10, 5)
+
+		assertAppError(t, err, common.CodeForbidden)
+		mockDAI.AssertNotCalled(t, "DeleteComment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("deleted by post author", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetCommentByID", ctx, int64(5)).Return(comment, nil)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+		mockDAI.On("DeleteComment", ctx, comment).Return(nil)
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("SetCachedPost", ctx, post).Return(nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, nil, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.DeleteComment(ctx, 2, 10, 5)
+
+		assert.NoError(t, err)
+		mockDAI.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,191 @@
+package post_service
+
+import (
+	"context"
+	"sort"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+// forEachFollowerBatch calls fn with followers of user batch by batch and returns the number of followers visited.
+// Followers are paged from cache if the cached set holds all of them, an incomplete set is rebuilt from db first.
+// If another request holds the rebuild lock, followers are read from db, so none is missed while cache is partial.
+func (s *PostService) forEachFollowerBatch(ctx context.Context, userId int64, fn func(followerIds []int64) error) (int64, error) {
+	cached, err := s.ensureFollowersCached(ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+	if !cached {
+		return s.forEachDbFollowerBatch(ctx, userId, fn)
+	}
+
+	var offset int64
+	for {
+		followerIds, err := s.userCacheDai.GetFollowerIDs(ctx, userId, offset, s.cfg.FanoutBatchSize)
+		if err != nil {
+			return offset, common.WrapError(common.CodeInternal, "failed to get followers from cache", err)
+		}
+		if len(followerIds) == 0 {
+			break
+		}
+		if err := fn(followerIds); err != nil {
+			return offset, err
+		}
+
+		offset += int64(len(followerIds))
+		if int64(len(followerIds)) < s.cfg.FanoutBatchSize {
+			break
+		}
+	}
+	return offset, nil
+}
+
+// forEachDbFollowerBatch is forEachFollowerBatch reading all followers from db at once
+func (s *PostService) forEachDbFollowerBatch(ctx context.Context, userId int64, fn func(followerIds []int64) error) (int64, error) {
+	followTsById, err := s.userDai.GetFollowerTimestamps(ctx, userId)
+	if err != nil {
+		return 0, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	followerIds := make([]int64, 0, len(followTsById))
+	for id := range followTsById {
+		followerIds = append(followerIds, id)
+	}
+	sort.Slice(followerIds, func(i, j int) bool { return followerIds[i] < followerIds[j] })
+
+	for start := int64(0); start < int64(len(followerIds)); start += s.cfg.FanoutBatchSize {
+		end := min(start+s.cfg.FanoutBatchSize, int64(len(followerIds)))
+		if err := fn(followerIds[start:end]); err != nil {
+			return start, err
+		}
+	}
+	return int64(len(followerIds)), nil
+}
+
+// ensureFollowersCached rebuilds the followers set of user from db if it is not complete.
+// It returns false if the set is still not complete, because another request is rebuilding it or a follow raced the rebuild.
+func (s *PostService) ensureFollowersCached(ctx context.Context, userId int64) (bool, error) {
+	cached, err := s.userCacheDai.IsFollowersCached(ctx, userId)
+	if err != nil {
+		return false, common.WrapError(common.CodeInternal, "failed to check cached followers", err)
+	}
+	if cached {
+		return true, nil
+	}
+
+	token, err := s.userCacheDai.LockFollowersRebuild(ctx, userId)
+	if err != nil {
+		return false, common.WrapError(common.CodeInternal, "failed to lock cached followers", err)
+	}
+	if len(token) == 0 {
+		return false, nil
+	}
+
+	followTsById, err := s.userDai.GetFollowerTimestamps(ctx, userId)
+	if err != nil {
+		return false, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	rebuilt, err := s.userCacheDai.SetCachedFollowers(ctx, userId, token, followTsById)
+	if err != nil {
+		return false, common.WrapError(common.CodeInternal, "failed to cache followers", err)
+	}
+	logger.Debug("rebuilt cached followers", logger.F("user_id", userId), logger.F("rebuilt", rebuilt))
+	return rebuilt, nil
+}
+
+// countFollowers counts followers of user in cache if the cached set is complete, otherwise in db
+func (s *PostService) countFollowers(ctx context.Context, userId int64) (int64, error) {
+	cached, err := s.userCacheDai.IsFollowersCached(ctx, userId)
+	if err != nil {
+		return 0, common.WrapError(common.CodeInternal, "failed to check cached followers", err)
+	}
+	if cached {
+		numFollowers, err := s.userCacheDai.CountFollowers(ctx, userId)
+		if err != nil {
+			return 0, common.WrapError(common.CodeInternal, "failed to count followers", err)
+		}
+		return numFollowers, nil
+	}
+
+	counts, err := s.userDai.GetCounts(ctx, userId)
+	if err != nil {
+		return 0, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if counts == nil {
+		return 0, nil
+	}
+	return counts.Followers, nil
+}
+
+// getFollowTimestamps returns follow timestamps of given followings of user, not followed ids are not returned.
+// They are looked up in cache if the cached followings set of user is complete, otherwise in db.
+func (s *PostService) getFollowTimestamps(ctx context.Context, userId int64, followingIds []int64) (map[int64]int64, error) {
+	if len(followingIds) == 0 {
+		return map[int64]int64{}, nil
+	}
+
+	cached, err := s.userCacheDai.IsFollowingsCached(ctx, userId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to check cached followings", err)
+	}
+	if cached {
+		followTsById, err := s.userCacheDai.GetFollowTimestamps(ctx, userId, followingIds)
+		if err != nil {
+			return nil, common.WrapError(common.CodeInternal, "failed to check following", err)
+		}
+		return followTsById, nil
+	}
+	return s.getDbFollowTimestamps(ctx, userId, followingIds)
+}
+
+// getDbFollowTimestamps is getFollowTimestamps reading follows from db
+func (s *PostService) getDbFollowTimestamps(ctx context.Context, userId int64, followingIds []int64) (map[int64]int64, error) {
+	follows, err := s.userDai.GetFollowsBetween(ctx, userId, followingIds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	followTsById := make(map[int64]int64)
+	for _, follow := range follows {
+		// follows of peers to user are returned as well
+		if follow.Follower.ID == userId {
+			followTsById[follow.Following.ID] = follow.FollowTs
+		}
+	}
+	return followTsById, nil
+}
+
+// getFollowedHighFollowerIDs returns high-follower users followed by user, whose posts are pulled into its newsfeed.
+// Followings are checked in cache if the cached followings set of user is complete, otherwise in db.
+func (s *PostService) getFollowedHighFollowerIDs(ctx context.Context, userId int64) ([]int64, error) {
+	cached, err := s.userCacheDai.IsFollowingsCached(ctx, userId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to check cached followings", err)
+	}
+	if cached {
+		ids, err := s.userCacheDai.GetFollowedHighFollowerIDs(ctx, userId)
+		if err != nil {
+			return nil, common.WrapError(common.CodeInternal, "failed to get followed high-follower users", err)
+		}
+		return ids, nil
+	}
+
+	highFollowerIds, err := s.userCacheDai.GetHighFollowerIDs(ctx)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to get high-follower users", err)
+	}
+	if len(highFollowerIds) == 0 {
+		return nil, nil
+	}
+	followTsById, err := s.getDbFollowTimestamps(ctx, userId, highFollowerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(followTsById))
+	for _, id := range highFollowerIds {
+		if _, ok := followTsById[id]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,232 @@
+package post_service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // register decoder
+	"image/jpeg"
+	_ "image/png" // register decoder
+	"net/http"
+	"time"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+const (
+	maxPostMedia = 10
+
+	defaultMaxMediaSize  = 3 << 20 // keep it below max grpc message size, which is 4MB by default
+	defaultThumbnailSize = 320
+	maxMediaPixels       = 40_000_000 // reject huge images before decoding them into memory
+	thumbnailQuality     = 80
+)
+
+// mediaExtensions contains supported content types, detected from file content instead of trusting clients
+var mediaExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+// BlobStore stores media files, it can be local file system or S3-compatible storage
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
+
+// UploadMedia validates and stores an image with its thumbnail, the returned media can be attached to a new post
+func (s *PostService) UploadMedia(ctx context.Context, userId int64, data []byte) (*model.Media, error) {
+	if userId <= 0 {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid user_id")
+	}
+	if len(data) == 0 {
+		return nil, common.NewError(common.CodeInvalidRequest, "media is empty")
+	}
+	if int64(len(data)) > s.cfg.MaxMediaSize {
+		return nil, common.NewError(common.CodeInvalidRequest, fmt.Sprintf("media is larger than %d bytes", s.cfg.MaxMediaSize))
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := mediaExtensions[contentType]
+	if !ok {
+		return nil, common.NewError(common.CodeInvalidRequest, "unsupported content type: "+contentType)
+	}
+
+	imgConfig, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, common.WrapError(common.CodeInvalidRequest, "invalid image", err)
+	}
+	if imgConfig.Width*imgConfig.Height > maxMediaPixels {
+		return nil, common.NewError(common.CodeInvalidRequest, "image dimensions are too large")
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, common.WrapError(common.CodeInvalidRequest, "invalid image", err)
+	}
+
+	thumbnail := &bytes.Buffer{}
+	err = jpeg.Encode(thumbnail, resizeImage(img, s.cfg.ThumbnailSize), &jpeg.Options{Quality: thumbnailQuality})
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to encode thumbnail", err)
+	}
+
+	// store blobs first, a blob without db row is only wasted space while a db row without blob is a broken post
+	blobKey, err := newBlobKey(userId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to generate blob key", err)
+	}
+	media := &model.Media{
+		UserID:           userId,
+		ContentType:      contentType,
+		Size:             int64(len(data)),
+		Width:            imgConfig.Width,
+		Height:           imgConfig.Height,
+		BlobKey:          blobKey + "." + ext,
+		ThumbnailKey:     blobKey + "_thumb.jpg",
+		CreatedTimestamp: time.Now().Unix(),
+	}
+	if err := s.blobStore.Put(ctx, media.BlobKey, data, contentType); err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to store media", err)
+	}
+	if err := s.blobStore.Put(ctx, media.ThumbnailKey, thumbnail.Bytes(), "image/jpeg"); err != nil {
+		s.deleteBlobs(ctx, media.BlobKey)
+		return nil, common.WrapError(common.CodeInternal, "failed to store thumbnail", err)
+	}
+
+	createdMedia, err := s.dai.CreateMedia(ctx, media)
+	if err != nil {
+		s.deleteBlobs(ctx, media.BlobKey, media.ThumbnailKey)
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	s.setMediaURLs(createdMedia)
+	return createdMedia, nil
+}
+
+// getAttachableMedia loads media of a new post in the same order as post.MediaIDs,
+// they must be uploaded by the post author and not attached to any post
+func (s *PostService) getAttachableMedia(ctx context.Context, post *model.Post) ([]*model.Media, error) {
+	if len(post.MediaIDs) == 0 {
+		return nil, nil
+	}
+
+	mediaList, err := s.dai.GetMediaByIDs(ctx, post.MediaIDs)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	mediaById := make(map[int64]*model.Media, len(mediaList))
+	for _, media := range mediaList {
+		mediaById[media.ID] = media
+	}
+
+	res := make([]*model.Media, len(post.MediaIDs))
+	for i, mediaId := range post.MediaIDs {
+		media, ok := mediaById[mediaId]
+		if !ok || media.UserID != post.UserID {
+			return nil, common.NewError(common.CodeInvalidRequest, fmt.Sprintf("media %d not found", mediaId))
+		}
+		if media.PostID != 0 {
+			return nil, common.NewError(common.CodeInvalidRequest, fmt.Sprintf("media %d is already attached", mediaId))
+		}
+		res[i] = media
+	}
+	return res, nil
+}
+
+func validatePostMediaIDs(mediaIds []int64) error {
+	if len(mediaIds) > maxPostMedia {
+		return fmt.Errorf("post has more than %d media", maxPostMedia)
+	}
+	seen := make(map[int64]bool, len(mediaIds))
+	for _, mediaId := range mediaIds {
+		if mediaId <= 0 {
+			return errors.New("invalid media id")
+		}
+		if seen[mediaId] {
+			return errors.New("duplicated media id")
+		}
+		seen[mediaId] = true
+	}
+	return nil
+}
+
+// setPostMediaURLs fills URLs of media from their blob keys, keys are stored instead of URLs so the store can be moved
+func (s *PostService) setPostMediaURLs(posts ...*model.Post) {
+	for _, post := range posts {
+		s.setMediaURLs(post.Media...)
+	}
+}
+
+func (s *PostService) setMediaURLs(mediaList ...*model.Media) {
+	if s.blobStore == nil { // newsfeed worker does not serve media
+		return
+	}
+	for _, media := range mediaList {
+		media.URL = s.blobStore.URL(media.BlobKey)
+		media.ThumbnailURL = s.blobStore.URL(media.ThumbnailKey)
+	}
+}
+
+func (s *PostService) deleteBlobs(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := s.blobStore.Delete(ctx, key); err != nil {
+			logger.Error("failed to delete blob", logger.E(err), logger.F("key", key))
+		}
+	}
+}
+
+// newBlobKey returns a random key grouped by user: <user_id>/<random hex>
+func newBlobKey(userId int64) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d/%s", userId, hex.EncodeToString(b)), nil
+}
+
+// resizeImage scales img down to fit in a maxSize x maxSize box, keeping its aspect ratio.
+// Each pixel of the result is the average of the source pixels it covers, which is good enough for thumbnails.
+func resizeImage(img image.Image, maxSize int) image.Image {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	dstW, dstH := srcW, srcH
+	if srcW > maxSize || srcH > maxSize {
+		if srcW >= srcH {
+			dstW, dstH = maxSize, max(1, srcH*maxSize/srcW)
+		} else {
+			dstW, dstH = max(1, srcW*maxSize/srcH), maxSize
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		y0, y1 := bounds.Min.Y+y*srcH/dstH, bounds.Min.Y+max((y+1)*srcH/dstH, y*srcH/dstH+1)
+		for x := 0; x < dstW; x++ {
+			x0, x1 := bounds.Min.X+x*srcW/dstW, bounds.Min.X+max((x+1)*srcW/dstW, x*srcW/dstW+1)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i+0] = uint8(r / n >> 8)
+			dst.Pix[i+1] = uint8(g / n >> 8)
+			dst.Pix[i+2] = uint8(b / n >> 8)
+			dst.Pix[i+3] = uint8(a / n >> 8)
+		}
+	}
+	return dst
+}
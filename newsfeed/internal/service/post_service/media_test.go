@@ -0,0 +1,156 @@
+package post_service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func newTestPNG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, 0, color.RGBA{R: 255, A: 255})
+	}
+	buf := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(buf, img))
+	return buf.Bytes()
+}
+
+func TestPostService_UploadMedia(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("too large", func(t *testing.T) {
+		service, err := New(Config{MaxMediaSize: 10}, nil, nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.UploadMedia(ctx, 1, newTestPNG(t, 8, 8))
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+
+	t.Run("unsupported content type", func(t *testing.T) {
+		service, err := New(Config{}, nil, nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.UploadMedia(ctx, 1, []byte("<html>not an image</html>"))
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+
+	t.Run("store image and thumbnail", func(t *testing.T) {
+		mockStore := new(MockBlobStore)
+		mockStore.On("Put", ctx, mock.MatchedBy(func(key string) bool { return len(key) > 0 }), mock.Anything, mock.Anything).Return(nil)
+		mockStore.On("URL", mock.Anything).Return(func(key string) string { return "http://media/" + key })
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("CreateMedia", ctx, mock.AnythingOfType("*model.Media")).
+			Return(func(ctx context.Context, media *model.Media) (*model.Media, error) {
+				media.ID = 5
+				return media, nil
+			})
+
+		service, err := New(Config{ThumbnailSize: 50}, mockDAI, nil, nil, nil, nil, nil, mockStore)
+		assert.NoError(t, err)
+
+		res, err := service.UploadMedia(ctx, 1, newTestPNG(t, 200, 100))
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), res.ID)
+		assert.Equal(t, "image/png", res.ContentType)
+		assert.Equal(t, 200, res.Width)
+		assert.Equal(t, "http://media/"+res.BlobKey, res.URL)
+		assert.Equal(t, "http://media/"+res.ThumbnailKey, res.ThumbnailURL)
+		mockStore.AssertNumberOfCalls(t, "Put", 2)
+	})
+
+	t.Run("delete blobs if db failed", func(t *testing.T) {
+		mockStore := new(MockBlobStore)
+		mockStore.On("Put", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockStore.On("Delete", ctx, mock.Anything).Return(nil)
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("CreateMedia", ctx, mock.AnythingOfType("*model.Media")).Return(nil, errors.New("db down"))
+
+		service, err := New(Config{}, mockDAI, nil, nil, nil, nil, nil, mockStore)
+		assert.NoError(t, err)
+
+		res, err := service.UploadMedia(ctx, 1, newTestPNG(t, 8, 8))
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeDatabaseError)
+		mockStore.AssertNumberOfCalls(t, "Delete", 2)
+	})
+}
+
+func TestPostService_CreatePost_Media(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("media of another user", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetMediaByIDs", ctx, []int64{5}).Return([]*model.Media{{ID: 5, UserID: 2}}, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.CreatePost(ctx, &model.Post{UserID: 1, MediaIDs: []int64{5}})
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeInvalidRequest)
+		mockDAI.AssertNotCalled(t, "CreatePost", mock.Anything, mock.Anything)
+	})
+
+	t.Run("duplicated media", func(t *testing.T) {
+		service, err := New(Config{}, new(MockPostDAI), nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.CreatePost(ctx, &model.Post{UserID: 1, MediaIDs: []int64{5, 5}})
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+
+	t.Run("attach media in given order", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetMediaByIDs", ctx, []int64{7, 5}).Return([]*model.Media{
+			{ID: 5, UserID: 1, BlobKey: "1/a.png"},
+			{ID: 7, UserID: 1, BlobKey: "1/b.png"},
+		}, nil)
+		mockDAI.On("CreatePost", ctx, mock.AnythingOfType("*model.Post")).
+			Return(&model.Post{ID: 10, UserID: 1, MediaIDs: []int64{7, 5}}, nil)
+		mockProducer := new(MockPostMsgProducer)
+		mockProducer.On("SendPost", ctx, mock.AnythingOfType("*model.Post")).Return(nil)
+		mockStore := new(MockBlobStore)
+		mockStore.On("URL", mock.Anything).Return(func(key string) string { return "http://media/" + key })
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("DeleteCachedCounts", ctx, []int64{1}).Return(nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, mockUserCache, nil, mockProducer, mockStore)
+		assert.NoError(t, err)
+
+		res, err := service.CreatePost(ctx, &model.Post{UserID: 1, MediaIDs: []int64{7, 5}})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Media, 2)
+		assert.Equal(t, int64(7), res.Media[0].ID)
+		assert.Equal(t, int64(10), res.Media[0].PostID)
+		assert.Equal(t, "http://media/1/b.png", res.Media[0].URL)
+	})
+}
+
+func TestResizeImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 640, 320))
+
+	assert.Equal(t, image.Rect(0, 0, 320, 160), resizeImage(img, 320).Bounds())
+	assert.Equal(t, image.Rect(0, 0, 640, 320), resizeImage(img, 1000).Bounds()) // not upscaled
+	assert.Equal(t, image.Rect(0, 0, 1, 2), resizeImage(image.NewRGBA(image.Rect(0, 0, 1, 500)), 2).Bounds())
+}
@@ -0,0 +1,190 @@
+package post_service
+
+import (
+	"context"
+	"sort"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+// fetchFeedItemsFunc loads items of a feed source sorted by timestamp desc, see PostCacheDAI.GetNewsfeedItems
+type fetchFeedItemsFunc func(maxTs int64, offset, count int64) ([]*model.FeedItem, error)
+
+// GetNewsfeed returns newsfeed of a user sorted by (created timestamp, post id) desc.
+// It merges 2 kinds of sources:
+//   - pushed: grpc:<user_id>:newsfeed, built by AppendPostToNewsfeed
+//   - pulled: grpc:<following_id>:posts of high-follower users that the user follows
+//
+// paging.Cursor holds the created timestamp and id of the last post of previous page, nil means the first page.
+// paging.OrderBy selects the ranker used to order posts inside the page, empty means the default one.
+// The returned cursor points to the next page, it is nil if there is no more post.
+func (s *PostService) GetNewsfeed(ctx context.Context, userId int64, paging *model.Paging) ([]*model.RankedPost, *cursor.Cursor, error) {
+	if paging.Limit <= 0 {
+		return nil, nil, common.NewError(common.CodeInvalidRequest, "invalid limit")
+	}
+
+	rankingName := paging.OrderBy
+	if len(rankingName) == 0 {
+		rankingName = s.cfg.DefaultRanking
+	}
+	ranker, ok := s.rankers[rankingName]
+	if !ok {
+		return nil, nil, common.NewError(common.CodeInvalidRequest, "unknown ranking: "+rankingName)
+	}
+
+	sources := []fetchFeedItemsFunc{
+		func(maxTs int64, offset, count int64) ([]*model.FeedItem, error) {
+			return s.postCacheDai.GetNewsfeedItems(ctx, userId, maxTs, offset, count)
+		},
+	}
+
+	highFollowerIds, err := s.getFollowedHighFollowerIDs(ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, followingId := range highFollowerIds {
+		sources = append(sources, func(maxTs int64, offset, count int64) ([]*model.FeedItem, error) {
+			return s.postCacheDai.GetUserPostItems(ctx, followingId, maxTs, offset, count)
+		})
+	}
+
+	// each source gives its own top items after cursor, so the top items of all sources are among them
+	items := make([]*model.FeedItem, 0)
+	for _, fetch := range sources {
+		sourceItems, err := fetchFeedItemsAfterCursor(fetch, paging.Cursor, paging.Limit)
+		if err != nil {
+			return nil, nil, common.WrapError(common.CodeInternal, "failed to get newsfeed from cache", err)
+		}
+		items = append(items, sourceItems...)
+	}
+	items = mergeFeedItems(items, paging.Limit)
+
+	posts, err := s.getPostsByFeedItems(ctx, items)
+	if err != nil {
+		return nil, nil, err
+	}
+	posts, err = s.filterVisiblePosts(ctx, userId, posts)
+	if err != nil {
+		return nil, nil, err
+	}
+	posts, err = s.filterBlockedAndMutedPosts(ctx, userId, posts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.setPostReactions(ctx, userId, posts); err != nil {
+		return nil, nil, err
+	}
+
+	rankedPosts, err := rankPosts(ctx, ranker, userId, posts)
+	if err != nil {
+		return nil, nil, common.WrapError(common.CodeInternal, "failed to rank newsfeed", err)
+	}
+
+	// cursor is taken from items instead of posts, so removed posts do not end the newsfeed early
+	var nextCursor *cursor.Cursor
+	if int64(len(items)) == paging.Limit {
+		lastItem := items[len(items)-1]
+		nextCursor = &cursor.Cursor{
+			SortKey: lastItem.Timestamp,
+			ID:      lastItem.PostID,
+		}
+	}
+	return rankedPosts, nextCursor, nil
+}
+
+// fetchFeedItemsAfterCursor loads at least limit items after the cursor from a source, or all of them if the source has fewer.
+// Items sharing the timestamp of the last loaded item are all loaded, because the source does not sort them by post id.
+func fetchFeedItemsAfterCursor(fetch fetchFeedItemsFunc, after *cursor.Cursor, limit int64) ([]*model.FeedItem, error) {
+	var maxTs int64
+	if after != nil {
+		maxTs = after.SortKey
+	}
+
+	res := make([]*model.FeedItem, 0, limit)
+	var offset int64
+	for {
+		batch, err := fetch(maxTs, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range batch {
+			if int64(len(res)) >= limit && item.Timestamp < res[len(res)-1].Timestamp {
+				return res, nil
+			}
+			if after != nil && !isAfterCursor(item, after) {
+				continue
+			}
+			res = append(res, item)
+		}
+
+		if int64(len(batch)) < limit {
+			return res, nil
+		}
+		offset += int64(len(batch))
+	}
+}
+
+// isAfterCursor checks if item comes after cursor in (timestamp, post id) desc order
+func isAfterCursor(item *model.FeedItem, after *cursor.Cursor) bool {
+	if item.Timestamp != after.SortKey {
+		return item.Timestamp < after.SortKey
+	}
+	return item.PostID < after.ID
+}
+
+// mergeFeedItems sorts items by (timestamp, post id) desc, removes duplicated posts and keeps the first limit items
+func mergeFeedItems(items []*model.FeedItem, limit int64) []*model.FeedItem {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Timestamp != items[j].Timestamp {
+			return items[i].Timestamp > items[j].Timestamp
+		}
+		return items[i].PostID > items[j].PostID
+	})
+
+	res := make([]*model.FeedItem, 0, limit)
+	for i, item := range items {
+		if int64(len(res)) >= limit {
+			break
+		}
+		if i > 0 && item.PostID == items[i-1].PostID { // same post is sorted next to each other
+			continue
+		}
+		res = append(res, item)
+	}
+	return res
+}
+
+// getPostsByFeedItems loads posts from cache, falling back to db for the missing ones
+func (s *PostService) getPostsByFeedItems(ctx context.Context, items []*model.FeedItem) ([]*model.Post, error) {
+	postIds := make([]int64, len(items))
+	for i := range items {
+		postIds[i] = items[i].PostID
+	}
+
+	cachedPosts, err := s.postCacheDai.GetCachedPostsByIDs(ctx, postIds)
+	if err != nil {
+		logger.Error("failed to get posts from cache", logger.E(err))
+		cachedPosts = make([]*model.Post, len(postIds))
+	}
+
+	posts := make([]*model.Post, 0, len(postIds))
+	for i, postId := range postIds {
+		post := cachedPosts[i]
+		if post == nil {
+			post, err = s.dai.GetPostByID(ctx, postId)
+			if err != nil {
+				return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+			}
+		}
+		if post == nil || post.Removed { // post is removed, its id may stay in newsfeeds until newsfeed worker retracts it
+			continue
+		}
+		posts = append(posts, post)
+	}
+	s.setPostMediaURLs(posts...)
+	return posts, nil
+}
@@ -0,0 +1,189 @@
+package post_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
+)
+
+// newFeedSource returns a fetchFeedItemsFunc over items sorted by timestamp desc, same as redis ZREVRANGEBYSCORE
+func newFeedSource(items ...*model.FeedItem) fetchFeedItemsFunc {
+	return func(maxTs int64, offset, count int64) ([]*model.FeedItem, error) {
+		filtered := make([]*model.FeedItem, 0)
+		for _, item := range items {
+			if maxTs <= 0 || item.Timestamp <= maxTs {
+				filtered = append(filtered, item)
+			}
+		}
+		if offset >= int64(len(filtered)) {
+			return nil, nil
+		}
+		end := min(offset+count, int64(len(filtered)))
+		return filtered[offset:end], nil
+	}
+}
+
+func TestFetchFeedItemsAfterCursor(t *testing.T) {
+	// post 5 and 6 share the same timestamp, redis returns them in member order
+	source := newFeedSource(
+		&model.FeedItem{PostID: 7, Timestamp: 300},
+		&model.FeedItem{PostID: 5, Timestamp: 200},
+		&model.FeedItem{PostID: 6, Timestamp: 200},
+		&model.FeedItem{PostID: 4, Timestamp: 100},
+	)
+
+	t.Run("first page loads all items sharing the last timestamp", func(t *testing.T) {
+		items, err := fetchFeedItemsAfterCursor(source, nil, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, items, 3)
+	})
+
+	t.Run("next page skips items before cursor", func(t *testing.T) {
+		items, err := fetchFeedItemsAfterCursor(source, &cursor.Cursor{SortKey: 200, ID: 6}, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.FeedItem{
+			{PostID: 5, Timestamp: 200},
+			{PostID: 4, Timestamp: 100},
+		}, items)
+	})
+}
+
+func TestPostService_GetNewsfeed(t *testing.T) {
+	ctx := context.Background()
+
+	// pushed newsfeed of user 1 contains post 3 of user 9 before user 9 became a high-follower user
+	pushed := newFeedSource(
+		&model.FeedItem{PostID: 4, Timestamp: 400},
+		&model.FeedItem{PostID: 3, Timestamp: 300},
+		&model.FeedItem{PostID: 1, Timestamp: 100},
+	)
+	pulled := newFeedSource(
+		&model.FeedItem{PostID: 5, Timestamp: 500},
+		&model.FeedItem{PostID: 3, Timestamp: 300},
+		&model.FeedItem{PostID: 2, Timestamp: 200},
+	)
+
+	mockPostCache := new(MockPostCacheDAI)
+	mockPostCache.On("GetNewsfeedItems", ctx, int64(1), mock.Anything, mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, userId int64, maxTs int64, offset, count int64) ([]*model.FeedItem, error) {
+			return pushed(maxTs, offset, count)
+		})
+	mockPostCache.On("GetUserPostItems", ctx, int64(9), mock.Anything, mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, userId int64, maxTs int64, offset, count int64) ([]*model.FeedItem, error) {
+			return pulled(maxTs, offset, count)
+		})
+	mockPostCache.On("GetCachedPostsByIDs", ctx, mock.Anything).
+		Return(func(ctx context.Context, postIds []int64) ([]*model.Post, error) {
+			posts := make([]*model.Post, len(postIds))
+			for i := range postIds {
+				posts[i] = &model.Post{ID: postIds[i]}
+			}
+			return posts, nil
+		})
+
+	mockPostCache.On("GetPostReactions", ctx, mock.Anything, int64(1)).
+		Return(func(ctx context.Context, postIds []int64, viewerId int64) ([]*model.PostReactions, error) {
+			return newEmptyReactions(len(postIds)), nil
+		})
+
+	mockUserCache := new(MockUserCacheDAI)
+	mockUserCache.On("IsFollowingsCached", ctx, int64(1)).Return(true, nil)
+	mockUserCache.On("GetFollowedHighFollowerIDs", ctx, int64(1)).Return([]int64{9}, nil)
+	mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+	mockUserCache.On("FilterBlocked", ctx, int64(1), mock.Anything).Return([]int64{}, nil)
+	mockUserCache.On("FilterMuted", ctx, int64(1), mock.Anything).Return([]int64{}, nil)
+
+	service, err := New(Config{}, nil, nil, nil, mockUserCache, mockPostCache, nil, nil)
+	assert.NoError(t, err)
+
+	// read all pages
+	postIds := make([]int64, 0)
+	paging := &model.Paging{Limit: 2}
+	for {
+		posts, nextCursor, err := service.GetNewsfeed(ctx, 1, paging)
+		assert.NoError(t, err)
+		for _, p := range posts {
+			postIds = append(postIds, p.Post.ID)
+		}
+		if nextCursor == nil {
+			break
+		}
+		paging.Cursor = nextCursor
+	}
+
+	assert.Equal(t, []int64{5, 4, 3, 2, 1}, postIds)
+}
+
+func TestPostService_GetNewsfeed_RemovedPost(t *testing.T) {
+	ctx := context.Background()
+
+	// post 2 is deleted but not retracted yet, post 3 is cached as a tombstone
+	mockPostCache := new(MockPostCacheDAI)
+	mockPostCache.On("GetNewsfeedItems", ctx, int64(1), int64(0), int64(0), int64(10)).Return([]*model.FeedItem{
+		{PostID: 3, Timestamp: 300},
+		{PostID: 2, Timestamp: 200},
+		{PostID: 1, Timestamp: 100},
+	}, nil)
+	mockPostCache.On("GetCachedPostsByIDs", ctx, []int64{3, 2, 1}).
+		Return([]*model.Post{{ID: 3, Removed: true}, nil, {ID: 1}}, nil)
+	mockPostCache.On("GetPostReactions", ctx, []int64{1}, int64(1)).Return(newEmptyReactions(1), nil)
+
+	mockDAI := new(MockPostDAI)
+	mockDAI.On("GetPostByID", ctx, int64(2)).Return(nil, nil)
+
+	mockUserCache := new(MockUserCacheDAI)
+	mockUserCache.On("IsFollowingsCached", ctx, int64(1)).Return(true, nil)
+	mockUserCache.On("GetFollowedHighFollowerIDs", ctx, int64(1)).Return(nil, nil)
+	mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+	mockUserCache.On("FilterBlocked", ctx, int64(1), mock.Anything).Return([]int64{}, nil)
+	mockUserCache.On("FilterMuted", ctx, int64(1), mock.Anything).Return([]int64{}, nil)
+
+	service, err := New(Config{}, mockDAI, nil, nil, mockUserCache, mockPostCache, nil, nil)
+	assert.NoError(t, err)
+
+	posts, nextCursor, err := service.GetNewsfeed(ctx, 1, &model.Paging{Limit: 10})
+
+	assert.NoError(t, err)
+	assert.Nil(t, nextCursor)
+	assert.Len(t, posts, 1)
+	assert.Equal(t, int64(1), posts[0].Post.ID)
+}
+
+func newEmptyReactions(n int) []*model.PostReactions {
+	reactions := make([]*model.PostReactions, n)
+	for i := range reactions {
+		reactions[i] = &model.PostReactions{Counts: make(map[string]int64)}
+	}
+	return reactions
+}
+
+func TestPostService_getFollowedHighFollowerIDs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("check followings in db if they are not cached", func(t *testing.T) {
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsFollowingsCached", ctx, int64(1)).Return(false, nil)
+		mockUserCache.On("GetHighFollowerIDs", ctx).Return([]int64{8, 9}, nil)
+		mockUserDAI := new(MockUserDAI)
+		mockUserDAI.On("GetFollowsBetween", ctx, int64(1), []int64{8, 9}).Return([]*model.Follow{
+			{Follower: &model.User{ID: 1}, Following: &model.User{ID: 9}, FollowTs: 1700000000},
+			{Follower: &model.User{ID: 8}, Following: &model.User{ID: 1}, FollowTs: 1700000000},
+		}, nil)
+
+		service, err := New(Config{}, nil, mockUserDAI, nil, mockUserCache, nil, nil, nil)
+		assert.NoError(t, err)
+
+		ids, err := service.getFollowedHighFollowerIDs(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{9}, ids)
+		mockUserCache.AssertNotCalled(t, "GetFollowedHighFollowerIDs", mock.Anything, mock.Anything)
+	})
+}
@@ -2,6 +2,9 @@ package post_service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"ep.k16/newsfeed/internal/common"
@@ -9,74 +12,519 @@ import (
 	"ep.k16/newsfeed/pkg/logger"
 )
 
+const (
+	maxPostContentLength = 5000
+
+	defaultNewsfeedMaxLength     = 1000
+	defaultFanoutBatchSize       = 500
+	defaultHighFollowerThreshold = 10000
+)
+
 type PostDAI interface {
+	CreatePost(ctx context.Context, post *model.Post) (*model.Post, error)
+	UpdatePost(ctx context.Context, post *model.Post) (*model.Post, error)
+	GetPostRevisions(ctx context.Context, postId int64, paging *model.Paging) ([]*model.PostRevision, error)
+	DeletePost(ctx context.Context, post *model.Post) error
+	GetPostByID(ctx context.Context, postId int64) (*model.Post, error)
+	GetPostsByUserID(ctx context.Context, userId int64, visibilities []string, paging *model.Paging) ([]*model.Post, error)
+
+	CreateMedia(ctx context.Context, media *model.Media) (*model.Media, error)
+	GetMediaByIDs(ctx context.Context, mediaIds []int64) ([]*model.Media, error)
+
+	CreateComment(ctx context.Context, comment *model.Comment) (*model.Comment, error)
+	GetCommentByID(ctx context.Context, commentId int64) (*model.Comment, error)
+	GetCommentsByPostID(ctx context.Context, postId, parentId int64, paging *model.Paging) ([]*model.Comment, error)
+	DeleteComment(ctx context.Context, comment *model.Comment) error
+
+	GetReactionsByPostID(ctx context.Context, postId int64) ([]*model.Reaction, error)
+	SaveReactions(ctx context.Context, reactions []*model.Reaction) error
 }
 
 type PostCacheDAI interface {
+	SetCachedPost(ctx context.Context, post *model.Post) error
+	AddCachedPost(ctx context.Context, post *model.Post) (bool, error)
+	GetCachedPostsByIDs(ctx context.Context, postIds []int64) ([]*model.Post, error)
+	DeleteCachedPost(ctx context.Context, postId int64) error
+
+	AddPostToNewsfeeds(ctx context.Context, userIds []int64, post *model.Post, maxLength int64) error
+	AddPostToUserPosts(ctx context.Context, post *model.Post, maxLength int64) error
+	RemovePostFromNewsfeeds(ctx context.Context, userIds []int64, postId int64) error
+	RemovePostFromUserPosts(ctx context.Context, post *model.Post) error
+	RemovePostsFromNewsfeed(ctx context.Context, userId int64, postIds []int64) error
+	GetNewsfeedItems(ctx context.Context, userId int64, maxTs int64, offset, count int64) ([]*model.FeedItem, error)
+	GetUserPostItems(ctx context.Context, userId int64, maxTs int64, offset, count int64) ([]*model.FeedItem, error)
+
+	SetReaction(ctx context.Context, postId, userId int64, reactionType string) (bool, error)
+	LoadReactions(ctx context.Context, postId int64, reactions []*model.Reaction) error
+	GetPostReactions(ctx context.Context, postIds []int64, viewerId int64) ([]*model.PostReactions, error)
+	PopDirtyReactions(ctx context.Context, count int64) ([]*model.Reaction, error)
+	AddDirtyReactions(ctx context.Context, reactions []*model.Reaction) error
+}
+
+type UserDAI interface {
+	GetByID(ctx context.Context, userId int64) (*model.User, error)
+	GetFollowerTimestamps(ctx context.Context, userId int64) (map[int64]int64, error)
+	GetFollowsBetween(ctx context.Context, userId int64, peerIds []int64) ([]*model.Follow, error)
+	GetCounts(ctx context.Context, userId int64) (*model.UserCounts, error)
+}
+
+type AudienceDAI interface {
+	GetAudienceMembersBetween(ctx context.Context, userId int64, list string, peerIds []int64) ([]*model.AudienceMember, error)
+	GetAudienceMembersOfUser(ctx context.Context, userId int64) ([]*model.AudienceMember, error)
 }
 
 type UserCacheDAI interface {
+	IsFollowersCached(ctx context.Context, userId int64) (bool, error)
+	LockFollowersRebuild(ctx context.Context, userId int64) (string, error)
+	SetCachedFollowers(ctx context.Context, userId int64, token string, followTsById map[int64]int64) (bool, error)
+	GetFollowerIDs(ctx context.Context, userId int64, offset, limit int64) ([]int64, error)
+	CountFollowers(ctx context.Context, userId int64) (int64, error)
+
+	AddHighFollowerUser(ctx context.Context, userId int64) error
+	IsHighFollowerUser(ctx context.Context, userId int64) (bool, error)
+	GetHighFollowerIDs(ctx context.Context) ([]int64, error)
+	GetFollowedHighFollowerIDs(ctx context.Context, userId int64) ([]int64, error)
+
+	// followings set is authoritative only if IsFollowingsCached is true
+	IsFollowingsCached(ctx context.Context, userId int64) (bool, error)
+	GetFollowTimestamps(ctx context.Context, userId int64, followingIds []int64) (map[int64]int64, error)
+
+	// audience sets are authoritative only if IsAudienceCached is true
+	IsAudienceCached(ctx context.Context, userId int64) (bool, error)
+	LockAudienceRebuild(ctx context.Context, userId int64) (string, error)
+	SetCachedAudience(ctx context.Context, userId int64, token string, members []*model.AudienceMember) (bool, error)
+	FilterCloseFriends(ctx context.Context, ownerId int64, userIds []int64) ([]int64, error)
+	FilterBlocked(ctx context.Context, userId int64, userIds []int64) ([]int64, error)
+	FilterMuted(ctx context.Context, userId int64, userIds []int64) ([]int64, error)
+	FilterMutedBy(ctx context.Context, userId int64, userIds []int64) ([]int64, error)
+
+	DeleteCachedCounts(ctx context.Context, userIds ...int64) error
 }
 
 type PostMsgProducer interface {
 	SendPost(ctx context.Context, post *model.Post) error
+	SendPostDeletion(ctx context.Context, post *model.Post) error
+}
+
+type Config struct {
+	NewsfeedMaxLength int64 // max number of posts kept in each cached newsfeed
+	FanoutBatchSize   int64 // number of followers loaded per batch when fanning out a post
+
+	// posts of users having at least this number of followers are not pushed to newsfeeds of followers,
+	// followers pull them when reading newsfeed instead
+	HighFollowerThreshold int64
+
+	DefaultRanking string // ranker used when request does not select one, default chronological
+
+	MaxMediaSize  int64 // max size in bytes of an uploaded media
+	ThumbnailSize int   // max width and height of media thumbnails
 }
 
 type PostService struct {
+	cfg Config
+
 	dai             PostDAI
+	userDai         UserDAI
+	audienceDai     AudienceDAI
 	userCacheDai    UserCacheDAI
 	postCacheDai    PostCacheDAI
 	postMsgProducer PostMsgProducer
+	blobStore       BlobStore
+
+	rankers map[string]Ranker
 }
 
-func New(postDai PostDAI, userCacheDai UserCacheDAI, postCacheDai PostCacheDAI, postMsgProducer PostMsgProducer) (*PostService, error) {
+func New(cfg Config, postDai PostDAI, userDai UserDAI, audienceDai AudienceDAI, userCacheDai UserCacheDAI, postCacheDai PostCacheDAI, postMsgProducer PostMsgProducer, blobStore BlobStore) (*PostService, error) {
+	if cfg.NewsfeedMaxLength <= 0 {
+		cfg.NewsfeedMaxLength = defaultNewsfeedMaxLength
+	}
+	if cfg.FanoutBatchSize <= 0 {
+		cfg.FanoutBatchSize = defaultFanoutBatchSize
+	}
+	if cfg.HighFollowerThreshold <= 0 {
+		cfg.HighFollowerThreshold = defaultHighFollowerThreshold
+	}
+	if len(cfg.DefaultRanking) == 0 {
+		cfg.DefaultRanking = RankingChronological
+	}
+	if cfg.MaxMediaSize <= 0 {
+		cfg.MaxMediaSize = defaultMaxMediaSize
+	}
+	if cfg.ThumbnailSize <= 0 {
+		cfg.ThumbnailSize = defaultThumbnailSize
+	}
+
 	svc := &PostService{
+		cfg:             cfg,
 		dai:             postDai,
+		userDai:         userDai,
+		audienceDai:     audienceDai,
 		userCacheDai:    userCacheDai,
 		postCacheDai:    postCacheDai,
 		postMsgProducer: postMsgProducer,
+		blobStore:       blobStore,
+		rankers:         make(map[string]Ranker),
+	}
+	svc.registerBuiltinRankers()
+
+	if _, ok := svc.rankers[cfg.DefaultRanking]; !ok {
+		return nil, fmt.Errorf("unknown default ranking: %s", cfg.DefaultRanking)
 	}
 
 	return svc, nil
 }
 
 func (s *PostService) CreatePost(ctx context.Context, post *model.Post) (*model.Post, error) {
-	// 1. validate grpc
-	// 2. validate post
-	// 3. create post into database
-	// 4. send msg to kafka: s.postMsgProducer.SendPost(ctx, post)
+	if post != nil && len(post.Visibility) == 0 {
+		post.Visibility = model.VisibilityPublic
+	}
+	if err := validatePost(post); err != nil {
+		return nil, common.WrapError(common.CodeInvalidRequest, "invalid post", err)
+	}
+	mediaList, err := s.getAttachableMedia(ctx, post)
+	if err != nil {
+		return nil, err
+	}
+
+	post.CreatedTimestamp = time.Now().Unix()
+	createdPost, err := s.dai.CreatePost(ctx, post)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	for _, media := range mediaList {
+		media.PostID = createdPost.ID
+	}
+	createdPost.Media = mediaList
+	s.setPostMediaURLs(createdPost)
+
+	// post count is changed in db, the cached one is reloaded on next read
+	if err := s.userCacheDai.DeleteCachedCounts(ctx, createdPost.UserID); err != nil {
+		logger.Error("failed to delete cached counts", logger.E(err), logger.F("user_id", createdPost.UserID))
+	}
+
+	// newsfeed is built asynchronously by newsfeed worker, so failing to send msg should not fail the request
+	if err := s.postMsgProducer.SendPost(ctx, createdPost); err != nil {
+		logger.Error("failed to send post", logger.E(err), logger.F("post_id", createdPost.ID))
+	}
+
+	return createdPost, nil
+}
+
+// UpdatePost changes content of a post of post.UserID, previous content is kept as a revision
+func (s *PostService) UpdatePost(ctx context.Context, post *model.Post) (*model.Post, error) {
+	if post == nil || post.ID <= 0 {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid post id")
+	}
+
+	currentPost, err := s.getExistingPost(ctx, post.ID)
+	if err != nil {
+		return nil, err
+	}
+	if currentPost.UserID != post.UserID {
+		return nil, common.NewError(common.CodeForbidden, "not allowed to edit post")
+	}
+
+	// media and visibility cannot be edited, but media makes empty content valid
+	post.MediaIDs = currentPost.MediaIDs
+	post.Visibility = getVisibility(currentPost)
+	if err := validatePost(post); err != nil {
+		return nil, common.WrapError(common.CodeInvalidRequest, "invalid post", err)
+	}
+	if post.Content == currentPost.Content { // nothing changes, do not add a revision
+		s.setPostMediaURLs(currentPost)
+		return currentPost, nil
+	}
+
+	post.EditedTimestamp = time.Now().Unix()
+	updatedPost, err := s.dai.UpdatePost(ctx, post)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if updatedPost == nil { // removed while editing
+		return nil, common.NewError(common.CodeNotFound, "post not found")
+	}
+
+	// newsfeeds only keep post ids, so refreshing the cached post updates all of them
+	if err := s.postCacheDai.SetCachedPost(ctx, updatedPost); err != nil {
+		logger.Error("failed to refresh cached post", logger.E(err), logger.F("post_id", updatedPost.ID))
+	}
+
+	s.setPostMediaURLs(updatedPost)
+	return updatedPost, nil
+}
+
+// DeletePost removes a post of userId, newsfeed worker retracts it from newsfeeds of followers asynchronously
+func (s *PostService) DeletePost(ctx context.Context, userId, postId int64) error {
+	post, err := s.getExistingPost(ctx, postId)
+	if err != nil {
+		return err
+	}
+	if post.UserID != userId {
+		return common.NewError(common.CodeForbidden, "not allowed to delete post")
+	}
+
+	if err := s.dai.DeletePost(ctx, post); err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	if err := s.userCacheDai.DeleteCachedCounts(ctx, post.UserID); err != nil {
+		logger.Error("failed to delete cached counts", logger.E(err), logger.F("user_id", post.UserID))
+	}
 
-	// test producer
-	if err := s.postMsgProducer.SendPost(ctx, &model.Post{
-		ID:               9999,
-		UserID:           9999,
-		Content:          "demo",
-		CreatedTimestamp: time.Now().Unix(),
-	}); err != nil {
-		logger.Error("failed to send post", logger.E(err))
-	} else {
-		logger.Debug("send post ok")
+	// newsfeeds still keep the post id until it is retracted, the tombstone hides it from readers meanwhile
+	post.Removed = true
+	if err := s.postCacheDai.SetCachedPost(ctx, post); err != nil {
+		logger.Error("failed to mark cached post removed", logger.E(err), logger.F("post_id", post.ID))
 	}
-	return nil, nil
+
+	if err := s.postMsgProducer.SendPostDeletion(ctx, post); err != nil {
+		logger.Error("failed to send post deletion", logger.E(err), logger.F("post_id", post.ID))
+	}
+	return nil
 }
 
-func (s *PostService) GetPostByUserID(ctx context.Context, userId int, paging model.Paging) ([]*model.Post, error) {
-	// flow is same as get followings:
-	// 1. get post_ids by page
-	// 2. get post models by post_ids
-	return nil, common.NewError(common.CodeNotImplemented, "Not Implemented")
+// ListPostRevisions returns previous versions of a post, newest first, only its author can see them.
+// paging.Cursor holds the last revision id of the previous page, nil means the first page.
+func (s *PostService) ListPostRevisions(ctx context.Context, userId, postId int64, paging *model.Paging) ([]*model.PostRevision, error) {
+	if paging.Limit <= 0 {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid limit")
+	}
+
+	post, err := s.getExistingPost(ctx, postId)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID != userId {
+		return nil, common.NewError(common.CodeForbidden, "not allowed to see post revisions")
+	}
+
+	revisions, err := s.dai.GetPostRevisions(ctx, postId, paging)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	return revisions, nil
 }
 
-func (s *PostService) GetNewsfeed(ctx context.Context, userId int, paging model.Paging) ([]*model.Post, error) {
-	// flow is same as get followings
-	// 1. get post_ids by page
-	// 2. get post models by post_ids
-	return nil, common.NewError(common.CodeNotImplemented, "Not Implemented")
+// GetPostByUserID returns posts of a user seen by viewer, newest first.
+// Posts not shared with viewer are filtered in db, so pages are still full.
+// Posts of a private user are only seen by its approved followers.
+// paging.Cursor holds the last post id of the previous page, nil means the first page.
+func (s *PostService) GetPostByUserID(ctx context.Context, viewerId, userId int64, paging *model.Paging) ([]*model.Post, error) {
+	if paging.Limit <= 0 {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid limit")
+	}
+
+	if viewerId != userId {
+		blockedIds, err := s.filterBlocked(ctx, viewerId, []int64{userId})
+		if err != nil {
+			return nil, err
+		}
+		if len(blockedIds) > 0 {
+			return nil, common.NewError(common.CodeNotExistedUserID, "user_id is not existed")
+		}
+	}
+
+	visibilities, err := s.getAllowedVisibilities(ctx, viewerId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(visibilities, model.VisibilityFollowers) {
+		// viewer is neither the user nor a follower, public posts are hidden too if the user is private
+		user, err := s.userDai.GetByID(ctx, userId)
+		if err != nil {
+			return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+		}
+		if user == nil {
+			return nil, common.NewError(common.CodeNotExistedUserID, "user_id is not existed")
+		}
+		if user.IsPrivate {
+			return nil, common.NewError(common.CodeForbidden, "user is private")
+		}
+	}
+
+	posts, err := s.dai.GetPostsByUserID(ctx, userId, visibilities, paging)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	s.setPostMediaURLs(posts...)
+
+	if err := s.setPostReactions(ctx, viewerId, posts); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+func validatePost(post *model.Post) error {
+	if post == nil {
+		return errors.New("post is required")
+	}
+	if post.UserID <= 0 {
+		return errors.New("user_id is required")
+	}
+	if len(post.Content) == 0 && len(post.MediaIDs) == 0 {
+		return errors.New("content or media is required")
+	}
+	if len(post.Content) > maxPostContentLength {
+		return errors.New("content is too long")
+	}
+	if !model.IsValidVisibility(post.Visibility) {
+		return errors.New("invalid visibility")
+	}
+	return validatePostMediaIDs(post.MediaIDs)
 }
 
+// AppendPostToNewsfeed fans out a new post to newsfeeds of all followers of its author (fan-out on write).
+// Posts shared with close friends only reach followers in close friends list of the author.
+// Followers muting the author are skipped.
+// Posts of high-follower users are not fanned out, they are pulled by GetNewsfeed instead.
 func (s *PostService) AppendPostToNewsfeed(ctx context.Context, post *model.Post) error {
-	// 1. create cached post: grpc:<user_id>:post:<post_id>
-	// 2. get all follower_ids from cache key grpc:<post_user_id>:followers (should get by batch)
-	// 3. add post_id + timestamp to sorted set grpc:<followerid>:newsfeed
-	return common.NewError(common.CodeNotImplemented, "Not Implemented")
+	if post == nil || post.ID <= 0 || post.UserID <= 0 {
+		return common.NewError(common.CodeInvalidRequest, "invalid post")
+	}
+
+	// 1. cache post, so newsfeed readers can load it without hitting db.
+	// The post may have been edited or commented since the msg was sent, then the newer cached post is kept.
+	if _, err := s.postCacheDai.AddCachedPost(ctx, post); err != nil {
+		return common.WrapError(common.CodeInternal, "failed to cache post", err)
+	}
+	if err := s.postCacheDai.AddPostToUserPosts(ctx, post, s.cfg.NewsfeedMaxLength); err != nil {
+		return common.WrapError(common.CodeInternal, "failed to cache user posts", err)
+	}
+
+	isHighFollower, err := s.checkHighFollowerUser(ctx, post.UserID)
+	if err != nil {
+		return err
+	}
+	if isHighFollower {
+		logger.Debug("skip fanning out post of high-follower user", logger.F("post_id", post.ID), logger.F("user_id", post.UserID))
+		return nil
+	}
+
+	// 2. get follower ids by batch, 3. add post to newsfeed of each follower
+	numFollowers, err := s.forEachFollowerBatch(ctx, post.UserID, func(followerIds []int64) error {
+		receiverIds := followerIds
+		var err error
+		if getVisibility(post) == model.VisibilityCloseFriends {
+			receiverIds, err = s.filterCloseFriends(ctx, post.UserID, followerIds)
+			if err != nil {
+				return err
+			}
+		}
+		receiverIds, err = s.excludeMutingFollowers(ctx, post.UserID, receiverIds)
+		if err != nil {
+			return err
+		}
+
+		err = s.postCacheDai.AddPostToNewsfeeds(ctx, receiverIds, post, s.cfg.NewsfeedMaxLength)
+		if err != nil {
+			return common.WrapError(common.CodeInternal, "failed to add post to newsfeeds", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("appended post to newsfeeds", logger.F("post_id", post.ID), logger.F("followers", numFollowers))
+	return nil
+}
+
+// RemovePostFromNewsfeed retracts a deleted post from newsfeeds of all followers of its author and from cache.
+// Followers are always visited, because the author may have become high-follower after the post was pushed.
+func (s *PostService) RemovePostFromNewsfeed(ctx context.Context, post *model.Post) error {
+	if post == nil || post.ID <= 0 || post.UserID <= 0 {
+		return common.NewError(common.CodeInvalidRequest, "invalid post")
+	}
+
+	if err := s.postCacheDai.RemovePostFromUserPosts(ctx, post); err != nil {
+		return common.WrapError(common.CodeInternal, "failed to remove post from user posts", err)
+	}
+
+	numFollowers, err := s.forEachFollowerBatch(ctx, post.UserID, func(followerIds []int64) error {
+		if err := s.postCacheDai.RemovePostFromNewsfeeds(ctx, followerIds, post.ID); err != nil {
+			return common.WrapError(common.CodeInternal, "failed to remove post from newsfeeds", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// delete cached post last, so readers of not-yet-cleaned newsfeeds still see its tombstone
+	if err := s.postCacheDai.DeleteCachedPost(ctx, post.ID); err != nil {
+		return common.WrapError(common.CodeInternal, "failed to delete cached post", err)
+	}
+
+	logger.Debug("removed post from newsfeeds", logger.F("post_id", post.ID), logger.F("followers", numFollowers))
+	return nil
+}
+
+// RemoveUserPostsFromNewsfeed purges posts of author from newsfeed of user after user unfollows author.
+// Only cached posts of author can be in the newsfeed, because both are trimmed to the newest posts.
+func (s *PostService) RemoveUserPostsFromNewsfeed(ctx context.Context, userId, authorId int64) error {
+	if userId <= 0 || authorId <= 0 {
+		return common.NewError(common.CodeInvalidRequest, "invalid user id")
+	}
+
+	// user may follow author again before the unfollow is processed, then posts must stay
+	followTsByAuthor, err := s.getFollowTimestamps(ctx, userId, []int64{authorId})
+	if err != nil {
+		return err
+	}
+	if _, ok := followTsByAuthor[authorId]; ok {
+		logger.Debug("skip purging posts of followed user", logger.F("user_id", userId), logger.F("author_id", authorId))
+		return nil
+	}
+
+	var offset int64
+	for {
+		items, err := s.postCacheDai.GetUserPostItems(ctx, authorId, 0, offset, s.cfg.FanoutBatchSize)
+		if err != nil {
+			return common.WrapError(common.CodeInternal, "failed to get user posts from cache", err)
+		}
+		if len(items) == 0 {
+			break
+		}
+
+		postIds := make([]int64, len(items))
+		for i := range items {
+			postIds[i] = items[i].PostID
+		}
+		if err := s.postCacheDai.RemovePostsFromNewsfeed(ctx, userId, postIds); err != nil {
+			return common.WrapError(common.CodeInternal, "failed to remove posts from newsfeed", err)
+		}
+
+		offset += int64(len(items))
+		if int64(len(items)) < s.cfg.FanoutBatchSize {
+			break
+		}
+	}
+
+	logger.Debug("removed user posts from newsfeed", logger.F("user_id", userId), logger.F("author_id", authorId), logger.F("posts", offset))
+	return nil
+}
+
+// checkHighFollowerUser checks if posts of user should be pulled instead of pushed.
+// Once marked, a user stays high-follower, so its older posts which were not pushed are still pulled by followers.
+func (s *PostService) checkHighFollowerUser(ctx context.Context, userId int64) (bool, error) {
+	isHighFollower, err := s.userCacheDai.IsHighFollowerUser(ctx, userId)
+	if err != nil {
+		return false, common.WrapError(common.CodeInternal, "failed to check high-follower user", err)
+	}
+	if isHighFollower {
+		return true, nil
+	}
+
+	numFollowers, err := s.countFollowers(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+	if numFollowers < s.cfg.HighFollowerThreshold {
+		return false, nil
+	}
+
+	if err := s.userCacheDai.AddHighFollowerUser(ctx, userId); err != nil {
+		return false, common.WrapError(common.CodeInternal, "failed to mark high-follower user", err)
+	}
+	return true, nil
 }
@@ -0,0 +1,401 @@
+package post_service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func assertAppError(t *testing.T, err error, errCode common.ErrorCode) {
+	appError, ok := err.(*common.AppError)
+	assert.True(t, ok)
+	assert.NotNil(t, appError)
+	assert.Equal(t, appError.Code, errCode)
+}
+
+func TestPostService_CreatePost(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("empty content", func(t *testing.T) {
+		service, err := New(Config{}, new(MockPostDAI), nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.CreatePost(ctx, &model.Post{UserID: 1})
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+
+	t.Run("success even if sending msg failed", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("CreatePost", ctx, mock.AnythingOfType("*model.Post")).
+			Return(&model.Post{ID: 10, UserID: 1, Content: "hello", CreatedTimestamp: 1700000000}, nil)
+		mockProducer := new(MockPostMsgProducer)
+		mockProducer.On("SendPost", ctx, mock.AnythingOfType("*model.Post")).Return(errors.New("kafka down"))
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("DeleteCachedCounts", ctx, []int64{1}).Return(nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, mockUserCache, nil, mockProducer, nil)
+		assert.NoError(t, err)
+
+		res, err := service.CreatePost(ctx, &model.Post{UserID: 1, Content: "hello"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), res.ID)
+		mockProducer.AssertExpectations(t)
+		mockUserCache.AssertExpectations(t)
+	})
+}
+
+func TestPostService_AppendPostToNewsfeed(t *testing.T) {
+	ctx := context.Background()
+	post := &model.Post{ID: 10, UserID: 1, Content: "hello", CreatedTimestamp: 1700000000}
+
+	t.Run("fan out by batch", func(t *testing.T) {
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("AddCachedPost", ctx, post).Return(true, nil)
+		mockPostCache.On("AddPostToUserPosts", ctx, post, int64(100)).Return(nil)
+		mockPostCache.On("AddPostToNewsfeeds", ctx, []int64{2, 3}, post, int64(100)).Return(nil).Once()
+		mockPostCache.On("AddPostToNewsfeeds", ctx, []int64{4}, post, int64(100)).Return(nil).Once()
+
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsHighFollowerUser", ctx, int64(1)).Return(false, nil)
+		mockUserCache.On("IsFollowersCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("CountFollowers", ctx, int64(1)).Return(int64(3), nil)
+		mockUserCache.On("GetFollowerIDs", ctx, int64(1), int64(0), int64(2)).Return([]int64{2, 3}, nil)
+		mockUserCache.On("GetFollowerIDs", ctx, int64(1), int64(2), int64(2)).Return([]int64{4}, nil)
+		mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("FilterMutedBy", ctx, int64(1), []int64{2, 3}).Return([]int64{}, nil)
+		mockUserCache.On("FilterMutedBy", ctx, int64(1), []int64{4}).Return([]int64{}, nil)
+
+		service, err := New(Config{NewsfeedMaxLength: 100, FanoutBatchSize: 2}, nil, nil, nil, mockUserCache, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.AppendPostToNewsfeed(ctx, post)
+
+		assert.NoError(t, err)
+		mockPostCache.AssertExpectations(t)
+		mockUserCache.AssertExpectations(t)
+	})
+
+	t.Run("keep post cached by an edit and still fan out", func(t *testing.T) {
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("AddCachedPost", ctx, post).Return(false, nil)
+		mockPostCache.On("AddPostToUserPosts", ctx, post, int64(defaultNewsfeedMaxLength)).Return(nil)
+		mockPostCache.On("AddPostToNewsfeeds", ctx, []int64{2}, post, int64(defaultNewsfeedMaxLength)).Return(nil)
+
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsHighFollowerUser", ctx, int64(1)).Return(false, nil)
+		mockUserCache.On("IsFollowersCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("CountFollowers", ctx, int64(1)).Return(int64(1), nil)
+		mockUserCache.On("GetFollowerIDs", ctx, int64(1), int64(0), int64(defaultFanoutBatchSize)).Return([]int64{2}, nil)
+		mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("FilterMutedBy", ctx, int64(1), []int64{2}).Return([]int64{}, nil)
+
+		service, err := New(Config{}, nil, nil, nil, mockUserCache, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.AppendPostToNewsfeed(ctx, post)
+
+		assert.NoError(t, err)
+		mockPostCache.AssertNotCalled(t, "SetCachedPost", mock.Anything, mock.Anything)
+		mockPostCache.AssertExpectations(t)
+	})
+
+	t.Run("skip fan out for high-follower user", func(t *testing.T) {
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("AddCachedPost", ctx, post).Return(true, nil)
+		mockPostCache.On("AddPostToUserPosts", ctx, post, int64(defaultNewsfeedMaxLength)).Return(nil)
+
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsHighFollowerUser", ctx, int64(1)).Return(false, nil)
+		mockUserCache.On("IsFollowersCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("CountFollowers", ctx, int64(1)).Return(int64(5), nil)
+		mockUserCache.On("AddHighFollowerUser", ctx, int64(1)).Return(nil)
+
+		service, err := New(Config{HighFollowerThreshold: 5}, nil, nil, nil, mockUserCache, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.AppendPostToNewsfeed(ctx, post)
+
+		assert.NoError(t, err)
+		mockPostCache.AssertNotCalled(t, "AddPostToNewsfeeds", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockUserCache.AssertExpectations(t)
+	})
+
+	t.Run("rebuild partial followers before fan out", func(t *testing.T) {
+		followTsById := map[int64]int64{2: 1700000100, 3: 1700000200}
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("AddCachedPost", ctx, post).Return(true, nil)
+		mockPostCache.On("AddPostToUserPosts", ctx, post, int64(defaultNewsfeedMaxLength)).Return(nil)
+		mockPostCache.On("AddPostToNewsfeeds", ctx, []int64{2, 3}, post, int64(defaultNewsfeedMaxLength)).Return(nil).Once()
+
+		mockUserDAI := new(MockUserDAI)
+		mockUserDAI.On("GetCounts", ctx, int64(1)).Return(&model.UserCounts{Followers: 2}, nil)
+		mockUserDAI.On("GetFollowerTimestamps", ctx, int64(1)).Return(followTsById, nil)
+
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsHighFollowerUser", ctx, int64(1)).Return(false, nil)
+		mockUserCache.On("IsFollowersCached", ctx, int64(1)).Return(false, nil)
+		mockUserCache.On("LockFollowersRebuild", ctx, int64(1)).Return("token", nil)
+		mockUserCache.On("SetCachedFollowers", ctx, int64(1), "token", followTsById).Return(true, nil)
+		mockUserCache.On("GetFollowerIDs", ctx, int64(1), int64(0), int64(defaultFanoutBatchSize)).Return([]int64{2, 3}, nil)
+		mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("FilterMutedBy", ctx, int64(1), []int64{2, 3}).Return([]int64{}, nil)
+
+		service, err := New(Config{}, nil, mockUserDAI, nil, mockUserCache, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.AppendPostToNewsfeed(ctx, post)
+
+		assert.NoError(t, err)
+		mockPostCache.AssertExpectations(t)
+		mockUserCache.AssertExpectations(t)
+		mockUserCache.AssertNotCalled(t, "CountFollowers", mock.Anything, mock.Anything)
+	})
+
+	t.Run("count followers in db while cached followers are partial", func(t *testing.T) {
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("AddCachedPost", ctx, post).Return(true, nil)
+		mockPostCache.On("AddPostToUserPosts", ctx, post, int64(defaultNewsfeedMaxLength)).Return(nil)
+
+		mockUserDAI := new(MockUserDAI)
+		mockUserDAI.On("GetCounts", ctx, int64(1)).Return(&model.UserCounts{Followers: 5}, nil)
+
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsHighFollowerUser", ctx, int64(1)).Return(false, nil)
+		mockUserCache.On("IsFollowersCached", ctx, int64(1)).Return(false, nil)
+		mockUserCache.On("AddHighFollowerUser", ctx, int64(1)).Return(nil)
+
+		service, err := New(Config{HighFollowerThreshold: 5}, nil, mockUserDAI, nil, mockUserCache, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.AppendPostToNewsfeed(ctx, post)
+
+		assert.NoError(t, err)
+		mockPostCache.AssertNotCalled(t, "AddPostToNewsfeeds", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockUserCache.AssertExpectations(t)
+	})
+
+	t.Run("cache error", func(t *testing.T) {
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("AddCachedPost", ctx, post).Return(false, errors.New("redis down"))
+
+		service, err := New(Config{}, nil, nil, nil, nil, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.AppendPostToNewsfeed(ctx, post)
+
+		assertAppError(t, err, common.CodeInternal)
+	})
+}
+
+func TestPostService_RemovePostFromNewsfeed(t *testing.T) {
+	ctx := context.Background()
+	post := &model.Post{ID: 10, UserID: 1, Removed: true}
+
+	t.Run("retract by batch", func(t *testing.T) {
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("RemovePostFromUserPosts", ctx, post).Return(nil)
+		mockPostCache.On("RemovePostFromNewsfeeds", ctx, []int64{2, 3}, int64(10)).Return(nil).Once()
+		mockPostCache.On("RemovePostFromNewsfeeds", ctx, []int64{4}, int64(10)).Return(nil).Once()
+		mockPostCache.On("DeleteCachedPost", ctx, int64(10)).Return(nil)
+
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsFollowersCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("GetFollowerIDs", ctx, int64(1), int64(0), int64(2)).Return([]int64{2, 3}, nil)
+		mockUserCache.On("GetFollowerIDs", ctx, int64(1), int64(2), int64(2)).Return([]int64{4}, nil)
+
+		service, err := New(Config{FanoutBatchSize: 2}, nil, nil, nil, mockUserCache, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.RemovePostFromNewsfeed(ctx, post)
+
+		assert.NoError(t, err)
+		mockPostCache.AssertExpectations(t)
+		mockUserCache.AssertExpectations(t)
+	})
+
+	t.Run("read followers from db while cache is rebuilt", func(t *testing.T) {
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("RemovePostFromUserPosts", ctx, post).Return(nil)
+		mockPostCache.On("RemovePostFromNewsfeeds", ctx, []int64{2, 3}, int64(10)).Return(nil).Once()
+		mockPostCache.On("RemovePostFromNewsfeeds", ctx, []int64{4}, int64(10)).Return(nil).Once()
+		mockPostCache.On("DeleteCachedPost", ctx, int64(10)).Return(nil)
+
+		mockUserDAI := new(MockUserDAI)
+		mockUserDAI.On("GetFollowerTimestamps", ctx, int64(1)).Return(map[int64]int64{4: 1700000300, 3: 1700000200, 2: 1700000100}, nil)
+
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsFollowersCached", ctx, int64(1)).Return(false, nil)
+		mockUserCache.On("LockFollowersRebuild", ctx, int64(1)).Return("", nil)
+
+		service, err := New(Config{FanoutBatchSize: 2}, nil, mockUserDAI, nil, mockUserCache, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.RemovePostFromNewsfeed(ctx, post)
+
+		assert.NoError(t, err)
+		mockPostCache.AssertExpectations(t)
+		mockUserCache.AssertNotCalled(t, "GetFollowerIDs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("keep cached post if newsfeeds are not cleaned", func(t *testing.T) {
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("RemovePostFromUserPosts", ctx, post).Return(nil)
+
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsFollowersCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("GetFollowerIDs", ctx, int64(1), int64(0), int64(defaultFanoutBatchSize)).Return(nil, errors.New("redis down"))
+
+		service, err := New(Config{}, nil, nil, nil, mockUserCache, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.RemovePostFromNewsfeed(ctx, post)
+
+		assertAppError(t, err, common.CodeInternal)
+		mockPostCache.AssertNotCalled(t, "DeleteCachedPost", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostService_RemoveUserPostsFromNewsfeed(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("purge by batch", func(t *testing.T) {
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsFollowingsCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("GetFollowTimestamps", ctx, int64(1), []int64{9}).Return(map[int64]int64{}, nil)
+
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("GetUserPostItems", ctx, int64(9), int64(0), int64(0), int64(2)).
+			Return([]*model.FeedItem{{PostID: 30, Timestamp: 300}, {PostID: 20, Timestamp: 200}}, nil)
+		mockPostCache.On("GetUserPostItems", ctx, int64(9), int64(0), int64(2), int64(2)).
+			Return([]*model.FeedItem{{PostID: 10, Timestamp: 100}}, nil)
+		mockPostCache.On("RemovePostsFromNewsfeed", ctx, int64(1), []int64{30, 20}).Return(nil).Once()
+		mockPostCache.On("RemovePostsFromNewsfeed", ctx, int64(1), []int64{10}).Return(nil).Once()
+
+		service, err := New(Config{FanoutBatchSize: 2}, nil, nil, nil, mockUserCache, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.RemoveUserPostsFromNewsfeed(ctx, 1, 9)
+
+		assert.NoError(t, err)
+		mockPostCache.AssertExpectations(t)
+	})
+
+	t.Run("followed again", func(t *testing.T) {
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsFollowingsCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("GetFollowTimestamps", ctx, int64(1), []int64{9}).Return(map[int64]int64{9: 1700000000}, nil)
+		mockPostCache := new(MockPostCacheDAI)
+
+		service, err := New(Config{}, nil, nil, nil, mockUserCache, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.RemoveUserPostsFromNewsfeed(ctx, 1, 9)
+
+		assert.NoError(t, err)
+		mockPostCache.AssertNotCalled(t, "RemovePostsFromNewsfeed", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostService_DeletePost(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not the author", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(&model.Post{ID: 10, UserID: 1}, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		err = service.DeletePost(ctx, 2, 10)
+
+		assertAppError(t, err, common.CodeForbidden)
+		mockDAI.AssertNotCalled(t, "DeletePost", mock.Anything, mock.Anything)
+	})
+
+	t.Run("mark cached post removed, drop cached counts and send deletion", func(t *testing.T) {
+		post := &model.Post{ID: 10, UserID: 1}
+		isRemoved := mock.MatchedBy(func(p *model.Post) bool { return p.ID == 10 && p.Removed })
+
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+		mockDAI.On("DeletePost", ctx, post).Return(nil)
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("SetCachedPost", ctx, isRemoved).Return(nil)
+		mockProducer := new(MockPostMsgProducer)
+		mockProducer.On("SendPostDeletion", ctx, isRemoved).Return(nil)
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("DeleteCachedCounts", ctx, []int64{1}).Return(nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, mockUserCache, mockPostCache, mockProducer, nil)
+		assert.NoError(t, err)
+
+		err = service.DeletePost(ctx, 1, 10)
+
+		assert.NoError(t, err)
+		mockDAI.AssertExpectations(t)
+		mockPostCache.AssertExpectations(t)
+		mockProducer.AssertExpectations(t)
+		mockUserCache.AssertExpectations(t)
+	})
+}
+
+func TestPostService_UpdatePost(t *testing.T) {
+	ctx := context.Background()
+	post := &model.Post{ID: 10, UserID: 1, Content: "hello world", CreatedTimestamp: 1700000000}
+
+	t.Run("not the author", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.UpdatePost(ctx, &model.Post{ID: 10, UserID: 2, Content: "hacked"})
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeForbidden)
+	})
+
+	t.Run("content is unchanged", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.UpdatePost(ctx, &model.Post{ID: 10, UserID: 1, Content: "hello world"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, post, res)
+		mockDAI.AssertNotCalled(t, "UpdatePost", mock.Anything, mock.Anything)
+	})
+
+	t.Run("update and refresh cached post", func(t *testing.T) {
+		updatedPost := &model.Post{ID: 10, UserID: 1, Content: "hello again", CreatedTimestamp: 1700000000, EditedTimestamp: 1700000100}
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+		mockDAI.On("UpdatePost", ctx, mock.MatchedBy(func(p *model.Post) bool {
+			return p.ID == 10 && p.Content == "hello again" && p.EditedTimestamp > 0
+		})).Return(updatedPost, nil)
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("SetCachedPost", ctx, updatedPost).Return(nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, nil, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.UpdatePost(ctx, &model.Post{ID: 10, UserID: 1, Content: "hello again"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, updatedPost, res)
+		mockPostCache.AssertExpectations(t)
+	})
+}
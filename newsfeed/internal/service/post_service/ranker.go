@@ -0,0 +1,147 @@
+package post_service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+const (
+	RankingChronological = "chronological"
+	RankingEngagement    = "engagement"
+	RankingAffinity      = "affinity"
+
+	defaultRankingHalfLife = 24 * time.Hour
+	commentWeight          = 2.0 // a comment takes more effort than a reaction
+)
+
+// Ranker scores posts of a newsfeed page, posts with higher score are shown first.
+// Ranking only reorders posts inside a page, so cursor paging over the chronological newsfeed still works.
+type Ranker interface {
+	Name() string
+	Score(ctx context.Context, userId int64, posts []*model.Post) ([]float64, error)
+}
+
+// RegisterRanker adds a ranker, which can be selected by its name per request or by config
+func (s *PostService) RegisterRanker(ranker Ranker) {
+	s.rankers[ranker.Name()] = ranker
+}
+
+func (s *PostService) registerBuiltinRankers() {
+	s.RegisterRanker(&chronologicalRanker{})
+	s.RegisterRanker(&engagementRanker{
+		halfLife: defaultRankingHalfLife,
+		now:      time.Now,
+	})
+	s.RegisterRanker(&affinityRanker{
+		getFollowTimestamps: s.getFollowTimestamps,
+		halfLife:            defaultRankingHalfLife,
+		now:                 time.Now,
+	})
+}
+
+// rankPosts sorts posts by score desc, posts having the same score keep their chronological order
+func rankPosts(ctx context.Context, ranker Ranker, userId int64, posts []*model.Post) ([]*model.RankedPost, error) {
+	scores, err := ranker.Score(ctx, userId, posts)
+	if err != nil {
+		return nil, err
+	}
+
+	rankedPosts := make([]*model.RankedPost, len(posts))
+	for i := range posts {
+		rankedPosts[i] = &model.RankedPost{
+			Post:  posts[i],
+			Score: scores[i],
+		}
+	}
+	sort.SliceStable(rankedPosts, func(i, j int) bool {
+		return rankedPosts[i].Score > rankedPosts[j].Score
+	})
+	return rankedPosts, nil
+}
+
+// freshness decays from 1 to 0 by post age, it halves after every halfLife
+func freshness(createdTs int64, now time.Time, halfLife time.Duration) float64 {
+	age := now.Sub(time.Unix(createdTs, 0))
+	if age < 0 {
+		age = 0
+	}
+	return math.Pow(0.5, float64(age)/float64(halfLife))
+}
+
+// chronologicalRanker shows newest posts first
+type chronologicalRanker struct{}
+
+func (r *chronologicalRanker) Name() string {
+	return RankingChronological
+}
+
+func (r *chronologicalRanker) Score(ctx context.Context, userId int64, posts []*model.Post) ([]float64, error) {
+	scores := make([]float64, len(posts))
+	for i := range posts {
+		scores[i] = float64(posts[i].CreatedTimestamp)
+	}
+	return scores, nil
+}
+
+// engagementRanker boosts fresh posts having many reactions and comments
+type engagementRanker struct {
+	halfLife time.Duration
+	now      func() time.Time
+}
+
+func (r *engagementRanker) Name() string {
+	return RankingEngagement
+}
+
+func (r *engagementRanker) Score(ctx context.Context, userId int64, posts []*model.Post) ([]float64, error) {
+	now := r.now()
+	scores := make([]float64, len(posts))
+	for i, post := range posts {
+		engagement := float64(post.ReactionCount) + commentWeight*float64(post.CommentCount)
+		scores[i] = freshness(post.CreatedTimestamp, now, r.halfLife) * (1 + math.Log1p(engagement))
+	}
+	return scores, nil
+}
+
+// affinityRanker boosts fresh posts of authors that the user has followed for a long time
+type affinityRanker struct {
+	// getFollowTimestamps is PostService.getFollowTimestamps, so follow ages fall back to db while cache is incomplete
+	getFollowTimestamps func(ctx context.Context, userId int64, followingIds []int64) (map[int64]int64, error)
+	halfLife            time.Duration
+	now                 func() time.Time
+}
+
+func (r *affinityRanker) Name() string {
+	return RankingAffinity
+}
+
+func (r *affinityRanker) Score(ctx context.Context, userId int64, posts []*model.Post) ([]float64, error) {
+	authorIds := make([]int64, 0, len(posts))
+	seen := make(map[int64]bool)
+	for _, post := range posts {
+		if !seen[post.UserID] {
+			seen[post.UserID] = true
+			authorIds = append(authorIds, post.UserID)
+		}
+	}
+
+	followTsByAuthor, err := r.getFollowTimestamps(ctx, userId, authorIds)
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.now()
+	scores := make([]float64, len(posts))
+	for i, post := range posts {
+		var followDays float64
+		if followTs, ok := followTsByAuthor[post.UserID]; ok {
+			followDays = now.Sub(time.Unix(followTs, 0)).Hours() / 24
+		}
+		scores[i] = freshness(post.CreatedTimestamp, now, r.halfLife) * (1 + math.Log1p(math.Max(followDays, 0)))
+	}
+	return scores, nil
+}
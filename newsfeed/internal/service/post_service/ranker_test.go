@@ -0,0 +1,148 @@
+package post_service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func TestRankPosts(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1_000_000, 0)
+	nowFunc := func() time.Time { return now }
+
+	t.Run("chronological keeps newest first", func(t *testing.T) {
+		posts := []*model.Post{
+			{ID: 1, CreatedTimestamp: 100},
+			{ID: 2, CreatedTimestamp: 300},
+			{ID: 3, CreatedTimestamp: 200},
+		}
+		ranked, err := rankPosts(ctx, &chronologicalRanker{}, 1, posts)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 3, 1}, rankedPostIds(ranked))
+	})
+
+	t.Run("engagement boosts popular post", func(t *testing.T) {
+		posts := []*model.Post{
+			{ID: 1, CreatedTimestamp: now.Unix() - 60},
+			{ID: 2, CreatedTimestamp: now.Unix() - 3600, ReactionCount: 50, CommentCount: 10},
+			{ID: 3, CreatedTimestamp: now.Unix() - 7*24*3600, ReactionCount: 50, CommentCount: 10}, // too old
+		}
+		ranker := &engagementRanker{halfLife: defaultRankingHalfLife, now: nowFunc}
+		ranked, err := rankPosts(ctx, ranker, 1, posts)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 1, 3}, rankedPostIds(ranked))
+	})
+
+	t.Run("affinity boosts long-time followings", func(t *testing.T) {
+		posts := []*model.Post{
+			{ID: 1, UserID: 10, CreatedTimestamp: now.Unix() - 60},
+			{ID: 2, UserID: 20, CreatedTimestamp: now.Unix() - 3600},
+		}
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsFollowingsCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("GetFollowTimestamps", ctx, int64(1), []int64{10, 20}).
+			Return(map[int64]int64{10: now.Unix() - 3600, 20: now.Unix() - 365*24*3600}, nil)
+		service, err := New(Config{}, nil, nil, nil, mockUserCache, nil, nil, nil)
+		assert.NoError(t, err)
+
+		ranker := &affinityRanker{getFollowTimestamps: service.getFollowTimestamps, halfLife: defaultRankingHalfLife, now: nowFunc}
+		ranked, err := rankPosts(ctx, ranker, 1, posts)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 1}, rankedPostIds(ranked))
+	})
+
+	t.Run("affinity reads follows from db while cached followings are incomplete", func(t *testing.T) {
+		posts := []*model.Post{
+			{ID: 1, UserID: 10, CreatedTimestamp: now.Unix() - 60},
+			{ID: 2, UserID: 20, CreatedTimestamp: now.Unix() - 3600},
+		}
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsFollowingsCached", ctx, int64(1)).Return(false, nil)
+		mockUserDAI := new(MockUserDAI)
+		mockUserDAI.On("GetFollowsBetween", ctx, int64(1), []int64{10, 20}).Return([]*model.Follow{
+			{Follower: &model.User{ID: 1}, Following: &model.User{ID: 20}, FollowTs: now.Unix() - 365*24*3600},
+		}, nil)
+		service, err := New(Config{}, nil, mockUserDAI, nil, mockUserCache, nil, nil, nil)
+		assert.NoError(t, err)
+
+		ranker := &affinityRanker{getFollowTimestamps: service.getFollowTimestamps, halfLife: defaultRankingHalfLife, now: nowFunc}
+		ranked, err := rankPosts(ctx, ranker, 1, posts)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 1}, rankedPostIds(ranked))
+		mockUserCache.AssertNotCalled(t, "GetFollowTimestamps", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostService_GetNewsfeed_Ranking(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unknown ranking", func(t *testing.T) {
+		service, err := New(Config{}, nil, nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		_, _, err = service.GetNewsfeed(ctx, 1, &model.Paging{Limit: 10, OrderBy: "random"})
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+
+	t.Run("unknown default ranking", func(t *testing.T) {
+		_, err := New(Config{DefaultRanking: "random"}, nil, nil, nil, nil, nil, nil, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("registered ranker", func(t *testing.T) {
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("GetNewsfeedItems", ctx, int64(1), mock.Anything, mock.Anything, mock.Anything).Return([]*model.FeedItem{
+			{PostID: 2, Timestamp: 200},
+			{PostID: 1, Timestamp: 100},
+		}, nil)
+		mockPostCache.On("GetCachedPostsByIDs", ctx, []int64{2, 1}).Return([]*model.Post{
+			{ID: 2, CreatedTimestamp: 200},
+			{ID: 1, CreatedTimestamp: 100},
+		}, nil)
+		mockPostCache.On("GetPostReactions", ctx, []int64{2, 1}, int64(1)).Return(newEmptyReactions(2), nil)
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsFollowingsCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("GetFollowedHighFollowerIDs", ctx, int64(1)).Return([]int64{}, nil)
+		mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("FilterBlocked", ctx, int64(1), mock.Anything).Return([]int64{}, nil)
+		mockUserCache.On("FilterMuted", ctx, int64(1), mock.Anything).Return([]int64{}, nil)
+
+		service, err := New(Config{}, nil, nil, nil, mockUserCache, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+		service.RegisterRanker(&oldestFirstRanker{})
+
+		posts, nextCursor, err := service.GetNewsfeed(ctx, 1, &model.Paging{Limit: 10, OrderBy: "oldest"})
+		assert.NoError(t, err)
+		assert.Nil(t, nextCursor)
+		assert.Equal(t, []int64{1, 2}, rankedPostIds(posts))
+	})
+}
+
+type oldestFirstRanker struct{}
+
+func (r *oldestFirstRanker) Name() string {
+	return "oldest"
+}
+
+func (r *oldestFirstRanker) Score(ctx context.Context, userId int64, posts []*model.Post) ([]float64, error) {
+	scores := make([]float64, len(posts))
+	for i := range posts {
+		scores[i] = -float64(posts[i].CreatedTimestamp)
+	}
+	return scores, nil
+}
+
+func rankedPostIds(posts []*model.RankedPost) []int64 {
+	ids := make([]int64, len(posts))
+	for i := range posts {
+		ids[i] = posts[i].Post.ID
+	}
+	return ids
+}
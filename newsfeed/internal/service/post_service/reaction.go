@@ -0,0 +1,138 @@
+package post_service
+
+import (
+	"context"
+	"time"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+// ReactPost sets reaction of a user on a post, empty reactionType removes it.
+// Reactions are changed in cache only, FlushReactions persists them to db later.
+func (s *PostService) ReactPost(ctx context.Context, userId, postId int64, reactionType string) (*model.PostReactions, error) {
+	if userId <= 0 || postId <= 0 {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid user_id or post_id")
+	}
+	if len(reactionType) > 0 && !model.IsValidReactionType(reactionType) {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid reaction type: "+reactionType)
+	}
+
+	if _, err := s.getAccessiblePost(ctx, userId, postId); err != nil {
+		return nil, err
+	}
+
+	loaded, err := s.postCacheDai.SetReaction(ctx, postId, userId, reactionType)
+	if err == nil && !loaded {
+		if err = s.loadCachedReactions(ctx, postId); err == nil {
+			loaded, err = s.postCacheDai.SetReaction(ctx, postId, userId, reactionType)
+		}
+	}
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to set reaction", err)
+	}
+	if !loaded {
+		return nil, common.NewError(common.CodeInternal, "failed to load reactions into cache")
+	}
+
+	reactions, err := s.getPostReactions(ctx, userId, []int64{postId})
+	if err != nil {
+		return nil, err
+	}
+	return reactions[0], nil
+}
+
+// FlushReactions persists up to batchSize reactions changed since the last flush,
+// it returns number of flushed reactions so callers can keep flushing while batches are full
+func (s *PostService) FlushReactions(ctx context.Context, batchSize int64) (int, error) {
+	reactions, err := s.postCacheDai.PopDirtyReactions(ctx, batchSize)
+	if err != nil {
+		return 0, common.WrapError(common.CodeInternal, "failed to get changed reactions", err)
+	}
+	if len(reactions) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now().Unix()
+	for _, reaction := range reactions {
+		reaction.UpdatedTimestamp = now
+	}
+
+	if err := s.dai.SaveReactions(ctx, reactions); err != nil {
+		// mark them changed again, so the next flush retries them
+		if addErr := s.postCacheDai.AddDirtyReactions(ctx, reactions); addErr != nil {
+			logger.Error("failed to restore changed reactions", logger.E(addErr), logger.F("count", len(reactions)))
+		}
+		return 0, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	return len(reactions), nil
+}
+
+// setPostReactions fills reaction counters of posts and reaction of viewer
+func (s *PostService) setPostReactions(ctx context.Context, viewerId int64, posts []*model.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postIds := make([]int64, len(posts))
+	for i := range posts {
+		postIds[i] = posts[i].ID
+	}
+	reactions, err := s.getPostReactions(ctx, viewerId, postIds)
+	if err != nil {
+		return err
+	}
+
+	for i, post := range posts {
+		post.Reactions = reactions[i]
+		post.ReactionCount = reactions[i].Total()
+	}
+	return nil
+}
+
+// getPostReactions reads reactions from cache, loading them from db for posts missing in cache
+func (s *PostService) getPostReactions(ctx context.Context, viewerId int64, postIds []int64) ([]*model.PostReactions, error) {
+	reactions, err := s.postCacheDai.GetPostReactions(ctx, postIds, viewerId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to get reactions from cache", err)
+	}
+
+	missingIds := make([]int64, 0)
+	for i := range postIds {
+		if reactions[i] != nil {
+			continue
+		}
+		if err := s.loadCachedReactions(ctx, postIds[i]); err != nil {
+			return nil, common.WrapError(common.CodeInternal, "failed to load reactions into cache", err)
+		}
+		missingIds = append(missingIds, postIds[i])
+	}
+	if len(missingIds) == 0 {
+		return reactions, nil
+	}
+
+	loadedReactions, err := s.postCacheDai.GetPostReactions(ctx, missingIds, viewerId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to get reactions from cache", err)
+	}
+	for i, j := 0, 0; i < len(postIds); i++ {
+		if reactions[i] != nil {
+			continue
+		}
+		reactions[i] = loadedReactions[j]
+		if reactions[i] == nil { // evicted again right after loading, show empty counters instead of failing
+			reactions[i] = &model.PostReactions{Counts: make(map[string]int64)}
+		}
+		j++
+	}
+	return reactions, nil
+}
+
+func (s *PostService) loadCachedReactions(ctx context.Context, postId int64) error {
+	reactions, err := s.dai.GetReactionsByPostID(ctx, postId)
+	if err != nil {
+		return err
+	}
+	return s.postCacheDai.LoadReactions(ctx, postId, reactions)
+}
@@ -0,0 +1,142 @@
+package post_service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func TestPostService_ReactPost(t *testing.T) {
+	ctx := context.Background()
+	post := &model.Post{ID: 10, UserID: 2}
+
+	t.Run("invalid reaction type", func(t *testing.T) {
+		service, err := New(Config{}, nil, nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.ReactPost(ctx, 1, 10, "dislike")
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+
+	t.Run("viewer blocked by post author", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+		mockUserCache.On("FilterBlocked", ctx, int64(1), []int64{2}).Return([]int64{2}, nil)
+		mockPostCache := new(MockPostCacheDAI)
+
+		service, err := New(Config{}, mockDAI, nil, nil, mockUserCache, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.ReactPost(ctx, 1, 10, model.ReactionLike)
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeNotFound)
+		mockPostCache.AssertNotCalled(t, "SetReaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("post is not shared with viewer", func(t *testing.T) {
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(&model.Post{ID: 10, UserID: 2, Visibility: model.VisibilityFollowers}, nil)
+		mockPostCache := new(MockPostCacheDAI)
+
+		service, err := New(Config{}, mockDAI, nil, nil, newAccessibleUserCache(ctx), mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.ReactPost(ctx, 1, 10, model.ReactionLike)
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeNotFound)
+		mockPostCache.AssertNotCalled(t, "SetReaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("load reactions from db when cache is lost", func(t *testing.T) {
+		persisted := []*model.Reaction{{PostID: 10, UserID: 3, Type: model.ReactionLove}}
+
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("GetPostByID", ctx, int64(10)).Return(post, nil)
+		mockDAI.On("GetReactionsByPostID", ctx, int64(10)).Return(persisted, nil)
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("SetReaction", ctx, int64(10), int64(1), model.ReactionLike).Return(false, nil).Once()
+		mockPostCache.On("LoadReactions", ctx, int64(10), persisted).Return(nil)
+		mockPostCache.On("SetReaction", ctx, int64(10), int64(1), model.ReactionLike).Return(true, nil).Once()
+		mockPostCache.On("GetPostReactions", ctx, []int64{10}, int64(1)).Return([]*model.PostReactions{{
+			Counts:         map[string]int64{model.ReactionLike: 1, model.ReactionLove: 1},
+			ViewerReaction: model.ReactionLike,
+		}}, nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, newAccessibleUserCache(ctx), mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.ReactPost(ctx, 1, 10, model.ReactionLike)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), res.Total())
+		assert.Equal(t, model.ReactionLike, res.ViewerReaction)
+		mockPostCache.AssertExpectations(t)
+	})
+}
+
+func TestPostService_FlushReactions(t *testing.T) {
+	ctx := context.Background()
+	reactions := []*model.Reaction{
+		{PostID: 10, UserID: 1, Type: model.ReactionLike},
+		{PostID: 10, UserID: 2}, // removed
+	}
+
+	t.Run("nothing to flush", func(t *testing.T) {
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("PopDirtyReactions", ctx, int64(100)).Return(nil, nil)
+
+		service, err := New(Config{}, nil, nil, nil, nil, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		n, err := service.FlushReactions(ctx, 100)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("retry later if db failed", func(t *testing.T) {
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("PopDirtyReactions", ctx, int64(100)).Return(reactions, nil)
+		mockPostCache.On("AddDirtyReactions", ctx, reactions).Return(nil)
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("SaveReactions", ctx, reactions).Return(errors.New("db down"))
+
+		service, err := New(Config{}, mockDAI, nil, nil, nil, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		n, err := service.FlushReactions(ctx, 100)
+
+		assert.Equal(t, 0, n)
+		assertAppError(t, err, common.CodeDatabaseError)
+		mockPostCache.AssertExpectations(t)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockPostCache := new(MockPostCacheDAI)
+		mockPostCache.On("PopDirtyReactions", ctx, int64(100)).Return(reactions, nil)
+		mockDAI := new(MockPostDAI)
+		mockDAI.On("SaveReactions", ctx, mock.MatchedBy(func(saved []*model.Reaction) bool {
+			return len(saved) == 2 && saved[0].UpdatedTimestamp > 0
+		})).Return(nil)
+
+		service, err := New(Config{}, mockDAI, nil, nil, nil, mockPostCache, nil, nil)
+		assert.NoError(t, err)
+
+		n, err := service.FlushReactions(ctx, 100)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+	})
+}
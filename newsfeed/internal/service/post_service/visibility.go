@@ -0,0 +1,102 @@
+package post_service
+
+import (
+	"context"
+
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+// getVisibility returns visibility of post, posts cached before visibility was added are public
+func getVisibility(post *model.Post) string {
+	if len(post.Visibility) == 0 {
+		return model.VisibilityPublic
+	}
+	return post.Visibility
+}
+
+// getAllowedVisibilities returns visibilities of posts of author that viewer can see
+func (s *PostService) getAllowedVisibilities(ctx context.Context, viewerId, authorId int64) ([]string, error) {
+	if viewerId == authorId {
+		return []string{model.VisibilityPublic, model.VisibilityFollowers, model.VisibilityCloseFriends}, nil
+	}
+
+	visibilities := []string{model.VisibilityPublic}
+
+	followTsByAuthor, err := s.getFollowTimestamps(ctx, viewerId, []int64{authorId})
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := followTsByAuthor[authorId]; ok {
+		visibilities = append(visibilities, model.VisibilityFollowers)
+	}
+
+	closeFriendIds, err := s.filterCloseFriends(ctx, authorId, []int64{viewerId})
+	if err != nil {
+		return nil, err
+	}
+	if len(closeFriendIds) > 0 {
+		visibilities = append(visibilities, model.VisibilityCloseFriends)
+	}
+	return visibilities, nil
+}
+
+// filterVisiblePosts removes posts that viewer cannot see, e.g. viewer has unfollowed the author
+// or been removed from close friends of the author after the post was pushed to its newsfeed
+func (s *PostService) filterVisiblePosts(ctx context.Context, viewerId int64, posts []*model.Post) ([]*model.Post, error) {
+	// public posts and posts of viewer do not need any check
+	followerAuthorIds := make([]int64, 0)
+	closeFriendAuthorIds := make([]int64, 0)
+	seen := make(map[int64]map[string]bool)
+	for _, post := range posts {
+		visibility := getVisibility(post)
+		if post.UserID == viewerId || visibility == model.VisibilityPublic || seen[post.UserID][visibility] {
+			continue
+		}
+		if seen[post.UserID] == nil {
+			seen[post.UserID] = make(map[string]bool)
+		}
+		seen[post.UserID][visibility] = true
+
+		if visibility == model.VisibilityFollowers {
+			followerAuthorIds = append(followerAuthorIds, post.UserID)
+		} else {
+			closeFriendAuthorIds = append(closeFriendAuthorIds, post.UserID)
+		}
+	}
+	if len(followerAuthorIds) == 0 && len(closeFriendAuthorIds) == 0 {
+		return posts, nil
+	}
+
+	followTsByAuthor, err := s.getFollowTimestamps(ctx, viewerId, followerAuthorIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// a page has few distinct authors sharing posts with close friends, so check them one by one
+	isCloseFriendOf := make(map[int64]bool)
+	for _, authorId := range closeFriendAuthorIds {
+		closeFriendIds, err := s.filterCloseFriends(ctx, authorId, []int64{viewerId})
+		if err != nil {
+			return nil, err
+		}
+		isCloseFriendOf[authorId] = len(closeFriendIds) > 0
+	}
+
+	res := make([]*model.Post, 0, len(posts))
+	for _, post := range posts {
+		if post.UserID != viewerId {
+			switch getVisibility(post) {
+			case model.VisibilityFollowers:
+				if _, ok := followTsByAuthor[post.UserID]; !ok {
+					continue
+				}
+			case model.VisibilityCloseFriends:
+				if !isCloseFriendOf[post.UserID] {
+					continue
+				}
+			}
+		}
+		res = append(res, post)
+	}
+	return res, nil
+}
@@ -0,0 +1,196 @@
+package post_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func TestPostService_GetPostByUserID_Visibility(t *testing.T) {
+	ctx := context.Background()
+	paging := &model.Paging{Limit: 10}
+
+	tests := []struct {
+		name         string
+		viewerId     int64
+		followed     bool
+		closeFriend  bool
+		visibilities []string
+	}{
+		{
+			name:         "author sees all posts",
+			viewerId:     1,
+			visibilities: []string{model.VisibilityPublic, model.VisibilityFollowers, model.VisibilityCloseFriends},
+		},
+		{
+			name:         "non-follower sees public posts only",
+			viewerId:     2,
+			visibilities: []string{model.VisibilityPublic},
+		},
+		{
+			name:         "follower",
+			viewerId:     2,
+			followed:     true,
+			visibilities: []string{model.VisibilityPublic, model.VisibilityFollowers},
+		},
+		{
+			name:         "close friend",
+			viewerId:     2,
+			followed:     true,
+			closeFriend:  true,
+			visibilities: []string{model.VisibilityPublic, model.VisibilityFollowers, model.VisibilityCloseFriends},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			followTs := map[int64]int64{}
+			if tt.followed {
+				followTs[1] = 1700000000
+			}
+			var closeFriendIds []int64
+			if tt.closeFriend {
+				closeFriendIds = []int64{tt.viewerId}
+			}
+
+			mockUserCache := new(MockUserCacheDAI)
+			mockUserCache.On("IsFollowingsCached", ctx, tt.viewerId).Return(true, nil)
+			mockUserCache.On("GetFollowTimestamps", ctx, tt.viewerId, []int64{1}).Return(followTs, nil)
+			mockUserCache.On("FilterCloseFriends", ctx, int64(1), []int64{tt.viewerId}).Return(closeFriendIds, nil)
+			mockUserCache.On("IsAudienceCached", ctx, tt.viewerId).Return(true, nil)
+			mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+			mockUserCache.On("FilterBlocked", ctx, tt.viewerId, []int64{1}).Return([]int64{}, nil)
+			mockUserDAI := new(MockUserDAI)
+			mockUserDAI.On("GetByID", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+			mockDAI := new(MockPostDAI)
+			mockDAI.On("GetPostsByUserID", ctx, int64(1), tt.visibilities, paging).Return([]*model.Post{}, nil)
+			mockPostCache := new(MockPostCacheDAI)
+			mockPostCache.On("GetPostReactions", ctx, mock.Anything, tt.viewerId).Return([]*model.PostReactions{}, nil)
+
+			service, err := New(Config{}, mockDAI, mockUserDAI, nil, mockUserCache, mockPostCache, nil, nil)
+			assert.NoError(t, err)
+
+			_, err = service.GetPostByUserID(ctx, tt.viewerId, 1, paging)
+
+			assert.NoError(t, err)
+			mockDAI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPostService_GetPostByUserID_Private(t *testing.T) {
+	ctx := context.Background()
+	paging := &model.Paging{Limit: 10}
+
+	mockUserCache := new(MockUserCacheDAI)
+	mockUserCache.On("IsAudienceCached", ctx, mock.Anything).Return(true, nil)
+	mockUserCache.On("FilterBlocked", ctx, int64(2), []int64{1}).Return([]int64{}, nil)
+	mockUserCache.On("IsFollowingsCached", ctx, int64(2)).Return(true, nil)
+	mockUserCache.On("GetFollowTimestamps", ctx, int64(2), []int64{1}).Return(map[int64]int64{}, nil)
+	mockUserCache.On("FilterCloseFriends", ctx, int64(1), []int64{2}).Return([]int64{}, nil)
+	mockUserDAI := new(MockUserDAI)
+	mockUserDAI.On("GetByID", ctx, int64(1)).Return(&model.User{ID: 1, IsPrivate: true}, nil)
+	mockDAI := new(MockPostDAI)
+
+	service, err := New(Config{}, mockDAI, mockUserDAI, nil, mockUserCache, nil, nil, nil)
+	assert.NoError(t, err)
+
+	res, err := service.GetPostByUserID(ctx, 2, 1, paging)
+
+	assert.Nil(t, res)
+	assertAppError(t, err, common.CodeForbidden)
+	mockDAI.AssertNotCalled(t, "GetPostsByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPostService_getAllowedVisibilities(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("check follows and close friends in db if they are not cached", func(t *testing.T) {
+		mockUserCache := new(MockUserCacheDAI)
+		mockUserCache.On("IsFollowingsCached", ctx, int64(2)).Return(false, nil)
+		mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(false, nil)
+		mockUserCache.On("LockAudienceRebuild", ctx, int64(1)).Return("", nil) // another request is rebuilding
+		mockUserDAI := new(MockUserDAI)
+		mockUserDAI.On("GetFollowsBetween", ctx, int64(2), []int64{1}).Return([]*model.Follow{
+			{Follower: &model.User{ID: 1}, Following: &model.User{ID: 2}}, // author follows viewer only
+		}, nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("GetAudienceMembersBetween", ctx, int64(1), model.AudienceListCloseFriends, []int64{2}).
+			Return([]*model.AudienceMember{{OwnerID: 1, List: model.AudienceListCloseFriends, MemberID: 2}}, nil)
+
+		service, err := New(Config{}, nil, mockUserDAI, mockAudienceDAI, mockUserCache, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.getAllowedVisibilities(ctx, 2, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{model.VisibilityPublic, model.VisibilityCloseFriends}, res)
+		mockUserCache.AssertNotCalled(t, "GetFollowTimestamps", mock.Anything, mock.Anything, mock.Anything)
+		mockUserCache.AssertNotCalled(t, "FilterCloseFriends", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPostService_FilterVisiblePosts(t *testing.T) {
+	ctx := context.Background()
+
+	// viewer 1 follows user 2 but not user 3, and is a close friend of user 3 only
+	posts := []*model.Post{
+		{ID: 1, UserID: 2, Visibility: model.VisibilityFollowers},
+		{ID: 2, UserID: 2, Visibility: model.VisibilityCloseFriends},
+		{ID: 3, UserID: 3, Visibility: model.VisibilityFollowers},
+		{ID: 4, UserID: 3, Visibility: model.VisibilityCloseFriends},
+		{ID: 5, UserID: 4}, // cached before visibility was added
+		{ID: 6, UserID: 1, Visibility: model.VisibilityCloseFriends},
+	}
+
+	mockUserCache := new(MockUserCacheDAI)
+	mockUserCache.On("IsFollowingsCached", ctx, int64(1)).Return(true, nil)
+	mockUserCache.On("GetFollowTimestamps", ctx, int64(1), []int64{2, 3}).Return(map[int64]int64{2: 1700000000}, nil)
+	mockUserCache.On("IsAudienceCached", ctx, mock.Anything).Return(true, nil)
+	mockUserCache.On("FilterCloseFriends", ctx, int64(2), []int64{1}).Return([]int64{}, nil)
+	mockUserCache.On("FilterCloseFriends", ctx, int64(3), []int64{1}).Return([]int64{1}, nil)
+
+	service, err := New(Config{}, nil, nil, nil, mockUserCache, nil, nil, nil)
+	assert.NoError(t, err)
+
+	res, err := service.filterVisiblePosts(ctx, 1, posts)
+
+	assert.NoError(t, err)
+	postIds := make([]int64, len(res))
+	for i := range res {
+		postIds[i] = res[i].ID
+	}
+	assert.Equal(t, []int64{1, 4, 5, 6}, postIds)
+}
+
+func TestPostService_AppendPostToNewsfeed_CloseFriends(t *testing.T) {
+	ctx := context.Background()
+	post := &model.Post{ID: 10, UserID: 1, Content: "hello", CreatedTimestamp: 1700000000, Visibility: model.VisibilityCloseFriends}
+
+	mockPostCache := new(MockPostCacheDAI)
+	mockPostCache.On("AddCachedPost", ctx, post).Return(true, nil)
+	mockPostCache.On("AddPostToUserPosts", ctx, post, int64(defaultNewsfeedMaxLength)).Return(nil)
+	mockPostCache.On("AddPostToNewsfeeds", ctx, []int64{3}, post, int64(defaultNewsfeedMaxLength)).Return(nil)
+
+	mockUserCache := new(MockUserCacheDAI)
+	mockUserCache.On("IsHighFollowerUser", ctx, int64(1)).Return(false, nil)
+	mockUserCache.On("IsFollowersCached", ctx, int64(1)).Return(true, nil)
+	mockUserCache.On("CountFollowers", ctx, int64(1)).Return(int64(3), nil)
+	mockUserCache.On("GetFollowerIDs", ctx, int64(1), int64(0), int64(defaultFanoutBatchSize)).Return([]int64{2, 3, 4}, nil)
+	mockUserCache.On("FilterCloseFriends", ctx, int64(1), []int64{2, 3, 4}).Return([]int64{3}, nil)
+	mockUserCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+	mockUserCache.On("FilterMutedBy", ctx, int64(1), []int64{3}).Return([]int64{}, nil)
+
+	service, err := New(Config{}, nil, nil, nil, mockUserCache, mockPostCache, nil, nil)
+	assert.NoError(t, err)
+
+	err = service.AppendPostToNewsfeed(ctx, post)
+
+	assert.NoError(t, err)
+	mockPostCache.AssertExpectations(t)
+	mockUserCache.AssertExpectations(t)
+}
@@ -0,0 +1,292 @@
+package suggestion_service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+const (
+	defaultMaxSuggestions = 50
+	defaultSuggestionTTL  = 24 * time.Hour
+
+	// each kind of candidates loads more than needed, because some are merged or dropped later
+	candidatesPerSuggestion = 4
+
+	friendOfFriendWeight = 1.0 // a following of a following is a strong signal
+	mutualFollowerWeight = 0.5 // a user followed by followers of the user is a weaker one
+)
+
+type UserDAI interface {
+	GetByID(ctx context.Context, userId int64) (*model.User, error)
+	GetUserIDs(ctx context.Context, afterId int64, limit int) ([]int64, error)
+
+	CountFriendsOfFriends(ctx context.Context, userId int64, limit int) (map[int64]int64, error)
+	CountCommonFollowers(ctx context.Context, userId int64, limit int) (map[int64]int64, error)
+}
+
+type AudienceDAI interface {
+	GetAudienceMembersBetween(ctx context.Context, userId int64, list string, peerIds []int64) ([]*model.AudienceMember, error)
+}
+
+type UserCacheDAI interface {
+	GetCachedUserByID(ctx context.Context, userId int64) (*model.User, error)
+	GetFollowTimestamps(ctx context.Context, userId int64, followingIds []int64) (map[int64]int64, error)
+	IsAudienceCached(ctx context.Context, userId int64) (bool, error)
+	FilterBlocked(ctx context.Context, userId int64, userIds []int64) ([]int64, error)
+
+	SetSuggestions(ctx context.Context, userId int64, suggestions []*model.Suggestion, ttl time.Duration) error
+	GetSuggestions(ctx context.Context, userId int64) ([]*model.Suggestion, error)
+}
+
+type Config struct {
+	MaxSuggestions int           // max number of suggestions kept per user
+	SuggestionTTL  time.Duration // suggestions not refreshed within this duration are dropped from cache
+}
+
+// SuggestionService suggests users to follow from the follow graph.
+// Suggestions are precomputed by RefreshSuggestions into cache, so reading them does not query the graph.
+type SuggestionService struct {
+	cfg Config
+
+	dai          UserDAI
+	audienceDai  AudienceDAI
+	userCacheDai UserCacheDAI
+}
+
+func New(cfg Config, userDai UserDAI, audienceDai AudienceDAI, userCacheDai UserCacheDAI) (*SuggestionService, error) {
+	if cfg.MaxSuggestions <= 0 {
+		cfg.MaxSuggestions = defaultMaxSuggestions
+	}
+	if cfg.SuggestionTTL <= 0 {
+		cfg.SuggestionTTL = defaultSuggestionTTL
+	}
+
+	return &SuggestionService{
+		cfg:          cfg,
+		dai:          userDai,
+		audienceDai:  audienceDai,
+		userCacheDai: userCacheDai,
+	}, nil
+}
+
+// RefreshSuggestions recomputes suggestions of a batch of users having id greater than afterId.
+// It returns the last visited user id, which is the afterId of the next batch, and the number of visited users.
+// A user failing to refresh keeps its previous suggestions and does not stop the batch.
+func (s *SuggestionService) RefreshSuggestions(ctx context.Context, afterId int64, batchSize int) (int64, int, error) {
+	userIds, err := s.dai.GetUserIDs(ctx, afterId, batchSize)
+	if err != nil {
+		return afterId, 0, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	for _, userId := range userIds {
+		if _, err := s.computeSuggestions(ctx, userId); err != nil {
+			logger.Error("failed to refresh suggestions", logger.E(err), logger.F("user_id", userId))
+		}
+	}
+
+	if len(userIds) == 0 {
+		return afterId, 0, nil
+	}
+	return userIds[len(userIds)-1], len(userIds), nil
+}
+
+// GetSuggestions returns at most limit users that user may follow, best first.
+// Users followed or blocked since suggestions were computed are skipped.
+func (s *SuggestionService) GetSuggestions(ctx context.Context, userId int64, limit int64) ([]*model.Suggestion, error) {
+	if limit <= 0 || limit > int64(s.cfg.MaxSuggestions) {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid limit")
+	}
+
+	suggestions, err := s.userCacheDai.GetSuggestions(ctx, userId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to get suggestions from cache", err)
+	}
+	if suggestions == nil { // new users are not refreshed yet
+		suggestions, err = s.computeSuggestions(ctx, userId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	suggestions, err = s.excludeFollowedAndBlocked(ctx, userId, suggestions)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*model.Suggestion, 0, limit)
+	for _, suggestion := range suggestions {
+		if int64(len(res)) >= limit {
+			break
+		}
+		suggestion.User, err = s.getUserByIDFromCacheOrDb(ctx, suggestion.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if suggestion.User == nil { // removed after suggestions were computed
+			continue
+		}
+		res = append(res, suggestion)
+	}
+	return res, nil
+}
+
+// computeSuggestions ranks candidates of user and saves the top ones to cache.
+// Candidates are users followed by followings of user (friends of friends) or by followers of user,
+// the more of them follow a candidate, the higher it is ranked.
+func (s *SuggestionService) computeSuggestions(ctx context.Context, userId int64) ([]*model.Suggestion, error) {
+	candidateLimit := s.cfg.MaxSuggestions * candidatesPerSuggestion
+
+	friendOfFriendCounts, err := s.dai.CountFriendsOfFriends(ctx, userId, candidateLimit)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	commonFollowerCounts, err := s.dai.CountCommonFollowers(ctx, userId, candidateLimit)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	suggestionById := make(map[int64]*model.Suggestion)
+	getSuggestion := func(candidateId int64) *model.Suggestion {
+		suggestion, ok := suggestionById[candidateId]
+		if !ok {
+			suggestion = &model.Suggestion{UserID: candidateId}
+			suggestionById[candidateId] = suggestion
+		}
+		return suggestion
+	}
+	for candidateId, count := range friendOfFriendCounts {
+		getSuggestion(candidateId).MutualFollowings = count
+	}
+	for candidateId, count := range commonFollowerCounts {
+		getSuggestion(candidateId).MutualFollowers = count
+	}
+
+	suggestions := make([]*model.Suggestion, 0, len(suggestionById))
+	for _, suggestion := range suggestionById {
+		suggestion.Score = friendOfFriendWeight*float64(suggestion.MutualFollowings) +
+			mutualFollowerWeight*float64(suggestion.MutualFollowers)
+		suggestions = append(suggestions, suggestion)
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].UserID < suggestions[j].UserID
+	})
+
+	// followings are excluded by db already, blocks are checked against complete cached block sets or db
+	suggestions, err = s.excludeBlocked(ctx, userId, suggestions)
+	if err != nil {
+		return nil, err
+	}
+	if len(suggestions) > s.cfg.MaxSuggestions {
+		suggestions = suggestions[:s.cfg.MaxSuggestions]
+	}
+
+	if err := s.userCacheDai.SetSuggestions(ctx, userId, suggestions, s.cfg.SuggestionTTL); err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to cache suggestions", err)
+	}
+	return suggestions, nil
+}
+
+func (s *SuggestionService) excludeFollowedAndBlocked(ctx context.Context, userId int64, suggestions []*model.Suggestion) ([]*model.Suggestion, error) {
+	followTsByUser, err := s.userCacheDai.GetFollowTimestamps(ctx, userId, suggestionUserIds(suggestions))
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to check following", err)
+	}
+
+	res := make([]*model.Suggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		if _, ok := followTsByUser[suggestion.UserID]; !ok {
+			res = append(res, suggestion)
+		}
+	}
+	return s.excludeBlocked(ctx, userId, res)
+}
+
+func (s *SuggestionService) excludeBlocked(ctx context.Context, userId int64, suggestions []*model.Suggestion) ([]*model.Suggestion, error) {
+	blockedIds, err := s.filterBlocked(ctx, userId, suggestionUserIds(suggestions))
+	if err != nil {
+		return nil, err
+	}
+	if len(blockedIds) == 0 {
+		return suggestions, nil
+	}
+
+	isBlocked := make(map[int64]bool)
+	for _, id := range blockedIds {
+		isBlocked[id] = true
+	}
+
+	res := make([]*model.Suggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		if !isBlocked[suggestion.UserID] {
+			res = append(res, suggestion)
+		}
+	}
+	return res, nil
+}
+
+// filterBlocked returns the given users blocked by user or blocking user, from cache if the cached block sets
+// of user are complete, otherwise from db
+func (s *SuggestionService) filterBlocked(ctx context.Context, userId int64, userIds []int64) ([]int64, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+
+	cached, err := s.userCacheDai.IsAudienceCached(ctx, userId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "failed to check cached blocks", err)
+	}
+	if cached {
+		blockedIds, err := s.userCacheDai.FilterBlocked(ctx, userId, userIds)
+		if err != nil {
+			return nil, common.WrapError(common.CodeInternal, "failed to check blocks", err)
+		}
+		return blockedIds, nil
+	}
+
+	blocks, err := s.audienceDai.GetAudienceMembersBetween(ctx, userId, model.AudienceListBlocked, userIds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	blockedIds := make([]int64, 0, len(blocks))
+	for _, block := range blocks {
+		if block.OwnerID == userId {
+			blockedIds = append(blockedIds, block.MemberID)
+		} else {
+			blockedIds = append(blockedIds, block.OwnerID)
+		}
+	}
+	return blockedIds, nil
+}
+
+// getUserByIDFromCacheOrDb returns nil if user does not exist
+func (s *SuggestionService) getUserByIDFromCacheOrDb(ctx context.Context, userId int64) (*model.User, error) {
+	user, err := s.userCacheDai.GetCachedUserByID(ctx, userId)
+	if err != nil {
+		logger.Error("failed to get user from cache", logger.E(err))
+	}
+	if user != nil {
+		return user, nil
+	}
+
+	user, err = s.dai.GetByID(ctx, userId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	return user, nil
+}
+
+func suggestionUserIds(suggestions []*model.Suggestion) []int64 {
+	ids := make([]int64, len(suggestions))
+	for i := range suggestions {
+		ids[i] = suggestions[i].UserID
+	}
+	return ids
+}
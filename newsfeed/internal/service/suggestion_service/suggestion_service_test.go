@@ -0,0 +1,126 @@
+package suggestion_service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+func assertAppError(t *testing.T, err error, errCode common.ErrorCode) {
+	var appErr *common.AppError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, errCode, appErr.Code)
+}
+
+func suggestedUserIds(suggestions []*model.Suggestion) []int64 {
+	ids := make([]int64, len(suggestions))
+	for i := range suggestions {
+		ids[i] = suggestions[i].UserID
+	}
+	return ids
+}
+
+func TestSuggestionService_RefreshSuggestions(t *testing.T) {
+	ctx := context.Background()
+
+	mockDAI := new(MockUserDAI)
+	mockDAI.On("GetUserIDs", ctx, int64(0), 10).Return([]int64{1}, nil)
+	// user 2 is followed by 2 followings of user 1, user 3 by 1 following and 4 followers
+	mockDAI.On("CountFriendsOfFriends", ctx, int64(1), 4*3).Return(map[int64]int64{2: 2, 3: 1, 4: 1}, nil)
+	mockDAI.On("CountCommonFollowers", ctx, int64(1), 4*3).Return(map[int64]int64{3: 4, 5: 1}, nil)
+
+	mockCache := new(MockUserCacheDAI)
+	mockCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+	mockCache.On("FilterBlocked", ctx, int64(1), []int64{3, 2, 4, 5}).Return([]int64{4}, nil)
+	mockCache.On("SetSuggestions", ctx, int64(1), mock.Anything, time.Hour).Return(nil)
+
+	service, err := New(Config{MaxSuggestions: 3, SuggestionTTL: time.Hour}, mockDAI, nil, mockCache)
+	assert.NoError(t, err)
+
+	lastId, n, err := service.RefreshSuggestions(ctx, 0, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), lastId)
+	assert.Equal(t, 1, n)
+
+	suggestions := mockCache.Calls[len(mockCache.Calls)-1].Arguments.Get(2).([]*model.Suggestion)
+	assert.Equal(t, []int64{3, 2, 5}, suggestedUserIds(suggestions))
+	assert.Equal(t, 3.0, suggestions[0].Score)
+	assert.Equal(t, int64(1), suggestions[0].MutualFollowings)
+	assert.Equal(t, int64(4), suggestions[0].MutualFollowers)
+}
+
+func TestSuggestionService_GetSuggestions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalid limit", func(t *testing.T) {
+		service, err := New(Config{MaxSuggestions: 10}, nil, nil, nil)
+		assert.NoError(t, err)
+
+		res, err := service.GetSuggestions(ctx, 1, 11)
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+
+	t.Run("skip followed, blocked and removed users", func(t *testing.T) {
+		cached := []*model.Suggestion{
+			{UserID: 2, Score: 5}, // followed after suggestions were computed
+			{UserID: 3, Score: 4}, // blocked after suggestions were computed
+			{UserID: 4, Score: 3}, // removed
+			{UserID: 5, Score: 2},
+			{UserID: 6, Score: 1},
+		}
+
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetSuggestions", ctx, int64(1)).Return(cached, nil)
+		mockCache.On("GetFollowTimestamps", ctx, int64(1), []int64{2, 3, 4, 5, 6}).Return(map[int64]int64{2: 1700000000}, nil)
+		mockCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+		mockCache.On("FilterBlocked", ctx, int64(1), []int64{3, 4, 5, 6}).Return([]int64{3}, nil)
+		mockCache.On("GetCachedUserByID", ctx, mock.Anything).Return(nil, nil)
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetByID", ctx, int64(4)).Return(nil, nil)
+		mockDAI.On("GetByID", ctx, int64(5)).Return(&model.User{ID: 5}, nil)
+
+		service, err := New(Config{}, mockDAI, nil, mockCache)
+		assert.NoError(t, err)
+
+		res, err := service.GetSuggestions(ctx, 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{5}, suggestedUserIds(res))
+		assert.Equal(t, int64(5), res[0].User.ID)
+		mockDAI.AssertNotCalled(t, "GetByID", ctx, int64(6))
+	})
+	t.Run("check blocks in db if cached blocks are not complete", func(t *testing.T) {
+		cached := []*model.Suggestion{
+			{UserID: 2, Score: 2}, // blocked user 1 after suggestions were computed
+			{UserID: 3, Score: 1},
+		}
+
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetSuggestions", ctx, int64(1)).Return(cached, nil)
+		mockCache.On("GetFollowTimestamps", ctx, int64(1), []int64{2, 3}).Return(map[int64]int64{}, nil)
+		mockCache.On("IsAudienceCached", ctx, int64(1)).Return(false, nil)
+		mockCache.On("GetCachedUserByID", ctx, int64(3)).Return(&model.User{ID: 3}, nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("GetAudienceMembersBetween", ctx, int64(1), model.AudienceListBlocked, []int64{2, 3}).
+			Return([]*model.AudienceMember{{OwnerID: 2, MemberID: 1, List: model.AudienceListBlocked}}, nil)
+
+		service, err := New(Config{}, new(MockUserDAI), mockAudienceDAI, mockCache)
+		assert.NoError(t, err)
+
+		res, err := service.GetSuggestions(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3}, suggestedUserIds(res))
+		mockCache.AssertNotCalled(t, "FilterBlocked", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
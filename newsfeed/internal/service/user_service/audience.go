@@ -0,0 +1,87 @@
+package user_service
+
+import (
+	"context"
+	"time"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+const maxAudienceMembersPerPage = 100
+
+type AudienceDAI interface {
+	AddAudienceMember(ctx context.Context, member *model.AudienceMember) error
+	RemoveAudienceMember(ctx context.Context, ownerId int64, list string, memberId int64) error
+	IsAudienceMember(ctx context.Context, ownerId int64, list string, memberId int64) (bool, error)
+	GetAudienceMembersBetween(ctx context.Context, userId int64, list string, peerIds []int64) ([]*model.AudienceMember, error)
+	GetAudienceMembersAmong(ctx context.Context, list string, userIds []int64) ([]*model.AudienceMember, error)
+	GetAudienceMembers(ctx context.Context, ownerId int64, list string, paging *model.Paging) ([]*model.AudienceMember, error)
+}
+
+// AddCloseFriend adds peer to close friends list of user, so peer can see posts shared with close friends.
+// The list is kept in db and mirrored in cache, which newsfeed worker reads when fanning out posts.
+// A failed cache write fails the request to be retried, adding the member again changes nothing in db.
+func (s *UserService) AddCloseFriend(ctx context.Context, userId, peerId int64) (*model.AudienceMember, error) {
+	if userId == peerId {
+		return nil, common.NewError(common.CodeInvalidRequest, "cannot add yourself to close friends")
+	}
+	peer, err := s.getUserByIDFromCacheOrDb(ctx, peerId)
+	if err != nil {
+		return nil, err
+	}
+
+	member := &model.AudienceMember{
+		OwnerID:          userId,
+		List:             model.AudienceListCloseFriends,
+		MemberID:         peerId,
+		CreatedTimestamp: time.Now().Unix(),
+	}
+	if err := s.audienceDai.AddAudienceMember(ctx, member); err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	if s.enabledCache {
+		if err := s.cacheDai.AddCloseFriend(ctx, userId, peerId); err != nil {
+			return nil, common.WrapError(common.CodeInternal, "failed to add close friend to cache", err)
+		}
+	}
+
+	member.Member = peer
+	return member, nil
+}
+
+func (s *UserService) RemoveCloseFriend(ctx context.Context, userId, peerId int64) error {
+	err := s.audienceDai.RemoveAudienceMember(ctx, userId, model.AudienceListCloseFriends, peerId)
+	if err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	if s.enabledCache {
+		if err := s.cacheDai.RemoveCloseFriend(ctx, userId, peerId); err != nil {
+			return common.WrapError(common.CodeInternal, "failed to remove close friend from cache", err)
+		}
+	}
+	return nil
+}
+
+// GetCloseFriends returns close friends of user, latest added first.
+// paging.Cursor holds the id of the last member of the previous page, nil means the first page.
+func (s *UserService) GetCloseFriends(ctx context.Context, userId int64, paging *model.Paging) ([]*model.AudienceMember, error) {
+	if paging.Limit <= 0 || paging.Limit > maxAudienceMembersPerPage {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid limit")
+	}
+
+	members, err := s.audienceDai.GetAudienceMembers(ctx, userId, model.AudienceListCloseFriends, paging)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	for _, member := range members {
+		member.Member, err = s.getUserByIDFromCacheOrDb(ctx, member.MemberID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return members, nil
+}
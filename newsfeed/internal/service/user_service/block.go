@@ -0,0 +1,137 @@
+package user_service
+
+import (
+	"context"
+	"time"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+)
+
+// Block hides user and peer from each other: follows and follow requests in both directions are removed,
+// and neither of them can follow the other until user unblocks peer.
+func (s *UserService) Block(ctx context.Context, userId, peerId int64) error {
+	if userId == peerId {
+		return common.NewError(common.CodeInvalidRequest, "cannot block yourself")
+	}
+	user, err := s.getUserByIDFromCacheOrDb(ctx, userId)
+	if err != nil {
+		return err
+	}
+	peer, err := s.getUserByIDFromCacheOrDb(ctx, peerId)
+	if err != nil {
+		return err
+	}
+
+	// block is saved before follows are removed. A follow transaction checks blocks with a shared lock,
+	// so saving the block waits for a running follow to commit, then the follow is found and removed below,
+	// and a follow starting after the block is saved sees it and fails
+	member := &model.AudienceMember{
+		OwnerID:          userId,
+		List:             model.AudienceListBlocked,
+		MemberID:         peerId,
+		CreatedTimestamp: time.Now().Unix(),
+	}
+	if err := s.audienceDai.AddAudienceMember(ctx, member); err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	// newsfeed reads blocks from cache to hide posts, including posts of high-follower users that are not pushed,
+	// so a block missing in cache fails the request to be retried, saving the block again changes nothing in db
+	if s.enabledCache {
+		if err := s.cacheDai.AddBlock(ctx, userId, peerId); err != nil {
+			return common.WrapError(common.CodeInternal, "failed to add block to cache", err)
+		}
+	}
+
+	for _, pair := range [][2]*model.User{{user, peer}, {peer, user}} {
+		follower, following := pair[0], pair[1]
+
+		f, err := s.dai.GetFollow(ctx, follower.ID, following.ID)
+		if err != nil {
+			return common.WrapError(common.CodeDatabaseError, "database error", err)
+		}
+		if f != nil {
+			if err := s.unfollow(ctx, follower, following); err != nil {
+				return err
+			}
+		}
+
+		if _, err := s.dai.DeleteFollowRequest(ctx, follower.ID, following.ID); err != nil {
+			return common.WrapError(common.CodeDatabaseError, "database error", err)
+		}
+	}
+	return nil
+}
+
+// Unblock allows user and peer to see and follow each other again, removed follows are not restored
+func (s *UserService) Unblock(ctx context.Context, userId, peerId int64) error {
+	err := s.audienceDai.RemoveAudienceMember(ctx, userId, model.AudienceListBlocked, peerId)
+	if err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	if s.enabledCache {
+		if err := s.cacheDai.RemoveBlock(ctx, userId, peerId); err != nil {
+			return common.WrapError(common.CodeInternal, "failed to remove block from cache", err)
+		}
+	}
+	return nil
+}
+
+// Mute hides posts of peer from newsfeed of user, user keeps following peer and peer is not notified.
+// Posts already in the newsfeed are filtered when it is read, new posts are not fanned out to user.
+func (s *UserService) Mute(ctx context.Context, userId, peerId int64) error {
+	if userId == peerId {
+		return common.NewError(common.CodeInvalidRequest, "cannot mute yourself")
+	}
+	if _, err := s.getUserByIDFromCacheOrDb(ctx, peerId); err != nil {
+		return err
+	}
+
+	member := &model.AudienceMember{
+		OwnerID:          userId,
+		List:             model.AudienceListMuted,
+		MemberID:         peerId,
+		CreatedTimestamp: time.Now().Unix(),
+	}
+	if err := s.audienceDai.AddAudienceMember(ctx, member); err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	if s.enabledCache {
+		if err := s.cacheDai.AddMute(ctx, userId, peerId); err != nil {
+			return common.WrapError(common.CodeInternal, "failed to add mute to cache", err)
+		}
+	}
+	return nil
+}
+
+// Unmute shows posts of peer in newsfeed of user again, posts skipped by fan-out while muted are not restored
+func (s *UserService) Unmute(ctx context.Context, userId, peerId int64) error {
+	err := s.audienceDai.RemoveAudienceMember(ctx, userId, model.AudienceListMuted, peerId)
+	if err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	if s.enabledCache {
+		if err := s.cacheDai.RemoveMute(ctx, userId, peerId); err != nil {
+			return common.WrapError(common.CodeInternal, "failed to remove mute from cache", err)
+		}
+	}
+	return nil
+}
+
+// isBlocked checks if user has blocked peer or peer has blocked user
+func (s *UserService) isBlocked(ctx context.Context, userId, peerId int64) (bool, error) {
+	for _, pair := range [][2]int64{{userId, peerId}, {peerId, userId}} {
+		blocked, err := s.audienceDai.IsAudienceMember(ctx, pair[0], model.AudienceListBlocked, pair[1])
+		if err != nil {
+			return false, common.WrapError(common.CodeDatabaseError, "database error", err)
+		}
+		if blocked {
+			return true, nil
+		}
+	}
+	return false, nil
+}
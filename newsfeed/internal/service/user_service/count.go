@@ -0,0 +1,83 @@
+package user_service
+
+import (
+	"context"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+// GetProfile returns user with its counts as seen by viewer, users blocking each other cannot see profiles of each other
+func (s *UserService) GetProfile(ctx context.Context, viewerId, userId int64) (*model.User, error) {
+	user, err := s.getUserByIDFromCacheOrDb(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if viewerId != userId {
+		blocked, err := s.isBlocked(ctx, viewerId, userId)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, common.NewError(common.CodeNotExistedUserID, "user_id is not existed")
+		}
+	}
+
+	user.Counts, err = s.getCountsFromCacheOrDb(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ReconcileCounts recounts follows and posts of a batch of users having id greater than afterId.
+// It returns the last visited user id, which is the afterId of the next batch, and the number of visited users.
+func (s *UserService) ReconcileCounts(ctx context.Context, afterId int64, batchSize int) (int64, int, error) {
+	userIds, err := s.dai.GetUserIDs(ctx, afterId, batchSize)
+	if err != nil {
+		return afterId, 0, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if len(userIds) == 0 {
+		return afterId, 0, nil
+	}
+
+	if err := s.dai.ReconcileCounts(ctx, userIds); err != nil {
+		return afterId, 0, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	if s.enabledCache {
+		if err := s.cacheDai.DeleteCachedCounts(ctx, userIds...); err != nil {
+			logger.Error("failed to delete cached counts", logger.E(err))
+		}
+	}
+	return userIds[len(userIds)-1], len(userIds), nil
+}
+
+func (s *UserService) getCountsFromCacheOrDb(ctx context.Context, userId int64) (*model.UserCounts, error) {
+	if s.enabledCache {
+		counts, err := s.cacheDai.GetCachedCounts(ctx, userId)
+		if err != nil {
+			logger.Error("failed to get counts from cache", logger.E(err))
+		}
+		if counts != nil {
+			return counts, nil
+		}
+	}
+
+	counts, err := s.dai.GetCounts(ctx, userId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if counts == nil {
+		return nil, common.NewError(common.CodeNotExistedUserID, "user_id is not existed")
+	}
+
+	if s.enabledCache {
+		if err := s.cacheDai.SetCachedCounts(ctx, userId, counts); err != nil {
+			logger.Error("failed to set cached counts", logger.E(err))
+		}
+	}
+	return counts, nil
+}
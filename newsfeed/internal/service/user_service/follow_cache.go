@@ -0,0 +1,142 @@
+package user_service
+
+import (
+	"context"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+// getFollowingsFromCache reads a page of followings from cache, the cached set is rebuilt from db first if it is not complete.
+// It returns nil if the set is being rebuilt by another request, so caller reads db instead.
+func (s *UserService) getFollowingsFromCache(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error) {
+	cached, err := s.cacheDai.IsFollowingsCached(ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !cached {
+		rebuilt, err := s.rebuildCachedFollowings(ctx, userId)
+		if err != nil || !rebuilt {
+			return nil, nil, err
+		}
+	}
+
+	followings, missingIds, err := s.cacheDai.GetFollowings(ctx, userId, paging)
+	if err != nil {
+		return nil, nil, err
+	}
+	peer := func(f *model.Follow) *model.User { return f.Following }
+	next := nextFollowCursor(followings, paging, peer)
+
+	followings, err = s.backfillFollowUsers(ctx, followings, missingIds, peer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return followings, next, nil
+}
+
+// getFollowersFromCache reads a page of followers from cache, same as getFollowingsFromCache
+func (s *UserService) getFollowersFromCache(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error) {
+	cached, err := s.cacheDai.IsFollowersCached(ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !cached {
+		rebuilt, err := s.rebuildCachedFollowers(ctx, userId)
+		if err != nil || !rebuilt {
+			return nil, nil, err
+		}
+	}
+
+	followers, missingIds, err := s.cacheDai.GetFollowers(ctx, userId, paging)
+	if err != nil {
+		return nil, nil, err
+	}
+	peer := func(f *model.Follow) *model.User { return f.Follower }
+	next := nextFollowCursor(followers, paging, peer)
+
+	followers, err = s.backfillFollowUsers(ctx, followers, missingIds, peer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return followers, next, nil
+}
+
+// nextFollowCursor returns the cursor of the page after follows, nil if the page is not full.
+// It must be taken before follows of removed users are dropped, or a short page would end the list early.
+func nextFollowCursor(follows []*model.Follow, paging *model.Paging, peer func(f *model.Follow) *model.User) *cursor.Cursor {
+	if len(follows) == 0 || int64(len(follows)) < paging.Limit {
+		return nil
+	}
+	last := follows[len(follows)-1]
+	return &cursor.Cursor{SortKey: last.FollowTs, ID: peer(last).ID}
+}
+
+// rebuildCachedFollowings loads all followings of user from db into cache under a per-user lock.
+// It returns false if another request holds the lock or a follow change raced the rebuild.
+func (s *UserService) rebuildCachedFollowings(ctx context.Context, userId int64) (bool, error) {
+	token, err := s.cacheDai.LockFollowingsRebuild(ctx, userId)
+	if err != nil || len(token) == 0 {
+		return false, err
+	}
+
+	followTsById, err := s.dai.GetFollowingTimestamps(ctx, userId)
+	if err != nil {
+		return false, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	return s.cacheDai.SetCachedFollowings(ctx, userId, token, followTsById)
+}
+
+// rebuildCachedFollowers loads all followers of user from db into cache, same as rebuildCachedFollowings
+func (s *UserService) rebuildCachedFollowers(ctx context.Context, userId int64) (bool, error) {
+	token, err := s.cacheDai.LockFollowersRebuild(ctx, userId)
+	if err != nil || len(token) == 0 {
+		return false, err
+	}
+
+	followTsById, err := s.dai.GetFollowerTimestamps(ctx, userId)
+	if err != nil {
+		return false, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	return s.cacheDai.SetCachedFollowers(ctx, userId, token, followTsById)
+}
+
+// backfillFollowUsers loads users missing from cache one by one from db and caches them.
+// peer returns the user of a follow to fill, follows of removed users are dropped.
+func (s *UserService) backfillFollowUsers(ctx context.Context, follows []*model.Follow, missingIds []int64, peer func(f *model.Follow) *model.User) ([]*model.Follow, error) {
+	if len(missingIds) == 0 {
+		return follows, nil
+	}
+
+	removed := make(map[int64]bool)
+	userById := make(map[int64]*model.User, len(missingIds))
+	for _, id := range missingIds {
+		user, err := s.dai.GetByID(ctx, id)
+		if err != nil {
+			return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+		}
+		if user == nil {
+			removed[id] = true
+			continue
+		}
+		if err := s.cacheDai.SetCachedUser(ctx, user); err != nil {
+			logger.Error("failed to cache user", logger.E(err), logger.F("user_id", id))
+		}
+		userById[id] = user
+	}
+
+	res := make([]*model.Follow, 0, len(follows))
+	for _, f := range follows {
+		user := peer(f)
+		if removed[user.ID] {
+			continue
+		}
+		if loaded, ok := userById[user.ID]; ok {
+			*user = *loaded
+		}
+		res = append(res, f)
+	}
+	return res, nil
+}
@@ -0,0 +1,126 @@
+package user_service
+
+import (
+	"context"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+const maxFollowRequestsPerPage = 100
+
+// SetPrivate changes whether new followers of user must be approved, existing followers and pending requests are kept
+func (s *UserService) SetPrivate(ctx context.Context, userId int64, isPrivate bool) (*model.User, error) {
+	user, err := s.getUserByIDFromCacheOrDb(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.dai.SetPrivate(ctx, userId, isPrivate); err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	user.IsPrivate = isPrivate
+
+	// Follow reads the setting from cached user, so it must be refreshed
+	if s.enabledCache {
+		if err := s.cacheDai.SetCachedUser(ctx, user); err != nil {
+			logger.Error("failed to set cached user", logger.E(err))
+		}
+	}
+	return user, nil
+}
+
+// requestFollow creates a pending follow to a private peer, the follow is not pending if user already follows peer
+func (s *UserService) requestFollow(ctx context.Context, userId, peerId int64) (*model.Follow, error) {
+	f, err := s.dai.GetFollow(ctx, userId, peerId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if f != nil {
+		return f, nil
+	}
+
+	request, err := s.dai.CreateFollowRequest(ctx, userId, peerId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	return &model.Follow{
+		ID:       request.ID,
+		FollowTs: request.CreatedTimestamp,
+		Pending:  true,
+	}, nil
+}
+
+// GetFollowRequests returns pending follow requests to user, latest first.
+// paging.Cursor holds the id of the last request of the previous page, nil means the first page.
+func (s *UserService) GetFollowRequests(ctx context.Context, userId int64, paging *model.Paging) ([]*model.FollowRequest, error) {
+	if paging.Limit <= 0 || paging.Limit > maxFollowRequestsPerPage {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid limit")
+	}
+
+	requests, err := s.dai.GetFollowRequests(ctx, userId, paging)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	for _, request := range requests {
+		request.Requester, err = s.getUserByIDFromCacheOrDb(ctx, request.RequesterID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return requests, nil
+}
+
+// ApproveFollowRequest turns the pending request of requester into a follow to user
+func (s *UserService) ApproveFollowRequest(ctx context.Context, userId, requesterId int64) (*model.Follow, error) {
+	user, err := s.getUserByIDFromCacheOrDb(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	requester, err := s.getUserByIDFromCacheOrDb(ctx, requesterId)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.dai.ApproveFollowRequest(ctx, requesterId, userId)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if f == nil {
+		return nil, common.NewError(common.CodeNotFound, "follow request not found")
+	}
+
+	if s.enabledCache {
+		if err = s.cacheDai.AddCachedFollow(ctx, f); err != nil {
+			logger.Error("failed to set cache grpc", logger.E(err))
+		}
+	}
+
+	f.Follower = requester
+	f.Following = user
+	return f, nil
+}
+
+// RejectFollowRequest removes the pending request of requester to user
+func (s *UserService) RejectFollowRequest(ctx context.Context, userId, requesterId int64) error {
+	return s.deleteFollowRequest(ctx, requesterId, userId)
+}
+
+// CancelFollowRequest removes the pending request of user to peer
+func (s *UserService) CancelFollowRequest(ctx context.Context, userId, peerId int64) error {
+	return s.deleteFollowRequest(ctx, userId, peerId)
+}
+
+func (s *UserService) deleteFollowRequest(ctx context.Context, requesterId, targetId int64) error {
+	deleted, err := s.dai.DeleteFollowRequest(ctx, requesterId, targetId)
+	if err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if !deleted {
+		return common.NewError(common.CodeNotFound, "follow request not found")
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package user_service
+
+import (
+	"context"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+// ImportFollows imports a batch of follows from another system and returns the number of follows created or restored,
+// and the number of follows skipped because one of their users does not exist or one user blocks the other.
+// Counts of users in the batch are recounted and imported follows are added to cached follow sets.
+// Follow msgs are not sent, so newsfeeds of followers are not backfilled with posts of imported followings.
+func (s *UserService) ImportFollows(ctx context.Context, follows []*model.Follow) (int, int, error) {
+	for _, f := range follows {
+		if f.Follower == nil || f.Following == nil || f.Follower.ID <= 0 || f.Following.ID <= 0 || f.Follower.ID == f.Following.ID {
+			return 0, 0, common.NewError(common.CodeInvalidRequest, "invalid follow")
+		}
+	}
+
+	importable, err := s.filterImportableFollows(ctx, follows)
+	if err != nil {
+		return 0, 0, err
+	}
+	skipped := len(follows) - len(importable)
+	if len(importable) == 0 {
+		return 0, skipped, nil
+	}
+
+	imported, err := s.dai.ImportFollows(ctx, importable)
+	if err != nil {
+		return 0, 0, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if len(imported) == 0 {
+		return 0, skipped, nil
+	}
+
+	if err := s.dai.ReconcileCounts(ctx, followUserIds(importable)); err != nil {
+		return 0, 0, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	// follows are committed already, a cache failure only leaves cached sets incomplete and counts stale
+	if s.enabledCache {
+		if err := s.cacheDai.AddCachedFollows(ctx, imported); err != nil {
+			logger.Error("failed to cache imported follows", logger.E(err))
+		}
+	}
+	return len(imported), skipped, nil
+}
+
+// filterImportableFollows returns follows whose users both exist and do not block each other,
+// checked in db with one query each for the whole batch
+func (s *UserService) filterImportableFollows(ctx context.Context, follows []*model.Follow) ([]*model.Follow, error) {
+	userIds := followUserIds(follows)
+	existingIds, err := s.dai.GetExistingUserIDs(ctx, userIds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	exists := make(map[int64]bool, len(existingIds))
+	for _, id := range existingIds {
+		exists[id] = true
+	}
+
+	blocks, err := s.audienceDai.GetAudienceMembersAmong(ctx, model.AudienceListBlocked, existingIds)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	isBlocked := make(map[[2]int64]bool, len(blocks))
+	for _, block := range blocks {
+		// a block hides both users from each other, so it stops follows in both directions
+		isBlocked[[2]int64{block.OwnerID, block.MemberID}] = true
+		isBlocked[[2]int64{block.MemberID, block.OwnerID}] = true
+	}
+
+	importable := make([]*model.Follow, 0, len(follows))
+	for _, f := range follows {
+		if !exists[f.Follower.ID] || !exists[f.Following.ID] || isBlocked[[2]int64{f.Follower.ID, f.Following.ID}] {
+			logger.Debug("skip follow of missing or blocked user",
+				logger.F("follower_id", f.Follower.ID), logger.F("following_id", f.Following.ID))
+			continue
+		}
+		importable = append(importable, f)
+	}
+	return importable, nil
+}
+
+// followUserIds returns distinct ids of followers and followings of follows
+func followUserIds(follows []*model.Follow) []int64 {
+	seen := make(map[int64]bool)
+	ids := make([]int64, 0)
+	for _, f := range follows {
+		for _, id := range []int64{f.Follower.ID, f.Following.ID} {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// ExportFollows returns a batch of follows having id greater than afterId sorted by id,
+// the id of the last follow is the afterId of the next batch.
+// userId limits follows to those having the user as follower or following, 0 means all users.
+func (s *UserService) ExportFollows(ctx context.Context, userId, afterId int64, batchSize int) ([]*model.Follow, error) {
+	if batchSize <= 0 {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid batch size")
+	}
+
+	follows, err := s.dai.ExportFollows(ctx, userId, afterId, batchSize)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	return follows, nil
+}
@@ -0,0 +1,103 @@
+package user_service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"time"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+const (
+	defaultPasswordResetTTL = time.Hour
+
+	minPasswordLength = 8
+)
+
+// RequestPasswordReset mails a reset token to each user having the email.
+// It responds the same whether the email is registered or not, so failing to mail a known user is only logged.
+func (s *UserService) RequestPasswordReset(ctx context.Context, email string) error {
+	users, err := s.dai.GetByEmail(ctx, email)
+	if err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if len(users) == 0 {
+		logger.Debug("password reset of unknown email is skipped")
+		return nil
+	}
+
+	for _, user := range users {
+		if err := s.sendPasswordReset(ctx, user); err != nil {
+			logger.Error("failed to send password reset", logger.E(err), logger.F("user_id", user.ID))
+			continue
+		}
+		logger.Info("sent password reset mail", logger.F("user_id", user.ID))
+	}
+	return nil
+}
+
+// ConfirmPasswordReset sets a new password with a reset token, the token can be used once.
+// All sessions of the user are revoked, so whoever knew the old password is logged out.
+func (s *UserService) ConfirmPasswordReset(ctx context.Context, resetToken string, newPassword string) error {
+	if len(newPassword) < minPasswordLength {
+		return common.NewError(common.CodeInvalidRequest, fmt.Sprintf("password must have at least %d characters", minPasswordLength))
+	}
+
+	hashedPassword, err := hashPassword(newPassword)
+	if err != nil {
+		return common.WrapError(common.CodeInternal, "hash password error", err)
+	}
+
+	userId, err := s.dai.ResetPassword(ctx, hashToken(resetToken), hashedPassword)
+	if err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if userId == 0 {
+		return common.NewError(common.CodeInvalidRequest, "reset token is invalid or expired")
+	}
+
+	logger.Info("reset password", logger.F("user_id", userId))
+	return nil
+}
+
+func (s *UserService) sendPasswordReset(ctx context.Context, user *model.User) error {
+	b, err := randomBytes(32)
+	if err != nil {
+		return err
+	}
+	resetToken := base64.RawURLEncoding.EncodeToString(b)
+
+	now := time.Now()
+	err = s.dai.CreatePasswordResetToken(ctx, &model.PasswordResetToken{
+		UserID:           user.ID,
+		TokenHash:        hashToken(resetToken),
+		CreatedTimestamp: now.Unix(),
+		ExpiresTimestamp: now.Add(s.cfg.PasswordResetTTL).Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	return s.mailer.Send(ctx, s.passwordResetMail(user, resetToken))
+}
+
+func (s *UserService) passwordResetMail(user *model.User, resetToken string) *model.Mail {
+	// without reset page, the token is sent as is to be posted to the confirm reset api
+	what, link := "token", resetToken
+	if len(s.cfg.PasswordResetURL) > 0 {
+		what, link = "link", s.cfg.PasswordResetURL+"?token="+url.QueryEscape(resetToken)
+	}
+
+	return &model.Mail{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\n"+
+			"Someone asked to reset the password of your account %s. Use this %s to set a new password:\n\n"+
+			"%s\n\n"+
+			"It expires in %s and can be used once. If you did not ask for it, you can ignore this mail.\n",
+			user.DisplayName, user.Username, what, link, s.cfg.PasswordResetTTL),
+	}
+}
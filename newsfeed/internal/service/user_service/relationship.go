@@ -0,0 +1,106 @@
+package user_service
+
+import (
+	"context"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+const maxRelationshipsPerRequest = 100
+
+// GetRelationships returns relationships between viewer and each of given users, in the same order.
+// They are looked up in cache, follows and blocks fall back to db if the cached sets of viewer are not complete.
+func (s *UserService) GetRelationships(ctx context.Context, viewerId int64, userIds []int64) ([]*model.Relationship, error) {
+	if len(userIds) == 0 || len(userIds) > maxRelationshipsPerRequest {
+		return nil, common.NewError(common.CodeInvalidRequest, "invalid number of user ids")
+	}
+
+	if s.enabledCache {
+		relationships, followsCached, err := s.cacheDai.GetRelationships(ctx, viewerId, userIds)
+		if err != nil {
+			logger.Error("failed to get relationships from cache", logger.E(err))
+		} else {
+			if !followsCached {
+				if err := s.loadFollowRelationships(ctx, viewerId, relationships); err != nil {
+					return nil, err
+				}
+			}
+			// cached block sets may miss blocks after cache loss until they are rebuilt
+			blocksCached, err := s.cacheDai.IsAudienceCached(ctx, viewerId)
+			if err != nil || !blocksCached {
+				if err := s.loadBlockRelationships(ctx, viewerId, relationships); err != nil {
+					return nil, err
+				}
+			}
+			return relationships, nil
+		}
+	}
+
+	relationships := make([]*model.Relationship, len(userIds))
+	for i := range userIds {
+		relationships[i] = &model.Relationship{UserID: userIds[i]}
+	}
+	if err := s.loadFollowRelationships(ctx, viewerId, relationships); err != nil {
+		return nil, err
+	}
+	if err := s.loadBlockRelationships(ctx, viewerId, relationships); err != nil {
+		return nil, err
+	}
+	return relationships, nil
+}
+
+// loadFollowRelationships sets follows of relationships from db in one query
+func (s *UserService) loadFollowRelationships(ctx context.Context, viewerId int64, relationships []*model.Relationship) error {
+	follows, err := s.dai.GetFollowsBetween(ctx, viewerId, relationshipUserIds(relationships))
+	if err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	followingIds := make(map[int64]bool)
+	followerIds := make(map[int64]bool)
+	for _, f := range follows {
+		if f.Follower.ID == viewerId {
+			followingIds[f.Following.ID] = true
+		} else {
+			followerIds[f.Follower.ID] = true
+		}
+	}
+	for _, relationship := range relationships {
+		relationship.Following = followingIds[relationship.UserID]
+		relationship.FollowedBy = followerIds[relationship.UserID]
+	}
+	return nil
+}
+
+// loadBlockRelationships sets blocks of relationships from db in one query
+func (s *UserService) loadBlockRelationships(ctx context.Context, viewerId int64, relationships []*model.Relationship) error {
+	blocks, err := s.audienceDai.GetAudienceMembersBetween(ctx, viewerId, model.AudienceListBlocked, relationshipUserIds(relationships))
+	if err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	blockedIds := make(map[int64]bool)
+	blockingIds := make(map[int64]bool)
+	for _, block := range blocks {
+		if block.OwnerID == viewerId {
+			blockedIds[block.MemberID] = true
+		} else {
+			blockingIds[block.OwnerID] = true
+		}
+	}
+	for _, relationship := range relationships {
+		relationship.Blocking = blockedIds[relationship.UserID]
+		relationship.BlockedBy = blockingIds[relationship.UserID]
+	}
+	return nil
+}
+
+func relationshipUserIds(relationships []*model.Relationship) []int64 {
+	ids := make([]int64, len(relationships))
+	for i := range relationships {
+		ids[i] = relationships[i].UserID
+	}
+	return ids
+}
@@ -0,0 +1,154 @@
+package user_service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"ep.k16/newsfeed/internal/common"
+	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+const defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+// RefreshSession exchanges a refresh token for the next token of its session.
+// Presenting a token which was exchanged already revokes the session, since the token was leaked
+// and either the user or the attacker holds the next one.
+func (s *UserService) RefreshSession(ctx context.Context, refreshToken string) (*model.Session, error) {
+	tokenHash := hashToken(refreshToken)
+	token, err := s.dai.GetRefreshToken(ctx, tokenHash)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if token == nil {
+		return nil, common.NewError(common.CodeUnauthorized, "invalid refresh token")
+	}
+
+	session, err := s.dai.GetSession(ctx, token.SessionID)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if session == nil || session.Revoked {
+		return nil, common.NewError(common.CodeUnauthorized, "session is revoked")
+	}
+	if token.Used {
+		return nil, s.revokeReusedSession(ctx, session)
+	}
+	if token.ExpiresTimestamp <= time.Now().Unix() {
+		return nil, common.NewError(common.CodeUnauthorized, "refresh token is expired")
+	}
+
+	next, nextToken, err := s.newRefreshToken(session.ID)
+	if err != nil {
+		return nil, err
+	}
+	rotated, err := s.dai.RotateRefreshToken(ctx, tokenHash, next)
+	if err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	if !rotated { // a concurrent refresh used the token first
+		return nil, s.revokeReusedSession(ctx, session)
+	}
+
+	user, err := s.getUserByIDFromCacheOrDb(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	session.User = user
+	session.RefreshToken = nextToken
+	session.RefreshExpireTs = next.ExpiresTimestamp
+	return session, nil
+}
+
+// Logout revokes a session of user, other sessions are kept
+func (s *UserService) Logout(ctx context.Context, userId int64, sessionId string) error {
+	if err := s.dai.RevokeSession(ctx, userId, sessionId); err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	return nil
+}
+
+// LogoutAll revokes all sessions of user
+func (s *UserService) LogoutAll(ctx context.Context, userId int64) error {
+	if err := s.dai.RevokeSessions(ctx, userId); err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	return nil
+}
+
+// CheckSession returns whether a session of user is active, access tokens of it are rejected otherwise
+func (s *UserService) CheckSession(ctx context.Context, userId int64, sessionId string) (bool, error) {
+	session, err := s.dai.GetSession(ctx, sessionId)
+	if err != nil {
+		return false, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	return session != nil && session.UserID == userId && !session.Revoked, nil
+}
+
+func (s *UserService) createSession(ctx context.Context, userId int64) (*model.Session, error) {
+	b, err := randomBytes(16)
+	if err != nil {
+		return nil, common.WrapError(common.CodeInternal, "generate session id error", err)
+	}
+	session := &model.Session{
+		ID:               hex.EncodeToString(b), // fits varchar(32) of sessions table
+		UserID:           userId,
+		CreatedTimestamp: time.Now().Unix(),
+	}
+
+	token, refreshToken, err := s.newRefreshToken(session.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.dai.CreateSession(ctx, session, token); err != nil {
+		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	session.RefreshToken = refreshToken
+	session.RefreshExpireTs = token.ExpiresTimestamp
+	return session, nil
+}
+
+// newRefreshToken returns the stored token and the raw token given to client
+func (s *UserService) newRefreshToken(sessionId string) (*model.RefreshToken, string, error) {
+	b, err := randomBytes(32)
+	if err != nil {
+		return nil, "", common.WrapError(common.CodeInternal, "generate refresh token error", err)
+	}
+	refreshToken := base64.RawURLEncoding.EncodeToString(b)
+	return &model.RefreshToken{
+		SessionID:        sessionId,
+		TokenHash:        hashToken(refreshToken),
+		ExpiresTimestamp: time.Now().Add(s.cfg.RefreshTokenTTL).Unix(),
+	}, refreshToken, nil
+}
+
+func (s *UserService) revokeReusedSession(ctx context.Context, session *model.Session) error {
+	logger.Warn("refresh token is reused, revoking session",
+		logger.F("user_id", session.UserID),
+		logger.F("session_id", session.ID),
+	)
+	if err := s.dai.RevokeSession(ctx, session.UserID, session.ID); err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	return common.NewError(common.CodeUnauthorized, "refresh token is reused, session is revoked")
+}
+
+// hashToken hashes a refresh or reset token for storing, tokens are random so a fast hash is enough
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomBytes(size int) ([]byte, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -3,24 +3,59 @@ package user_service
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
 	"ep.k16/newsfeed/internal/common"
 	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
 	"ep.k16/newsfeed/pkg/logger"
 )
 
+const maxFollowsPerPage = 100
+
 type UserDAI interface {
 	Create(ctx context.Context, user *model.User) (*model.User, error)
 	GetByUsername(ctx context.Context, username string) (*model.User, error)
 	GetByID(ctx context.Context, userId int64) (*model.User, error)
+	SetPrivate(ctx context.Context, userId int64, isPrivate bool) error
 
 	Follow(ctx context.Context, userId, peerId int64) (*model.Follow, error)
+	GetFollow(ctx context.Context, userId, peerId int64) (*model.Follow, error)
+	GetFollowsBetween(ctx context.Context, userId int64, peerIds []int64) ([]*model.Follow, error)
 	Unfollow(ctx context.Context, userId int64, peerId int64) error
 
-	GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error)
-	GetFollowers(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error)
+	CreateFollowRequest(ctx context.Context, requesterId, targetId int64) (*model.FollowRequest, error)
+	DeleteFollowRequest(ctx context.Context, requesterId, targetId int64) (bool, error)
+	ApproveFollowRequest(ctx context.Context, requesterId, targetId int64) (*model.Follow, error)
+	GetFollowRequests(ctx context.Context, targetId int64, paging *model.Paging) ([]*model.FollowRequest, error)
+
+	GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error)
+	GetFollowers(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error)
+	GetFollowingTimestamps(ctx context.Context, userId int64) (map[int64]int64, error)
+	GetFollowerTimestamps(ctx context.Context, userId int64) (map[int64]int64, error)
+	ImportFollows(ctx context.Context, follows []*model.Follow) ([]*model.Follow, error)
+	GetExistingUserIDs(ctx context.Context, userIds []int64) ([]int64, error)
+	ExportFollows(ctx context.Context, userId, afterId int64, limit int) ([]*model.Follow, error)
+
+	GetUserIDs(ctx context.Context, afterId int64, limit int) ([]int64, error)
+	GetCounts(ctx context.Context, userId int64) (*model.UserCounts, error)
+	ReconcileCounts(ctx context.Context, userIds []int64) error
+
+	CreateSession(ctx context.Context, session *model.Session, token *model.RefreshToken) error
+	GetSession(ctx context.Context, sessionId string) (*model.Session, error)
+	GetRefreshToken(ctx context.Context, tokenHash string) (*model.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, usedTokenHash string, next *model.RefreshToken) (bool, error)
+	RevokeSession(ctx context.Context, userId int64, sessionId string) error
+	RevokeSessions(ctx context.Context, userId int64) error
+
+	GetByEmail(ctx context.Context, email string) ([]*model.User, error)
+	CreatePasswordResetToken(ctx context.Context, token *model.PasswordResetToken) error
+	ResetPassword(ctx context.Context, tokenHash string, hashedPassword string) (int64, error)
+
+	CreateEmailVerificationToken(ctx context.Context, token *model.EmailVerificationToken) error
+	VerifyEmail(ctx context.Context, tokenHash string) (int64, error)
 }
 
 type UserCacheDAI interface {
@@ -28,20 +63,89 @@ type UserCacheDAI interface {
 	GetCachedUserByID(ctx context.Context, userId int64) (*model.User, error)
 
 	AddCachedFollow(ctx context.Context, follow *model.Follow) error
-	GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error)
+	AddCachedFollows(ctx context.Context, follows []*model.Follow) error
+	RemoveCachedFollow(ctx context.Context, userId, peerId int64) error
+	GetRelationships(ctx context.Context, viewerId int64, userIds []int64) ([]*model.Relationship, bool, error)
+	IsAudienceCached(ctx context.Context, userId int64) (bool, error)
+	GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, []int64, error)
+	GetFollowers(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, []int64, error)
+	IsFollowingsCached(ctx context.Context, userId int64) (bool, error)
+	IsFollowersCached(ctx context.Context, userId int64) (bool, error)
+	LockFollowingsRebuild(ctx context.Context, userId int64) (string, error)
+	LockFollowersRebuild(ctx context.Context, userId int64) (string, error)
+	SetCachedFollowings(ctx context.Context, userId int64, token string, followTsById map[int64]int64) (bool, error)
+	SetCachedFollowers(ctx context.Context, userId int64, token string, followTsById map[int64]int64) (bool, error)
+
+	AddCloseFriend(ctx context.Context, ownerId, memberId int64) error
+	RemoveCloseFriend(ctx context.Context, ownerId, memberId int64) error
+
+	AddBlock(ctx context.Context, userId, peerId int64) error
+	RemoveBlock(ctx context.Context, userId, peerId int64) error
+	AddMute(ctx context.Context, userId, peerId int64) error
+	RemoveMute(ctx context.Context, userId, peerId int64) error
+
+	SetCachedCounts(ctx context.Context, userId int64, counts *model.UserCounts) error
+	GetCachedCounts(ctx context.Context, userId int64) (*model.UserCounts, error)
+	DeleteCachedCounts(ctx context.Context, userIds ...int64) error
+}
+
+type FollowMsgProducer interface {
+	SendUnfollow(ctx context.Context, follow *model.Follow) error
+}
+
+type Mailer interface {
+	Send(ctx context.Context, mail *model.Mail) error
+}
+
+type Config struct {
+	RefreshTokenTTL time.Duration // a session ends if it is not refreshed within this duration
+
+	PasswordResetTTL time.Duration // lifetime of password reset tokens
+	PasswordResetURL string        // page setting a new password, reset token is appended as token query param
+
+	EmailVerificationTTL    time.Duration // lifetime of email verification tokens
+	EmailVerificationURL    string        // page verifying an email, verification token is appended as token query param
+	UnverifiedDeniedActions []string      // actions denied until email is verified, e.g. model.ActionPost
 }
 
 type UserService struct {
-	dai UserDAI
+	cfg Config
+
+	dai               UserDAI
+	audienceDai       AudienceDAI
+	followMsgProducer FollowMsgProducer
+	mailer            Mailer
 
 	enabledCache bool
 	cacheDai     UserCacheDAI
+
+	unverifiedDenied map[string]bool
 }
 
-func New(userDai UserDAI, userCacheDai UserCacheDAI) (*UserService, error) {
+func New(cfg Config, userDai UserDAI, userCacheDai UserCacheDAI, audienceDai AudienceDAI, followMsgProducer FollowMsgProducer, mailer Mailer) (*UserService, error) {
+	if cfg.RefreshTokenTTL <= 0 {
+		cfg.RefreshTokenTTL = defaultRefreshTokenTTL
+	}
+	if cfg.PasswordResetTTL <= 0 {
+		cfg.PasswordResetTTL = defaultPasswordResetTTL
+	}
+	if cfg.EmailVerificationTTL <= 0 {
+		cfg.EmailVerificationTTL = defaultEmailVerificationTTL
+	}
+
+	unverifiedDenied, err := toActionSet(cfg.UnverifiedDeniedActions)
+	if err != nil {
+		return nil, err
+	}
+
 	svc := &UserService{
-		dai:      userDai,
-		cacheDai: userCacheDai,
+		cfg:               cfg,
+		dai:               userDai,
+		audienceDai:       audienceDai,
+		followMsgProducer: followMsgProducer,
+		mailer:            mailer,
+		cacheDai:          userCacheDai,
+		unverifiedDenied:  unverifiedDenied,
 	}
 
 	if userCacheDai == nil || reflect.ValueOf(userCacheDai).IsNil() {
@@ -79,11 +183,17 @@ func (s *UserService) Signup(ctx context.Context, user *model.User) (*model.User
 		}
 	}
 
+	// the user can ask for another mail, so failing to send it should not fail the signup
+	if err := s.sendEmailVerification(ctx, res); err != nil {
+		logger.Error("failed to send email verification", logger.E(err), logger.F("user_id", res.ID))
+	}
+
 	user = res
 	return user, nil
 }
 
-func (s *UserService) Login(ctx context.Context, user *model.User) (*model.User, error) {
+// Login checks username and password, then starts a new session of the user
+func (s *UserService) Login(ctx context.Context, user *model.User) (*model.Session, error) {
 	existedUser, err := s.dai.GetByUsername(ctx, user.Username)
 	if err != nil {
 		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
@@ -97,7 +207,18 @@ func (s *UserService) Login(ctx context.Context, user *model.User) (*model.User,
 		return nil, common.NewError(common.CodeInvalidLogin, "username or password is wrong")
 	}
 
-	return existedUser, nil
+	// counts are only shown on profile, so failing to load them should not fail the login
+	existedUser.Counts, err = s.getCountsFromCacheOrDb(ctx, existedUser.ID)
+	if err != nil {
+		logger.Error("failed to get counts", logger.E(err), logger.F("user_id", existedUser.ID))
+	}
+
+	session, err := s.createSession(ctx, existedUser.ID)
+	if err != nil {
+		return nil, err
+	}
+	session.User = existedUser
+	return session, nil
 }
 
 func hashPassword(password string) (string, error) {
@@ -113,7 +234,12 @@ func checkPassword(hash, password string) bool {
 	return err == nil
 }
 
+// Follow follows peer instantly if peer is public, otherwise it requests to follow and the returned follow is pending
 func (s *UserService) Follow(ctx context.Context, userId, peerId int64) (*model.Follow, error) {
+	if userId == peerId {
+		return nil, common.NewError(common.CodeInvalidRequest, "cannot follow yourself")
+	}
+
 	user, err := s.getUserByIDFromCacheOrDb(ctx, userId)
 	if err != nil {
 		return nil, err
@@ -124,7 +250,20 @@ func (s *UserService) Follow(ctx context.Context, userId, peerId int64) (*model.
 		return nil, err
 	}
 
-	f, err := s.follow(ctx, userId, peerId)
+	blocked, err := s.isBlocked(ctx, userId, peerId)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, common.NewError(common.CodeForbidden, "cannot follow blocked user")
+	}
+
+	var f *model.Follow
+	if peer.IsPrivate {
+		f, err = s.requestFollow(ctx, userId, peerId)
+	} else {
+		f, err = s.follow(ctx, userId, peerId)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -134,26 +273,72 @@ func (s *UserService) Follow(ctx context.Context, userId, peerId int64) (*model.
 	return f, nil
 }
 
+// Unfollow removes the follow from db and cache, then newsfeed worker purges posts of peer from newsfeed of user
 func (s *UserService) Unfollow(ctx context.Context, userId, peerId int64) error {
-	return common.NewError(common.CodeNotImplemented, "not implemented")
-}
+	user, err := s.getUserByIDFromCacheOrDb(ctx, userId)
+	if err != nil {
+		return err
+	}
 
-func (s *UserService) GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error) {
-	_, err := s.getUserByIDFromCacheOrDb(ctx, userId)
+	peer, err := s.getUserByIDFromCacheOrDb(ctx, peerId)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	return s.unfollow(ctx, user, peer)
+}
+
+func (s *UserService) unfollow(ctx context.Context, user, peer *model.User) error {
+	if err := s.dai.Unfollow(ctx, user.ID, peer.ID); err != nil {
+		return common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+
+	// fan-out and newsfeed read newsfeed relations from cache, so the follow must be removed there too
+	if s.enabledCache {
+		if err := s.cacheDai.RemoveCachedFollow(ctx, user.ID, peer.ID); err != nil {
+			logger.Error("failed to remove cached follow", logger.E(err))
+		}
+	}
+
+	// newsfeed is cleaned asynchronously by newsfeed worker, so failing to send msg should not fail the request
+	follow := &model.Follow{
+		Follower:  user,
+		Following: peer,
+		FollowTs:  time.Now().Unix(),
+	}
+	if err := s.followMsgProducer.SendUnfollow(ctx, follow); err != nil {
+		logger.Error("failed to send unfollow", logger.E(err), logger.F("user_id", user.ID), logger.F("peer_id", peer.ID))
+	}
+	return nil
+}
+
+// GetFollowings returns a page of followings of user and the cursor of the next page, nil on the last page.
+// The cursor is taken before follows of removed users are skipped, so a short page does not end the list.
+func (s *UserService) GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error) {
+	if paging.Limit <= 0 || paging.Limit > maxFollowsPerPage {
+		return nil, nil, common.NewError(common.CodeInvalidRequest, "invalid limit")
 	}
 
-	followings, err := s.getFollowingsFromCacheOrDb(ctx, userId, paging)
+	_, err := s.getUserByIDFromCacheOrDb(ctx, userId)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return followings, nil
+	return s.getFollowingsFromCacheOrDb(ctx, userId, paging)
 }
 
-func (s *UserService) GetFollowers(ctx context.Context, userId int64, paging *model.Paging) ([]*model.User, error) {
-	return nil, common.NewError(common.CodeNotImplemented, "not implemented yet")
+// GetFollowers returns a page of followers of user, same as GetFollowings
+func (s *UserService) GetFollowers(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error) {
+	if paging.Limit <= 0 || paging.Limit > maxFollowsPerPage {
+		return nil, nil, common.NewError(common.CodeInvalidRequest, "invalid limit")
+	}
+
+	_, err := s.getUserByIDFromCacheOrDb(ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.getFollowersFromCacheOrDb(ctx, userId, paging)
 }
 
 func (s *UserService) getUserByIDFromCacheOrDb(ctx context.Context, userId int64) (*model.User, error) {
@@ -183,10 +368,14 @@ func (s *UserService) getUserByIDFromCacheOrDb(ctx context.Context, userId int64
 }
 
 func (s *UserService) follow(ctx context.Context, userId, peerId int64) (*model.Follow, error) {
+	// a block committed after the check in Follow is checked again in the follow transaction
 	f, err := s.dai.Follow(ctx, userId, peerId)
 	if err != nil {
 		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
 	}
+	if f == nil {
+		return nil, common.NewError(common.CodeForbidden, "cannot follow blocked user")
+	}
 
 	if s.enabledCache {
 		if err = s.cacheDai.AddCachedFollow(ctx, f); err != nil {
@@ -197,26 +386,42 @@ func (s *UserService) follow(ctx context.Context, userId, peerId int64) (*model.
 	return f, nil
 }
 
-func (s *UserService) getFollowingsFromCacheOrDb(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error) {
-	var (
-		followings []*model.Follow
-		err        error
-	)
+func (s *UserService) getFollowingsFromCacheOrDb(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error) {
 	if s.enabledCache {
-		followings, err = s.cacheDai.GetFollowings(ctx, userId, paging)
+		followings, next, err := s.getFollowingsFromCache(ctx, userId, paging)
 		if err != nil {
 			logger.Error("failed to get followings from cache", logger.E(err))
 		}
-		if len(followings) > 0 {
+		if followings != nil {
 			logger.Debug("get followings from cache")
-			return followings, nil
+			return followings, next, nil
 		}
 	}
 
-	followings, err = s.dai.GetFollowings(ctx, userId, paging)
+	followings, next, err := s.dai.GetFollowings(ctx, userId, paging)
 	if err != nil {
-		return nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+		return nil, nil, common.WrapError(common.CodeDatabaseError, "database error", err)
 	}
 	logger.Debug("get followings from DB")
-	return followings, nil
+	return followings, next, nil
+}
+
+func (s *UserService) getFollowersFromCacheOrDb(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error) {
+	if s.enabledCache {
+		followers, next, err := s.getFollowersFromCache(ctx, userId, paging)
+		if err != nil {
+			logger.Error("failed to get followers from cache", logger.E(err))
+		}
+		if followers != nil {
+			logger.Debug("get followers from cache")
+			return followers, next, nil
+		}
+	}
+
+	followers, next, err := s.dai.GetFollowers(ctx, userId, paging)
+	if err != nil {
+		return nil, nil, common.WrapError(common.CodeDatabaseError, "database error", err)
+	}
+	logger.Debug("get followers from DB")
+	return followers, next, nil
 }
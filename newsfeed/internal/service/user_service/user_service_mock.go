@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -38,6 +39,80 @@ func (_m *MockUserDAI) EXPECT() *MockUserDAI_Expecter {
 	return &MockUserDAI_Expecter{mock: &_m.Mock}
 }
 
+// ApproveFollowRequest provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) ApproveFollowRequest(ctx context.Context, requesterId int64, targetId int64) (*model.Follow, error) {
+	ret := _mock.Called(ctx, requesterId, targetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveFollowRequest")
+	}
+
+	var r0 *model.Follow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*model.Follow, error)); ok {
+		return returnFunc(ctx, requesterId, targetId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *model.Follow); ok {
+		r0 = returnFunc(ctx, requesterId, targetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, requesterId, targetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_ApproveFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveFollowRequest'
+type MockUserDAI_ApproveFollowRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveFollowRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requesterId int64
+//   - targetId int64
+func (_e *MockUserDAI_Expecter) ApproveFollowRequest(ctx interface{}, requesterId interface{}, targetId interface{}) *MockUserDAI_ApproveFollowRequest_Call {
+	return &MockUserDAI_ApproveFollowRequest_Call{Call: _e.mock.On("ApproveFollowRequest", ctx, requesterId, targetId)}
+}
+
+func (_c *MockUserDAI_ApproveFollowRequest_Call) Run(run func(ctx context.Context, requesterId int64, targetId int64)) *MockUserDAI_ApproveFollowRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_ApproveFollowRequest_Call) Return(follow *model.Follow, err error) *MockUserDAI_ApproveFollowRequest_Call {
+	_c.Call.Return(follow, err)
+	return _c
+}
+
+func (_c *MockUserDAI_ApproveFollowRequest_Call) RunAndReturn(run func(ctx context.Context, requesterId int64, targetId int64) (*model.Follow, error)) *MockUserDAI_ApproveFollowRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockUserDAI
 func (_mock *MockUserDAI) Create(ctx context.Context, user *model.User) (*model.User, error) {
 	ret := _mock.Called(ctx, user)
@@ -106,121 +181,105 @@ func (_c *MockUserDAI_Create_Call) RunAndReturn(run func(ctx context.Context, us
 	return _c
 }
 
-// Follow provides a mock function for the type MockUserDAI
-func (_mock *MockUserDAI) Follow(ctx context.Context, userId int64, peerId int64) (*model.Follow, error) {
-	ret := _mock.Called(ctx, userId, peerId)
+// CreateEmailVerificationToken provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) CreateEmailVerificationToken(ctx context.Context, token *model.EmailVerificationToken) error {
+	ret := _mock.Called(ctx, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Follow")
+		panic("no return value specified for CreateEmailVerificationToken")
 	}
 
-	var r0 *model.Follow
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*model.Follow, error)); ok {
-		return returnFunc(ctx, userId, peerId)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *model.Follow); ok {
-		r0 = returnFunc(ctx, userId, peerId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Follow)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
-		r1 = returnFunc(ctx, userId, peerId)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.EmailVerificationToken) error); ok {
+		r0 = returnFunc(ctx, token)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockUserDAI_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
-type MockUserDAI_Follow_Call struct {
+// MockUserDAI_CreateEmailVerificationToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEmailVerificationToken'
+type MockUserDAI_CreateEmailVerificationToken_Call struct {
 	*mock.Call
 }
 
-// Follow is a helper method to define mock.On call
+// CreateEmailVerificationToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId int64
-//   - peerId int64
-func (_e *MockUserDAI_Expecter) Follow(ctx interface{}, userId interface{}, peerId interface{}) *MockUserDAI_Follow_Call {
-	return &MockUserDAI_Follow_Call{Call: _e.mock.On("Follow", ctx, userId, peerId)}
+//   - token *model.EmailVerificationToken
+func (_e *MockUserDAI_Expecter) CreateEmailVerificationToken(ctx interface{}, token interface{}) *MockUserDAI_CreateEmailVerificationToken_Call {
+	return &MockUserDAI_CreateEmailVerificationToken_Call{Call: _e.mock.On("CreateEmailVerificationToken", ctx, token)}
 }
 
-func (_c *MockUserDAI_Follow_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserDAI_Follow_Call {
+func (_c *MockUserDAI_CreateEmailVerificationToken_Call) Run(run func(ctx context.Context, token *model.EmailVerificationToken)) *MockUserDAI_CreateEmailVerificationToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 *model.EmailVerificationToken
 		if args[1] != nil {
-			arg1 = args[1].(int64)
-		}
-		var arg2 int64
-		if args[2] != nil {
-			arg2 = args[2].(int64)
+			arg1 = args[1].(*model.EmailVerificationToken)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserDAI_Follow_Call) Return(follow *model.Follow, err error) *MockUserDAI_Follow_Call {
-	_c.Call.Return(follow, err)
+func (_c *MockUserDAI_CreateEmailVerificationToken_Call) Return(err error) *MockUserDAI_CreateEmailVerificationToken_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockUserDAI_Follow_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) (*model.Follow, error)) *MockUserDAI_Follow_Call {
+func (_c *MockUserDAI_CreateEmailVerificationToken_Call) RunAndReturn(run func(ctx context.Context, token *model.EmailVerificationToken) error) *MockUserDAI_CreateEmailVerificationToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockUserDAI
-func (_mock *MockUserDAI) GetByID(ctx context.Context, userId int64) (*model.User, error) {
-	ret := _mock.Called(ctx, userId)
+// CreateFollowRequest provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) CreateFollowRequest(ctx context.Context, requesterId int64, targetId int64) (*model.FollowRequest, error) {
+	ret := _mock.Called(ctx, requesterId, targetId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for CreateFollowRequest")
 	}
 
-	var r0 *model.User
+	var r0 *model.FollowRequest
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.User, error)); ok {
-		return returnFunc(ctx, userId)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*model.FollowRequest, error)); ok {
+		return returnFunc(ctx, requesterId, targetId)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.User); ok {
-		r0 = returnFunc(ctx, userId)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *model.FollowRequest); ok {
+		r0 = returnFunc(ctx, requesterId, targetId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.User)
+			r0 = ret.Get(0).(*model.FollowRequest)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, userId)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, requesterId, targetId)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserDAI_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockUserDAI_GetByID_Call struct {
+// MockUserDAI_CreateFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFollowRequest'
+type MockUserDAI_CreateFollowRequest_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// CreateFollowRequest is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId int64
-func (_e *MockUserDAI_Expecter) GetByID(ctx interface{}, userId interface{}) *MockUserDAI_GetByID_Call {
-	return &MockUserDAI_GetByID_Call{Call: _e.mock.On("GetByID", ctx, userId)}
+//   - requesterId int64
+//   - targetId int64
+func (_e *MockUserDAI_Expecter) CreateFollowRequest(ctx interface{}, requesterId interface{}, targetId interface{}) *MockUserDAI_CreateFollowRequest_Call {
+	return &MockUserDAI_CreateFollowRequest_Call{Call: _e.mock.On("CreateFollowRequest", ctx, requesterId, targetId)}
 }
 
-func (_c *MockUserDAI_GetByID_Call) Run(run func(ctx context.Context, userId int64)) *MockUserDAI_GetByID_Call {
+func (_c *MockUserDAI_CreateFollowRequest_Call) Run(run func(ctx context.Context, requesterId int64, targetId int64)) *MockUserDAI_CreateFollowRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -230,73 +289,67 @@ func (_c *MockUserDAI_GetByID_Call) Run(run func(ctx context.Context, userId int
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserDAI_GetByID_Call) Return(user *model.User, err error) *MockUserDAI_GetByID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockUserDAI_CreateFollowRequest_Call) Return(followRequest *model.FollowRequest, err error) *MockUserDAI_CreateFollowRequest_Call {
+	_c.Call.Return(followRequest, err)
 	return _c
 }
 
-func (_c *MockUserDAI_GetByID_Call) RunAndReturn(run func(ctx context.Context, userId int64) (*model.User, error)) *MockUserDAI_GetByID_Call {
+func (_c *MockUserDAI_CreateFollowRequest_Call) RunAndReturn(run func(ctx context.Context, requesterId int64, targetId int64) (*model.FollowRequest, error)) *MockUserDAI_CreateFollowRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByUsername provides a mock function for the type MockUserDAI
-func (_mock *MockUserDAI) GetByUsername(ctx context.Context, username string) (*model.User, error) {
-	ret := _mock.Called(ctx, username)
+// CreatePasswordResetToken provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) CreatePasswordResetToken(ctx context.Context, token *model.PasswordResetToken) error {
+	ret := _mock.Called(ctx, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByUsername")
+		panic("no return value specified for CreatePasswordResetToken")
 	}
 
-	var r0 *model.User
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.User, error)); ok {
-		return returnFunc(ctx, username)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.User); ok {
-		r0 = returnFunc(ctx, username)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.User)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, username)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.PasswordResetToken) error); ok {
+		r0 = returnFunc(ctx, token)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockUserDAI_GetByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUsername'
-type MockUserDAI_GetByUsername_Call struct {
+// MockUserDAI_CreatePasswordResetToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePasswordResetToken'
+type MockUserDAI_CreatePasswordResetToken_Call struct {
 	*mock.Call
 }
 
-// GetByUsername is a helper method to define mock.On call
+// CreatePasswordResetToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - username string
-func (_e *MockUserDAI_Expecter) GetByUsername(ctx interface{}, username interface{}) *MockUserDAI_GetByUsername_Call {
-	return &MockUserDAI_GetByUsername_Call{Call: _e.mock.On("GetByUsername", ctx, username)}
+//   - token *model.PasswordResetToken
+func (_e *MockUserDAI_Expecter) CreatePasswordResetToken(ctx interface{}, token interface{}) *MockUserDAI_CreatePasswordResetToken_Call {
+	return &MockUserDAI_CreatePasswordResetToken_Call{Call: _e.mock.On("CreatePasswordResetToken", ctx, token)}
 }
 
-func (_c *MockUserDAI_GetByUsername_Call) Run(run func(ctx context.Context, username string)) *MockUserDAI_GetByUsername_Call {
+func (_c *MockUserDAI_CreatePasswordResetToken_Call) Run(run func(ctx context.Context, token *model.PasswordResetToken)) *MockUserDAI_CreatePasswordResetToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *model.PasswordResetToken
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*model.PasswordResetToken)
 		}
 		run(
 			arg0,
@@ -306,70 +359,59 @@ func (_c *MockUserDAI_GetByUsername_Call) Run(run func(ctx context.Context, user
 	return _c
 }
 
-func (_c *MockUserDAI_GetByUsername_Call) Return(user *model.User, err error) *MockUserDAI_GetByUsername_Call {
-	_c.Call.Return(user, err)
+func (_c *MockUserDAI_CreatePasswordResetToken_Call) Return(err error) *MockUserDAI_CreatePasswordResetToken_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockUserDAI_GetByUsername_Call) RunAndReturn(run func(ctx context.Context, username string) (*model.User, error)) *MockUserDAI_GetByUsername_Call {
+func (_c *MockUserDAI_CreatePasswordResetToken_Call) RunAndReturn(run func(ctx context.Context, token *model.PasswordResetToken) error) *MockUserDAI_CreatePasswordResetToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFollowers provides a mock function for the type MockUserDAI
-func (_mock *MockUserDAI) GetFollowers(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error) {
-	ret := _mock.Called(ctx, userId, paging)
+// CreateSession provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) CreateSession(ctx context.Context, session *model.Session, token *model.RefreshToken) error {
+	ret := _mock.Called(ctx, session, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFollowers")
+		panic("no return value specified for CreateSession")
 	}
 
-	var r0 []*model.Follow
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.Follow, error)); ok {
-		return returnFunc(ctx, userId, paging)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.Follow); ok {
-		r0 = returnFunc(ctx, userId, paging)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Follow)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) error); ok {
-		r1 = returnFunc(ctx, userId, paging)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Session, *model.RefreshToken) error); ok {
+		r0 = returnFunc(ctx, session, token)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockUserDAI_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
-type MockUserDAI_GetFollowers_Call struct {
+// MockUserDAI_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type MockUserDAI_CreateSession_Call struct {
 	*mock.Call
 }
 
-// GetFollowers is a helper method to define mock.On call
+// CreateSession is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId int64
-//   - paging *model.Paging
-func (_e *MockUserDAI_Expecter) GetFollowers(ctx interface{}, userId interface{}, paging interface{}) *MockUserDAI_GetFollowers_Call {
-	return &MockUserDAI_GetFollowers_Call{Call: _e.mock.On("GetFollowers", ctx, userId, paging)}
+//   - session *model.Session
+//   - token *model.RefreshToken
+func (_e *MockUserDAI_Expecter) CreateSession(ctx interface{}, session interface{}, token interface{}) *MockUserDAI_CreateSession_Call {
+	return &MockUserDAI_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, session, token)}
 }
 
-func (_c *MockUserDAI_GetFollowers_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockUserDAI_GetFollowers_Call {
+func (_c *MockUserDAI_CreateSession_Call) Run(run func(ctx context.Context, session *model.Session, token *model.RefreshToken)) *MockUserDAI_CreateSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 *model.Session
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(*model.Session)
 		}
-		var arg2 *model.Paging
+		var arg2 *model.RefreshToken
 		if args[2] != nil {
-			arg2 = args[2].(*model.Paging)
+			arg2 = args[2].(*model.RefreshToken)
 		}
 		run(
 			arg0,
@@ -380,58 +422,56 @@ func (_c *MockUserDAI_GetFollowers_Call) Run(run func(ctx context.Context, userI
 	return _c
 }
 
-func (_c *MockUserDAI_GetFollowers_Call) Return(follows []*model.Follow, err error) *MockUserDAI_GetFollowers_Call {
-	_c.Call.Return(follows, err)
+func (_c *MockUserDAI_CreateSession_Call) Return(err error) *MockUserDAI_CreateSession_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockUserDAI_GetFollowers_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error)) *MockUserDAI_GetFollowers_Call {
+func (_c *MockUserDAI_CreateSession_Call) RunAndReturn(run func(ctx context.Context, session *model.Session, token *model.RefreshToken) error) *MockUserDAI_CreateSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFollowings provides a mock function for the type MockUserDAI
-func (_mock *MockUserDAI) GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error) {
-	ret := _mock.Called(ctx, userId, paging)
+// DeleteFollowRequest provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) DeleteFollowRequest(ctx context.Context, requesterId int64, targetId int64) (bool, error) {
+	ret := _mock.Called(ctx, requesterId, targetId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFollowings")
+		panic("no return value specified for DeleteFollowRequest")
 	}
 
-	var r0 []*model.Follow
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.Follow, error)); ok {
-		return returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (bool, error)); ok {
+		return returnFunc(ctx, requesterId, targetId)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.Follow); ok {
-		r0 = returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
+		r0 = returnFunc(ctx, requesterId, targetId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Follow)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) error); ok {
-		r1 = returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, requesterId, targetId)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserDAI_GetFollowings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowings'
-type MockUserDAI_GetFollowings_Call struct {
+// MockUserDAI_DeleteFollowRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFollowRequest'
+type MockUserDAI_DeleteFollowRequest_Call struct {
 	*mock.Call
 }
 
-// GetFollowings is a helper method to define mock.On call
+// DeleteFollowRequest is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId int64
-//   - paging *model.Paging
-func (_e *MockUserDAI_Expecter) GetFollowings(ctx interface{}, userId interface{}, paging interface{}) *MockUserDAI_GetFollowings_Call {
-	return &MockUserDAI_GetFollowings_Call{Call: _e.mock.On("GetFollowings", ctx, userId, paging)}
+//   - requesterId int64
+//   - targetId int64
+func (_e *MockUserDAI_Expecter) DeleteFollowRequest(ctx interface{}, requesterId interface{}, targetId interface{}) *MockUserDAI_DeleteFollowRequest_Call {
+	return &MockUserDAI_DeleteFollowRequest_Call{Call: _e.mock.On("DeleteFollowRequest", ctx, requesterId, targetId)}
 }
 
-func (_c *MockUserDAI_GetFollowings_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockUserDAI_GetFollowings_Call {
+func (_c *MockUserDAI_DeleteFollowRequest_Call) Run(run func(ctx context.Context, requesterId int64, targetId int64)) *MockUserDAI_DeleteFollowRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -441,9 +481,9 @@ func (_c *MockUserDAI_GetFollowings_Call) Run(run func(ctx context.Context, user
 		if args[1] != nil {
 			arg1 = args[1].(int64)
 		}
-		var arg2 *model.Paging
+		var arg2 int64
 		if args[2] != nil {
-			arg2 = args[2].(*model.Paging)
+			arg2 = args[2].(int64)
 		}
 		run(
 			arg0,
@@ -454,47 +494,59 @@ func (_c *MockUserDAI_GetFollowings_Call) Run(run func(ctx context.Context, user
 	return _c
 }
 
-func (_c *MockUserDAI_GetFollowings_Call) Return(follows []*model.Follow, err error) *MockUserDAI_GetFollowings_Call {
-	_c.Call.Return(follows, err)
+func (_c *MockUserDAI_DeleteFollowRequest_Call) Return(b bool, err error) *MockUserDAI_DeleteFollowRequest_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockUserDAI_GetFollowings_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error)) *MockUserDAI_GetFollowings_Call {
+func (_c *MockUserDAI_DeleteFollowRequest_Call) RunAndReturn(run func(ctx context.Context, requesterId int64, targetId int64) (bool, error)) *MockUserDAI_DeleteFollowRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Unfollow provides a mock function for the type MockUserDAI
-func (_mock *MockUserDAI) Unfollow(ctx context.Context, userId int64, peerId int64) error {
-	ret := _mock.Called(ctx, userId, peerId)
+// ExportFollows provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) ExportFollows(ctx context.Context, userId int64, afterId int64, limit int) ([]*model.Follow, error) {
+	ret := _mock.Called(ctx, userId, afterId, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Unfollow")
+		panic("no return value specified for ExportFollows")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
-		r0 = returnFunc(ctx, userId, peerId)
+	var r0 []*model.Follow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, int) ([]*model.Follow, error)); ok {
+		return returnFunc(ctx, userId, afterId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64, int) []*model.Follow); ok {
+		r0 = returnFunc(ctx, userId, afterId, limit)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Follow)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64, int) error); ok {
+		r1 = returnFunc(ctx, userId, afterId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockUserDAI_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
-type MockUserDAI_Unfollow_Call struct {
+// MockUserDAI_ExportFollows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportFollows'
+type MockUserDAI_ExportFollows_Call struct {
 	*mock.Call
 }
 
-// Unfollow is a helper method to define mock.On call
+// ExportFollows is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userId int64
-//   - peerId int64
-func (_e *MockUserDAI_Expecter) Unfollow(ctx interface{}, userId interface{}, peerId interface{}) *MockUserDAI_Unfollow_Call {
-	return &MockUserDAI_Unfollow_Call{Call: _e.mock.On("Unfollow", ctx, userId, peerId)}
+//   - afterId int64
+//   - limit int
+func (_e *MockUserDAI_Expecter) ExportFollows(ctx interface{}, userId interface{}, afterId interface{}, limit interface{}) *MockUserDAI_ExportFollows_Call {
+	return &MockUserDAI_ExportFollows_Call{Call: _e.mock.On("ExportFollows", ctx, userId, afterId, limit)}
 }
 
-func (_c *MockUserDAI_Unfollow_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserDAI_Unfollow_Call {
+func (_c *MockUserDAI_ExportFollows_Call) Run(run func(ctx context.Context, userId int64, afterId int64, limit int)) *MockUserDAI_ExportFollows_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -508,90 +560,153 @@ func (_c *MockUserDAI_Unfollow_Call) Run(run func(ctx context.Context, userId in
 		if args[2] != nil {
 			arg2 = args[2].(int64)
 		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserDAI_Unfollow_Call) Return(err error) *MockUserDAI_Unfollow_Call {
-	_c.Call.Return(err)
+func (_c *MockUserDAI_ExportFollows_Call) Return(follows []*model.Follow, err error) *MockUserDAI_ExportFollows_Call {
+	_c.Call.Return(follows, err)
 	return _c
 }
 
-func (_c *MockUserDAI_Unfollow_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserDAI_Unfollow_Call {
+func (_c *MockUserDAI_ExportFollows_Call) RunAndReturn(run func(ctx context.Context, userId int64, afterId int64, limit int) ([]*model.Follow, error)) *MockUserDAI_ExportFollows_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUserCacheDAI creates a new instance of MockUserCacheDAI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockUserCacheDAI(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockUserCacheDAI {
-	mock := &MockUserCacheDAI{}
-	mock.Mock.Test(t)
+// Follow provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) Follow(ctx context.Context, userId int64, peerId int64) (*model.Follow, error) {
+	ret := _mock.Called(ctx, userId, peerId)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
 
-	return mock
+	var r0 *model.Follow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*model.Follow, error)); ok {
+		return returnFunc(ctx, userId, peerId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *model.Follow); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, userId, peerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockUserCacheDAI is an autogenerated mock type for the UserCacheDAI type
-type MockUserCacheDAI struct {
-	mock.Mock
+// MockUserDAI_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type MockUserDAI_Follow_Call struct {
+	*mock.Call
 }
 
-type MockUserCacheDAI_Expecter struct {
-	mock *mock.Mock
+// Follow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserDAI_Expecter) Follow(ctx interface{}, userId interface{}, peerId interface{}) *MockUserDAI_Follow_Call {
+	return &MockUserDAI_Follow_Call{Call: _e.mock.On("Follow", ctx, userId, peerId)}
 }
 
-func (_m *MockUserCacheDAI) EXPECT() *MockUserCacheDAI_Expecter {
-	return &MockUserCacheDAI_Expecter{mock: &_m.Mock}
+func (_c *MockUserDAI_Follow_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserDAI_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
 }
 
-// AddCachedFollow provides a mock function for the type MockUserCacheDAI
-func (_mock *MockUserCacheDAI) AddCachedFollow(ctx context.Context, follow *model.Follow) error {
-	ret := _mock.Called(ctx, follow)
+func (_c *MockUserDAI_Follow_Call) Return(follow *model.Follow, err error) *MockUserDAI_Follow_Call {
+	_c.Call.Return(follow, err)
+	return _c
+}
+
+func (_c *MockUserDAI_Follow_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) (*model.Follow, error)) *MockUserDAI_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByEmail provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetByEmail(ctx context.Context, email string) ([]*model.User, error) {
+	ret := _mock.Called(ctx, email)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddCachedFollow")
+		panic("no return value specified for GetByEmail")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Follow) error); ok {
-		r0 = returnFunc(ctx, follow)
+	var r0 []*model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*model.User, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*model.User); ok {
+		r0 = returnFunc(ctx, email)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockUserCacheDAI_AddCachedFollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCachedFollow'
-type MockUserCacheDAI_AddCachedFollow_Call struct {
+// MockUserDAI_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
+type MockUserDAI_GetByEmail_Call struct {
 	*mock.Call
 }
 
-// AddCachedFollow is a helper method to define mock.On call
+// GetByEmail is a helper method to define mock.On call
 //   - ctx context.Context
-//   - follow *model.Follow
-func (_e *MockUserCacheDAI_Expecter) AddCachedFollow(ctx interface{}, follow interface{}) *MockUserCacheDAI_AddCachedFollow_Call {
-	return &MockUserCacheDAI_AddCachedFollow_Call{Call: _e.mock.On("AddCachedFollow", ctx, follow)}
+//   - email string
+func (_e *MockUserDAI_Expecter) GetByEmail(ctx interface{}, email interface{}) *MockUserDAI_GetByEmail_Call {
+	return &MockUserDAI_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
 }
 
-func (_c *MockUserCacheDAI_AddCachedFollow_Call) Run(run func(ctx context.Context, follow *model.Follow)) *MockUserCacheDAI_AddCachedFollow_Call {
+func (_c *MockUserDAI_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockUserDAI_GetByEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *model.Follow
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*model.Follow)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -601,22 +716,22 @@ func (_c *MockUserCacheDAI_AddCachedFollow_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *MockUserCacheDAI_AddCachedFollow_Call) Return(err error) *MockUserCacheDAI_AddCachedFollow_Call {
-	_c.Call.Return(err)
+func (_c *MockUserDAI_GetByEmail_Call) Return(users []*model.User, err error) *MockUserDAI_GetByEmail_Call {
+	_c.Call.Return(users, err)
 	return _c
 }
 
-func (_c *MockUserCacheDAI_AddCachedFollow_Call) RunAndReturn(run func(ctx context.Context, follow *model.Follow) error) *MockUserCacheDAI_AddCachedFollow_Call {
+func (_c *MockUserDAI_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) ([]*model.User, error)) *MockUserDAI_GetByEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCachedUserByID provides a mock function for the type MockUserCacheDAI
-func (_mock *MockUserCacheDAI) GetCachedUserByID(ctx context.Context, userId int64) (*model.User, error) {
+// GetByID provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetByID(ctx context.Context, userId int64) (*model.User, error) {
 	ret := _mock.Called(ctx, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCachedUserByID")
+		panic("no return value specified for GetByID")
 	}
 
 	var r0 *model.User
@@ -639,19 +754,19 @@ func (_mock *MockUserCacheDAI) GetCachedUserByID(ctx context.Context, userId int
 	return r0, r1
 }
 
-// MockUserCacheDAI_GetCachedUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCachedUserByID'
-type MockUserCacheDAI_GetCachedUserByID_Call struct {
+// MockUserDAI_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockUserDAI_GetByID_Call struct {
 	*mock.Call
 }
 
-// GetCachedUserByID is a helper method to define mock.On call
+// GetByID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userId int64
-func (_e *MockUserCacheDAI_Expecter) GetCachedUserByID(ctx interface{}, userId interface{}) *MockUserCacheDAI_GetCachedUserByID_Call {
-	return &MockUserCacheDAI_GetCachedUserByID_Call{Call: _e.mock.On("GetCachedUserByID", ctx, userId)}
+func (_e *MockUserDAI_Expecter) GetByID(ctx interface{}, userId interface{}) *MockUserDAI_GetByID_Call {
+	return &MockUserDAI_GetByID_Call{Call: _e.mock.On("GetByID", ctx, userId)}
 }
 
-func (_c *MockUserCacheDAI_GetCachedUserByID_Call) Run(run func(ctx context.Context, userId int64)) *MockUserCacheDAI_GetCachedUserByID_Call {
+func (_c *MockUserDAI_GetByID_Call) Run(run func(ctx context.Context, userId int64)) *MockUserDAI_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -669,128 +784,133 @@ func (_c *MockUserCacheDAI_GetCachedUserByID_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockUserCacheDAI_GetCachedUserByID_Call) Return(user *model.User, err error) *MockUserCacheDAI_GetCachedUserByID_Call {
+func (_c *MockUserDAI_GetByID_Call) Return(user *model.User, err error) *MockUserDAI_GetByID_Call {
 	_c.Call.Return(user, err)
 	return _c
 }
 
-func (_c *MockUserCacheDAI_GetCachedUserByID_Call) RunAndReturn(run func(ctx context.Context, userId int64) (*model.User, error)) *MockUserCacheDAI_GetCachedUserByID_Call {
+func (_c *MockUserDAI_GetByID_Call) RunAndReturn(run func(ctx context.Context, userId int64) (*model.User, error)) *MockUserDAI_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFollowings provides a mock function for the type MockUserCacheDAI
-func (_mock *MockUserCacheDAI) GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error) {
-	ret := _mock.Called(ctx, userId, paging)
+// GetByUsername provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetByUsername(ctx context.Context, username string) (*model.User, error) {
+	ret := _mock.Called(ctx, username)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFollowings")
+		panic("no return value specified for GetByUsername")
 	}
 
-	var r0 []*model.Follow
+	var r0 *model.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.Follow, error)); ok {
-		return returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.User, error)); ok {
+		return returnFunc(ctx, username)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.Follow); ok {
-		r0 = returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.User); ok {
+		r0 = returnFunc(ctx, username)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Follow)
+			r0 = ret.Get(0).(*model.User)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) error); ok {
-		r1 = returnFunc(ctx, userId, paging)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, username)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUserCacheDAI_GetFollowings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowings'
-type MockUserCacheDAI_GetFollowings_Call struct {
+// MockUserDAI_GetByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUsername'
+type MockUserDAI_GetByUsername_Call struct {
 	*mock.Call
 }
 
-// GetFollowings is a helper method to define mock.On call
+// GetByUsername is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId int64
-//   - paging *model.Paging
-func (_e *MockUserCacheDAI_Expecter) GetFollowings(ctx interface{}, userId interface{}, paging interface{}) *MockUserCacheDAI_GetFollowings_Call {
-	return &MockUserCacheDAI_GetFollowings_Call{Call: _e.mock.On("GetFollowings", ctx, userId, paging)}
+//   - username string
+func (_e *MockUserDAI_Expecter) GetByUsername(ctx interface{}, username interface{}) *MockUserDAI_GetByUsername_Call {
+	return &MockUserDAI_GetByUsername_Call{Call: _e.mock.On("GetByUsername", ctx, username)}
 }
 
-func (_c *MockUserCacheDAI_GetFollowings_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockUserCacheDAI_GetFollowings_Call {
+func (_c *MockUserDAI_GetByUsername_Call) Run(run func(ctx context.Context, username string)) *MockUserDAI_GetByUsername_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int64
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(int64)
-		}
-		var arg2 *model.Paging
-		if args[2] != nil {
-			arg2 = args[2].(*model.Paging)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUserCacheDAI_GetFollowings_Call) Return(follows []*model.Follow, err error) *MockUserCacheDAI_GetFollowings_Call {
-	_c.Call.Return(follows, err)
+func (_c *MockUserDAI_GetByUsername_Call) Return(user *model.User, err error) *MockUserDAI_GetByUsername_Call {
+	_c.Call.Return(user, err)
 	return _c
 }
 
-func (_c *MockUserCacheDAI_GetFollowings_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, error)) *MockUserCacheDAI_GetFollowings_Call {
+func (_c *MockUserDAI_GetByUsername_Call) RunAndReturn(run func(ctx context.Context, username string) (*model.User, error)) *MockUserDAI_GetByUsername_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetCachedUser provides a mock function for the type MockUserCacheDAI
-func (_mock *MockUserCacheDAI) SetCachedUser(ctx context.Context, user *model.User) error {
-	ret := _mock.Called(ctx, user)
+// GetCounts provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetCounts(ctx context.Context, userId int64) (*model.UserCounts, error) {
+	ret := _mock.Called(ctx, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetCachedUser")
+		panic("no return value specified for GetCounts")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User) error); ok {
-		r0 = returnFunc(ctx, user)
+	var r0 *model.UserCounts
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.UserCounts, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.UserCounts); ok {
+		r0 = returnFunc(ctx, userId)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserCounts)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockUserCacheDAI_SetCachedUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCachedUser'
-type MockUserCacheDAI_SetCachedUser_Call struct {
+// MockUserDAI_GetCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCounts'
+type MockUserDAI_GetCounts_Call struct {
 	*mock.Call
 }
 
-// SetCachedUser is a helper method to define mock.On call
+// GetCounts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - user *model.User
-func (_e *MockUserCacheDAI_Expecter) SetCachedUser(ctx interface{}, user interface{}) *MockUserCacheDAI_SetCachedUser_Call {
-	return &MockUserCacheDAI_SetCachedUser_Call{Call: _e.mock.On("SetCachedUser", ctx, user)}
+//   - userId int64
+func (_e *MockUserDAI_Expecter) GetCounts(ctx interface{}, userId interface{}) *MockUserDAI_GetCounts_Call {
+	return &MockUserDAI_GetCounts_Call{Call: _e.mock.On("GetCounts", ctx, userId)}
 }
 
-func (_c *MockUserCacheDAI_SetCachedUser_Call) Run(run func(ctx context.Context, user *model.User)) *MockUserCacheDAI_SetCachedUser_Call {
+func (_c *MockUserDAI_GetCounts_Call) Run(run func(ctx context.Context, userId int64)) *MockUserDAI_GetCounts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *model.User
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*model.User)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -800,12 +920,3660 @@ func (_c *MockUserCacheDAI_SetCachedUser_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockUserCacheDAI_SetCachedUser_Call) Return(err error) *MockUserCacheDAI_SetCachedUser_Call {
-	_c.Call.Return(err)
+func (_c *MockUserDAI_GetCounts_Call) Return(userCounts *model.UserCounts, err error) *MockUserDAI_GetCounts_Call {
+	_c.Call.Return(userCounts, err)
 	return _c
 }
 
-func (_c *MockUserCacheDAI_SetCachedUser_Call) RunAndReturn(run func(ctx context.Context, user *model.User) error) *MockUserCacheDAI_SetCachedUser_Call {
+func (_c *MockUserDAI_GetCounts_Call) RunAndReturn(run func(ctx context.Context, userId int64) (*model.UserCounts, error)) *MockUserDAI_GetCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExistingUserIDs provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetExistingUserIDs(ctx context.Context, userIds []int64) ([]int64, error) {
+	ret := _mock.Called(ctx, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExistingUserIDs")
+	}
+
+	var r0 []int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) ([]int64, error)); ok {
+		return returnFunc(ctx, userIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) []int64); ok {
+		r0 = returnFunc(ctx, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = returnFunc(ctx, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_GetExistingUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExistingUserIDs'
+type MockUserDAI_GetExistingUserIDs_Call struct {
+	*mock.Call
+}
+
+// GetExistingUserIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIds []int64
+func (_e *MockUserDAI_Expecter) GetExistingUserIDs(ctx interface{}, userIds interface{}) *MockUserDAI_GetExistingUserIDs_Call {
+	return &MockUserDAI_GetExistingUserIDs_Call{Call: _e.mock.On("GetExistingUserIDs", ctx, userIds)}
+}
+
+func (_c *MockUserDAI_GetExistingUserIDs_Call) Run(run func(ctx context.Context, userIds []int64)) *MockUserDAI_GetExistingUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int64
+		if args[1] != nil {
+			arg1 = args[1].([]int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_GetExistingUserIDs_Call) Return(int64s []int64, err error) *MockUserDAI_GetExistingUserIDs_Call {
+	_c.Call.Return(int64s, err)
+	return _c
+}
+
+func (_c *MockUserDAI_GetExistingUserIDs_Call) RunAndReturn(run func(ctx context.Context, userIds []int64) ([]int64, error)) *MockUserDAI_GetExistingUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollow provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetFollow(ctx context.Context, userId int64, peerId int64) (*model.Follow, error) {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollow")
+	}
+
+	var r0 *model.Follow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) (*model.Follow, error)); ok {
+		return returnFunc(ctx, userId, peerId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) *model.Follow); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = returnFunc(ctx, userId, peerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_GetFollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollow'
+type MockUserDAI_GetFollow_Call struct {
+	*mock.Call
+}
+
+// GetFollow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserDAI_Expecter) GetFollow(ctx interface{}, userId interface{}, peerId interface{}) *MockUserDAI_GetFollow_Call {
+	return &MockUserDAI_GetFollow_Call{Call: _e.mock.On("GetFollow", ctx, userId, peerId)}
+}
+
+func (_c *MockUserDAI_GetFollow_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserDAI_GetFollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollow_Call) Return(follow *model.Follow, err error) *MockUserDAI_GetFollow_Call {
+	_c.Call.Return(follow, err)
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollow_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) (*model.Follow, error)) *MockUserDAI_GetFollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowRequests provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetFollowRequests(ctx context.Context, targetId int64, paging *model.Paging) ([]*model.FollowRequest, error) {
+	ret := _mock.Called(ctx, targetId, paging)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowRequests")
+	}
+
+	var r0 []*model.FollowRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.FollowRequest, error)); ok {
+		return returnFunc(ctx, targetId, paging)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.FollowRequest); ok {
+		r0 = returnFunc(ctx, targetId, paging)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FollowRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) error); ok {
+		r1 = returnFunc(ctx, targetId, paging)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_GetFollowRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowRequests'
+type MockUserDAI_GetFollowRequests_Call struct {
+	*mock.Call
+}
+
+// GetFollowRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - targetId int64
+//   - paging *model.Paging
+func (_e *MockUserDAI_Expecter) GetFollowRequests(ctx interface{}, targetId interface{}, paging interface{}) *MockUserDAI_GetFollowRequests_Call {
+	return &MockUserDAI_GetFollowRequests_Call{Call: _e.mock.On("GetFollowRequests", ctx, targetId, paging)}
+}
+
+func (_c *MockUserDAI_GetFollowRequests_Call) Run(run func(ctx context.Context, targetId int64, paging *model.Paging)) *MockUserDAI_GetFollowRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *model.Paging
+		if args[2] != nil {
+			arg2 = args[2].(*model.Paging)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollowRequests_Call) Return(followRequests []*model.FollowRequest, err error) *MockUserDAI_GetFollowRequests_Call {
+	_c.Call.Return(followRequests, err)
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollowRequests_Call) RunAndReturn(run func(ctx context.Context, targetId int64, paging *model.Paging) ([]*model.FollowRequest, error)) *MockUserDAI_GetFollowRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowerTimestamps provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetFollowerTimestamps(ctx context.Context, userId int64) (map[int64]int64, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowerTimestamps")
+	}
+
+	var r0 map[int64]int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (map[int64]int64, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) map[int64]int64); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_GetFollowerTimestamps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowerTimestamps'
+type MockUserDAI_GetFollowerTimestamps_Call struct {
+	*mock.Call
+}
+
+// GetFollowerTimestamps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockUserDAI_Expecter) GetFollowerTimestamps(ctx interface{}, userId interface{}) *MockUserDAI_GetFollowerTimestamps_Call {
+	return &MockUserDAI_GetFollowerTimestamps_Call{Call: _e.mock.On("GetFollowerTimestamps", ctx, userId)}
+}
+
+func (_c *MockUserDAI_GetFollowerTimestamps_Call) Run(run func(ctx context.Context, userId int64)) *MockUserDAI_GetFollowerTimestamps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollowerTimestamps_Call) Return(int64ToInt64 map[int64]int64, err error) *MockUserDAI_GetFollowerTimestamps_Call {
+	_c.Call.Return(int64ToInt64, err)
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollowerTimestamps_Call) RunAndReturn(run func(ctx context.Context, userId int64) (map[int64]int64, error)) *MockUserDAI_GetFollowerTimestamps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetFollowers(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error) {
+	ret := _mock.Called(ctx, userId, paging)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 []*model.Follow
+	var r1 *cursor.Cursor
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.Follow, *cursor.Cursor, error)); ok {
+		return returnFunc(ctx, userId, paging)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.Follow); ok {
+		r0 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) *cursor.Cursor); ok {
+		r1 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cursor.Cursor)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, *model.Paging) error); ok {
+		r2 = returnFunc(ctx, userId, paging)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserDAI_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type MockUserDAI_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - paging *model.Paging
+func (_e *MockUserDAI_Expecter) GetFollowers(ctx interface{}, userId interface{}, paging interface{}) *MockUserDAI_GetFollowers_Call {
+	return &MockUserDAI_GetFollowers_Call{Call: _e.mock.On("GetFollowers", ctx, userId, paging)}
+}
+
+func (_c *MockUserDAI_GetFollowers_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockUserDAI_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *model.Paging
+		if args[2] != nil {
+			arg2 = args[2].(*model.Paging)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollowers_Call) Return(follows []*model.Follow, cursor *cursor.Cursor, err error) *MockUserDAI_GetFollowers_Call {
+	_c.Call.Return(follows, cursor, err)
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollowers_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error)) *MockUserDAI_GetFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowingTimestamps provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetFollowingTimestamps(ctx context.Context, userId int64) (map[int64]int64, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowingTimestamps")
+	}
+
+	var r0 map[int64]int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (map[int64]int64, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) map[int64]int64); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_GetFollowingTimestamps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowingTimestamps'
+type MockUserDAI_GetFollowingTimestamps_Call struct {
+	*mock.Call
+}
+
+// GetFollowingTimestamps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockUserDAI_Expecter) GetFollowingTimestamps(ctx interface{}, userId interface{}) *MockUserDAI_GetFollowingTimestamps_Call {
+	return &MockUserDAI_GetFollowingTimestamps_Call{Call: _e.mock.On("GetFollowingTimestamps", ctx, userId)}
+}
+
+func (_c *MockUserDAI_GetFollowingTimestamps_Call) Run(run func(ctx context.Context, userId int64)) *MockUserDAI_GetFollowingTimestamps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollowingTimestamps_Call) Return(int64ToInt64 map[int64]int64, err error) *MockUserDAI_GetFollowingTimestamps_Call {
+	_c.Call.Return(int64ToInt64, err)
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollowingTimestamps_Call) RunAndReturn(run func(ctx context.Context, userId int64) (map[int64]int64, error)) *MockUserDAI_GetFollowingTimestamps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowings provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error) {
+	ret := _mock.Called(ctx, userId, paging)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowings")
+	}
+
+	var r0 []*model.Follow
+	var r1 *cursor.Cursor
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.Follow, *cursor.Cursor, error)); ok {
+		return returnFunc(ctx, userId, paging)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.Follow); ok {
+		r0 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) *cursor.Cursor); ok {
+		r1 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cursor.Cursor)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, *model.Paging) error); ok {
+		r2 = returnFunc(ctx, userId, paging)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserDAI_GetFollowings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowings'
+type MockUserDAI_GetFollowings_Call struct {
+	*mock.Call
+}
+
+// GetFollowings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - paging *model.Paging
+func (_e *MockUserDAI_Expecter) GetFollowings(ctx interface{}, userId interface{}, paging interface{}) *MockUserDAI_GetFollowings_Call {
+	return &MockUserDAI_GetFollowings_Call{Call: _e.mock.On("GetFollowings", ctx, userId, paging)}
+}
+
+func (_c *MockUserDAI_GetFollowings_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockUserDAI_GetFollowings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *model.Paging
+		if args[2] != nil {
+			arg2 = args[2].(*model.Paging)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollowings_Call) Return(follows []*model.Follow, cursor *cursor.Cursor, err error) *MockUserDAI_GetFollowings_Call {
+	_c.Call.Return(follows, cursor, err)
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollowings_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, *cursor.Cursor, error)) *MockUserDAI_GetFollowings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowsBetween provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetFollowsBetween(ctx context.Context, userId int64, peerIds []int64) ([]*model.Follow, error) {
+	ret := _mock.Called(ctx, userId, peerIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowsBetween")
+	}
+
+	var r0 []*model.Follow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, []int64) ([]*model.Follow, error)); ok {
+		return returnFunc(ctx, userId, peerIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, []int64) []*model.Follow); ok {
+		r0 = returnFunc(ctx, userId, peerIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = returnFunc(ctx, userId, peerIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_GetFollowsBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowsBetween'
+type MockUserDAI_GetFollowsBetween_Call struct {
+	*mock.Call
+}
+
+// GetFollowsBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerIds []int64
+func (_e *MockUserDAI_Expecter) GetFollowsBetween(ctx interface{}, userId interface{}, peerIds interface{}) *MockUserDAI_GetFollowsBetween_Call {
+	return &MockUserDAI_GetFollowsBetween_Call{Call: _e.mock.On("GetFollowsBetween", ctx, userId, peerIds)}
+}
+
+func (_c *MockUserDAI_GetFollowsBetween_Call) Run(run func(ctx context.Context, userId int64, peerIds []int64)) *MockUserDAI_GetFollowsBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 []int64
+		if args[2] != nil {
+			arg2 = args[2].([]int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollowsBetween_Call) Return(follows []*model.Follow, err error) *MockUserDAI_GetFollowsBetween_Call {
+	_c.Call.Return(follows, err)
+	return _c
+}
+
+func (_c *MockUserDAI_GetFollowsBetween_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerIds []int64) ([]*model.Follow, error)) *MockUserDAI_GetFollowsBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRefreshToken provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetRefreshToken(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefreshToken")
+	}
+
+	var r0 *model.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.RefreshToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.RefreshToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_GetRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefreshToken'
+type MockUserDAI_GetRefreshToken_Call struct {
+	*mock.Call
+}
+
+// GetRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockUserDAI_Expecter) GetRefreshToken(ctx interface{}, tokenHash interface{}) *MockUserDAI_GetRefreshToken_Call {
+	return &MockUserDAI_GetRefreshToken_Call{Call: _e.mock.On("GetRefreshToken", ctx, tokenHash)}
+}
+
+func (_c *MockUserDAI_GetRefreshToken_Call) Run(run func(ctx context.Context, tokenHash string)) *MockUserDAI_GetRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_GetRefreshToken_Call) Return(refreshToken *model.RefreshToken, err error) *MockUserDAI_GetRefreshToken_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *MockUserDAI_GetRefreshToken_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*model.RefreshToken, error)) *MockUserDAI_GetRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSession provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetSession(ctx context.Context, sessionId string) (*model.Session, error) {
+	ret := _mock.Called(ctx, sessionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSession")
+	}
+
+	var r0 *model.Session
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.Session, error)); ok {
+		return returnFunc(ctx, sessionId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.Session); ok {
+		r0 = returnFunc(ctx, sessionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, sessionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_GetSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSession'
+type MockUserDAI_GetSession_Call struct {
+	*mock.Call
+}
+
+// GetSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionId string
+func (_e *MockUserDAI_Expecter) GetSession(ctx interface{}, sessionId interface{}) *MockUserDAI_GetSession_Call {
+	return &MockUserDAI_GetSession_Call{Call: _e.mock.On("GetSession", ctx, sessionId)}
+}
+
+func (_c *MockUserDAI_GetSession_Call) Run(run func(ctx context.Context, sessionId string)) *MockUserDAI_GetSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_GetSession_Call) Return(session *model.Session, err error) *MockUserDAI_GetSession_Call {
+	_c.Call.Return(session, err)
+	return _c
+}
+
+func (_c *MockUserDAI_GetSession_Call) RunAndReturn(run func(ctx context.Context, sessionId string) (*model.Session, error)) *MockUserDAI_GetSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserIDs provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) GetUserIDs(ctx context.Context, afterId int64, limit int) ([]int64, error) {
+	ret := _mock.Called(ctx, afterId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserIDs")
+	}
+
+	var r0 []int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) ([]int64, error)); ok {
+		return returnFunc(ctx, afterId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) []int64); ok {
+		r0 = returnFunc(ctx, afterId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = returnFunc(ctx, afterId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_GetUserIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserIDs'
+type MockUserDAI_GetUserIDs_Call struct {
+	*mock.Call
+}
+
+// GetUserIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - afterId int64
+//   - limit int
+func (_e *MockUserDAI_Expecter) GetUserIDs(ctx interface{}, afterId interface{}, limit interface{}) *MockUserDAI_GetUserIDs_Call {
+	return &MockUserDAI_GetUserIDs_Call{Call: _e.mock.On("GetUserIDs", ctx, afterId, limit)}
+}
+
+func (_c *MockUserDAI_GetUserIDs_Call) Run(run func(ctx context.Context, afterId int64, limit int)) *MockUserDAI_GetUserIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_GetUserIDs_Call) Return(int64s []int64, err error) *MockUserDAI_GetUserIDs_Call {
+	_c.Call.Return(int64s, err)
+	return _c
+}
+
+func (_c *MockUserDAI_GetUserIDs_Call) RunAndReturn(run func(ctx context.Context, afterId int64, limit int) ([]int64, error)) *MockUserDAI_GetUserIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportFollows provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) ImportFollows(ctx context.Context, follows []*model.Follow) ([]*model.Follow, error) {
+	ret := _mock.Called(ctx, follows)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportFollows")
+	}
+
+	var r0 []*model.Follow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*model.Follow) ([]*model.Follow, error)); ok {
+		return returnFunc(ctx, follows)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*model.Follow) []*model.Follow); ok {
+		r0 = returnFunc(ctx, follows)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*model.Follow) error); ok {
+		r1 = returnFunc(ctx, follows)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_ImportFollows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportFollows'
+type MockUserDAI_ImportFollows_Call struct {
+	*mock.Call
+}
+
+// ImportFollows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follows []*model.Follow
+func (_e *MockUserDAI_Expecter) ImportFollows(ctx interface{}, follows interface{}) *MockUserDAI_ImportFollows_Call {
+	return &MockUserDAI_ImportFollows_Call{Call: _e.mock.On("ImportFollows", ctx, follows)}
+}
+
+func (_c *MockUserDAI_ImportFollows_Call) Run(run func(ctx context.Context, follows []*model.Follow)) *MockUserDAI_ImportFollows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*model.Follow
+		if args[1] != nil {
+			arg1 = args[1].([]*model.Follow)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_ImportFollows_Call) Return(follows1 []*model.Follow, err error) *MockUserDAI_ImportFollows_Call {
+	_c.Call.Return(follows1, err)
+	return _c
+}
+
+func (_c *MockUserDAI_ImportFollows_Call) RunAndReturn(run func(ctx context.Context, follows []*model.Follow) ([]*model.Follow, error)) *MockUserDAI_ImportFollows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReconcileCounts provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) ReconcileCounts(ctx context.Context, userIds []int64) error {
+	ret := _mock.Called(ctx, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReconcileCounts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) error); ok {
+		r0 = returnFunc(ctx, userIds)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserDAI_ReconcileCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReconcileCounts'
+type MockUserDAI_ReconcileCounts_Call struct {
+	*mock.Call
+}
+
+// ReconcileCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIds []int64
+func (_e *MockUserDAI_Expecter) ReconcileCounts(ctx interface{}, userIds interface{}) *MockUserDAI_ReconcileCounts_Call {
+	return &MockUserDAI_ReconcileCounts_Call{Call: _e.mock.On("ReconcileCounts", ctx, userIds)}
+}
+
+func (_c *MockUserDAI_ReconcileCounts_Call) Run(run func(ctx context.Context, userIds []int64)) *MockUserDAI_ReconcileCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int64
+		if args[1] != nil {
+			arg1 = args[1].([]int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_ReconcileCounts_Call) Return(err error) *MockUserDAI_ReconcileCounts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserDAI_ReconcileCounts_Call) RunAndReturn(run func(ctx context.Context, userIds []int64) error) *MockUserDAI_ReconcileCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPassword provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) ResetPassword(ctx context.Context, tokenHash string, hashedPassword string) (int64, error) {
+	ret := _mock.Called(ctx, tokenHash, hashedPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPassword")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return returnFunc(ctx, tokenHash, hashedPassword)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = returnFunc(ctx, tokenHash, hashedPassword)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash, hashedPassword)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type MockUserDAI_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+//   - hashedPassword string
+func (_e *MockUserDAI_Expecter) ResetPassword(ctx interface{}, tokenHash interface{}, hashedPassword interface{}) *MockUserDAI_ResetPassword_Call {
+	return &MockUserDAI_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, tokenHash, hashedPassword)}
+}
+
+func (_c *MockUserDAI_ResetPassword_Call) Run(run func(ctx context.Context, tokenHash string, hashedPassword string)) *MockUserDAI_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_ResetPassword_Call) Return(n int64, err error) *MockUserDAI_ResetPassword_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockUserDAI_ResetPassword_Call) RunAndReturn(run func(ctx context.Context, tokenHash string, hashedPassword string) (int64, error)) *MockUserDAI_ResetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) RevokeSession(ctx context.Context, userId int64, sessionId string) error {
+	ret := _mock.Called(ctx, userId, sessionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = returnFunc(ctx, userId, sessionId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserDAI_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockUserDAI_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - sessionId string
+func (_e *MockUserDAI_Expecter) RevokeSession(ctx interface{}, userId interface{}, sessionId interface{}) *MockUserDAI_RevokeSession_Call {
+	return &MockUserDAI_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, userId, sessionId)}
+}
+
+func (_c *MockUserDAI_RevokeSession_Call) Run(run func(ctx context.Context, userId int64, sessionId string)) *MockUserDAI_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_RevokeSession_Call) Return(err error) *MockUserDAI_RevokeSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserDAI_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, userId int64, sessionId string) error) *MockUserDAI_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSessions provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) RevokeSessions(ctx context.Context, userId int64) error {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSessions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserDAI_RevokeSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSessions'
+type MockUserDAI_RevokeSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockUserDAI_Expecter) RevokeSessions(ctx interface{}, userId interface{}) *MockUserDAI_RevokeSessions_Call {
+	return &MockUserDAI_RevokeSessions_Call{Call: _e.mock.On("RevokeSessions", ctx, userId)}
+}
+
+func (_c *MockUserDAI_RevokeSessions_Call) Run(run func(ctx context.Context, userId int64)) *MockUserDAI_RevokeSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_RevokeSessions_Call) Return(err error) *MockUserDAI_RevokeSessions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserDAI_RevokeSessions_Call) RunAndReturn(run func(ctx context.Context, userId int64) error) *MockUserDAI_RevokeSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateRefreshToken provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) RotateRefreshToken(ctx context.Context, usedTokenHash string, next *model.RefreshToken) (bool, error) {
+	ret := _mock.Called(ctx, usedTokenHash, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateRefreshToken")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *model.RefreshToken) (bool, error)); ok {
+		return returnFunc(ctx, usedTokenHash, next)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *model.RefreshToken) bool); ok {
+		r0 = returnFunc(ctx, usedTokenHash, next)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *model.RefreshToken) error); ok {
+		r1 = returnFunc(ctx, usedTokenHash, next)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_RotateRefreshToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateRefreshToken'
+type MockUserDAI_RotateRefreshToken_Call struct {
+	*mock.Call
+}
+
+// RotateRefreshToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usedTokenHash string
+//   - next *model.RefreshToken
+func (_e *MockUserDAI_Expecter) RotateRefreshToken(ctx interface{}, usedTokenHash interface{}, next interface{}) *MockUserDAI_RotateRefreshToken_Call {
+	return &MockUserDAI_RotateRefreshToken_Call{Call: _e.mock.On("RotateRefreshToken", ctx, usedTokenHash, next)}
+}
+
+func (_c *MockUserDAI_RotateRefreshToken_Call) Run(run func(ctx context.Context, usedTokenHash string, next *model.RefreshToken)) *MockUserDAI_RotateRefreshToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *model.RefreshToken
+		if args[2] != nil {
+			arg2 = args[2].(*model.RefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_RotateRefreshToken_Call) Return(b bool, err error) *MockUserDAI_RotateRefreshToken_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserDAI_RotateRefreshToken_Call) RunAndReturn(run func(ctx context.Context, usedTokenHash string, next *model.RefreshToken) (bool, error)) *MockUserDAI_RotateRefreshToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPrivate provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) SetPrivate(ctx context.Context, userId int64, isPrivate bool) error {
+	ret := _mock.Called(ctx, userId, isPrivate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPrivate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, bool) error); ok {
+		r0 = returnFunc(ctx, userId, isPrivate)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserDAI_SetPrivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPrivate'
+type MockUserDAI_SetPrivate_Call struct {
+	*mock.Call
+}
+
+// SetPrivate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - isPrivate bool
+func (_e *MockUserDAI_Expecter) SetPrivate(ctx interface{}, userId interface{}, isPrivate interface{}) *MockUserDAI_SetPrivate_Call {
+	return &MockUserDAI_SetPrivate_Call{Call: _e.mock.On("SetPrivate", ctx, userId, isPrivate)}
+}
+
+func (_c *MockUserDAI_SetPrivate_Call) Run(run func(ctx context.Context, userId int64, isPrivate bool)) *MockUserDAI_SetPrivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_SetPrivate_Call) Return(err error) *MockUserDAI_SetPrivate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserDAI_SetPrivate_Call) RunAndReturn(run func(ctx context.Context, userId int64, isPrivate bool) error) *MockUserDAI_SetPrivate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unfollow provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) Unfollow(ctx context.Context, userId int64, peerId int64) error {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserDAI_Unfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfollow'
+type MockUserDAI_Unfollow_Call struct {
+	*mock.Call
+}
+
+// Unfollow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserDAI_Expecter) Unfollow(ctx interface{}, userId interface{}, peerId interface{}) *MockUserDAI_Unfollow_Call {
+	return &MockUserDAI_Unfollow_Call{Call: _e.mock.On("Unfollow", ctx, userId, peerId)}
+}
+
+func (_c *MockUserDAI_Unfollow_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserDAI_Unfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_Unfollow_Call) Return(err error) *MockUserDAI_Unfollow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserDAI_Unfollow_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserDAI_Unfollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyEmail provides a mock function for the type MockUserDAI
+func (_mock *MockUserDAI) VerifyEmail(ctx context.Context, tokenHash string) (int64, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmail")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserDAI_VerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmail'
+type MockUserDAI_VerifyEmail_Call struct {
+	*mock.Call
+}
+
+// VerifyEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockUserDAI_Expecter) VerifyEmail(ctx interface{}, tokenHash interface{}) *MockUserDAI_VerifyEmail_Call {
+	return &MockUserDAI_VerifyEmail_Call{Call: _e.mock.On("VerifyEmail", ctx, tokenHash)}
+}
+
+func (_c *MockUserDAI_VerifyEmail_Call) Run(run func(ctx context.Context, tokenHash string)) *MockUserDAI_VerifyEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserDAI_VerifyEmail_Call) Return(n int64, err error) *MockUserDAI_VerifyEmail_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockUserDAI_VerifyEmail_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (int64, error)) *MockUserDAI_VerifyEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserCacheDAI creates a new instance of MockUserCacheDAI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserCacheDAI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserCacheDAI {
+	mock := &MockUserCacheDAI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserCacheDAI is an autogenerated mock type for the UserCacheDAI type
+type MockUserCacheDAI struct {
+	mock.Mock
+}
+
+type MockUserCacheDAI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserCacheDAI) EXPECT() *MockUserCacheDAI_Expecter {
+	return &MockUserCacheDAI_Expecter{mock: &_m.Mock}
+}
+
+// AddBlock provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) AddBlock(ctx context.Context, userId int64, peerId int64) error {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBlock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserCacheDAI_AddBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBlock'
+type MockUserCacheDAI_AddBlock_Call struct {
+	*mock.Call
+}
+
+// AddBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserCacheDAI_Expecter) AddBlock(ctx interface{}, userId interface{}, peerId interface{}) *MockUserCacheDAI_AddBlock_Call {
+	return &MockUserCacheDAI_AddBlock_Call{Call: _e.mock.On("AddBlock", ctx, userId, peerId)}
+}
+
+func (_c *MockUserCacheDAI_AddBlock_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserCacheDAI_AddBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_AddBlock_Call) Return(err error) *MockUserCacheDAI_AddBlock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_AddBlock_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserCacheDAI_AddBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddCachedFollow provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) AddCachedFollow(ctx context.Context, follow *model.Follow) error {
+	ret := _mock.Called(ctx, follow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCachedFollow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Follow) error); ok {
+		r0 = returnFunc(ctx, follow)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserCacheDAI_AddCachedFollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCachedFollow'
+type MockUserCacheDAI_AddCachedFollow_Call struct {
+	*mock.Call
+}
+
+// AddCachedFollow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follow *model.Follow
+func (_e *MockUserCacheDAI_Expecter) AddCachedFollow(ctx interface{}, follow interface{}) *MockUserCacheDAI_AddCachedFollow_Call {
+	return &MockUserCacheDAI_AddCachedFollow_Call{Call: _e.mock.On("AddCachedFollow", ctx, follow)}
+}
+
+func (_c *MockUserCacheDAI_AddCachedFollow_Call) Run(run func(ctx context.Context, follow *model.Follow)) *MockUserCacheDAI_AddCachedFollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Follow
+		if args[1] != nil {
+			arg1 = args[1].(*model.Follow)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_AddCachedFollow_Call) Return(err error) *MockUserCacheDAI_AddCachedFollow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_AddCachedFollow_Call) RunAndReturn(run func(ctx context.Context, follow *model.Follow) error) *MockUserCacheDAI_AddCachedFollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddCachedFollows provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) AddCachedFollows(ctx context.Context, follows []*model.Follow) error {
+	ret := _mock.Called(ctx, follows)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCachedFollows")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*model.Follow) error); ok {
+		r0 = returnFunc(ctx, follows)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserCacheDAI_AddCachedFollows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCachedFollows'
+type MockUserCacheDAI_AddCachedFollows_Call struct {
+	*mock.Call
+}
+
+// AddCachedFollows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follows []*model.Follow
+func (_e *MockUserCacheDAI_Expecter) AddCachedFollows(ctx interface{}, follows interface{}) *MockUserCacheDAI_AddCachedFollows_Call {
+	return &MockUserCacheDAI_AddCachedFollows_Call{Call: _e.mock.On("AddCachedFollows", ctx, follows)}
+}
+
+func (_c *MockUserCacheDAI_AddCachedFollows_Call) Run(run func(ctx context.Context, follows []*model.Follow)) *MockUserCacheDAI_AddCachedFollows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*model.Follow
+		if args[1] != nil {
+			arg1 = args[1].([]*model.Follow)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_AddCachedFollows_Call) Return(err error) *MockUserCacheDAI_AddCachedFollows_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_AddCachedFollows_Call) RunAndReturn(run func(ctx context.Context, follows []*model.Follow) error) *MockUserCacheDAI_AddCachedFollows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddCloseFriend provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) AddCloseFriend(ctx context.Context, ownerId int64, memberId int64) error {
+	ret := _mock.Called(ctx, ownerId, memberId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCloseFriend")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, ownerId, memberId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserCacheDAI_AddCloseFriend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCloseFriend'
+type MockUserCacheDAI_AddCloseFriend_Call struct {
+	*mock.Call
+}
+
+// AddCloseFriend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerId int64
+//   - memberId int64
+func (_e *MockUserCacheDAI_Expecter) AddCloseFriend(ctx interface{}, ownerId interface{}, memberId interface{}) *MockUserCacheDAI_AddCloseFriend_Call {
+	return &MockUserCacheDAI_AddCloseFriend_Call{Call: _e.mock.On("AddCloseFriend", ctx, ownerId, memberId)}
+}
+
+func (_c *MockUserCacheDAI_AddCloseFriend_Call) Run(run func(ctx context.Context, ownerId int64, memberId int64)) *MockUserCacheDAI_AddCloseFriend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_AddCloseFriend_Call) Return(err error) *MockUserCacheDAI_AddCloseFriend_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_AddCloseFriend_Call) RunAndReturn(run func(ctx context.Context, ownerId int64, memberId int64) error) *MockUserCacheDAI_AddCloseFriend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddMute provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) AddMute(ctx context.Context, userId int64, peerId int64) error {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserCacheDAI_AddMute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMute'
+type MockUserCacheDAI_AddMute_Call struct {
+	*mock.Call
+}
+
+// AddMute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserCacheDAI_Expecter) AddMute(ctx interface{}, userId interface{}, peerId interface{}) *MockUserCacheDAI_AddMute_Call {
+	return &MockUserCacheDAI_AddMute_Call{Call: _e.mock.On("AddMute", ctx, userId, peerId)}
+}
+
+func (_c *MockUserCacheDAI_AddMute_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserCacheDAI_AddMute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_AddMute_Call) Return(err error) *MockUserCacheDAI_AddMute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_AddMute_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserCacheDAI_AddMute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCachedCounts provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) DeleteCachedCounts(ctx context.Context, userIds ...int64) error {
+	var tmpRet mock.Arguments
+	if len(userIds) > 0 {
+		tmpRet = _mock.Called(ctx, userIds)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCachedCounts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...int64) error); ok {
+		r0 = returnFunc(ctx, userIds...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserCacheDAI_DeleteCachedCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCachedCounts'
+type MockUserCacheDAI_DeleteCachedCounts_Call struct {
+	*mock.Call
+}
+
+// DeleteCachedCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIds ...int64
+func (_e *MockUserCacheDAI_Expecter) DeleteCachedCounts(ctx interface{}, userIds ...interface{}) *MockUserCacheDAI_DeleteCachedCounts_Call {
+	return &MockUserCacheDAI_DeleteCachedCounts_Call{Call: _e.mock.On("DeleteCachedCounts",
+		append([]interface{}{ctx}, userIds...)...)}
+}
+
+func (_c *MockUserCacheDAI_DeleteCachedCounts_Call) Run(run func(ctx context.Context, userIds ...int64)) *MockUserCacheDAI_DeleteCachedCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int64
+		var variadicArgs []int64
+		if len(args) > 1 {
+			variadicArgs = args[1].([]int64)
+		}
+		arg1 = variadicArgs
+		run(
+			arg0,
+			arg1...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_DeleteCachedCounts_Call) Return(err error) *MockUserCacheDAI_DeleteCachedCounts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_DeleteCachedCounts_Call) RunAndReturn(run func(ctx context.Context, userIds ...int64) error) *MockUserCacheDAI_DeleteCachedCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCachedCounts provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) GetCachedCounts(ctx context.Context, userId int64) (*model.UserCounts, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCachedCounts")
+	}
+
+	var r0 *model.UserCounts
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.UserCounts, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.UserCounts); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserCounts)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserCacheDAI_GetCachedCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCachedCounts'
+type MockUserCacheDAI_GetCachedCounts_Call struct {
+	*mock.Call
+}
+
+// GetCachedCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockUserCacheDAI_Expecter) GetCachedCounts(ctx interface{}, userId interface{}) *MockUserCacheDAI_GetCachedCounts_Call {
+	return &MockUserCacheDAI_GetCachedCounts_Call{Call: _e.mock.On("GetCachedCounts", ctx, userId)}
+}
+
+func (_c *MockUserCacheDAI_GetCachedCounts_Call) Run(run func(ctx context.Context, userId int64)) *MockUserCacheDAI_GetCachedCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_GetCachedCounts_Call) Return(userCounts *model.UserCounts, err error) *MockUserCacheDAI_GetCachedCounts_Call {
+	_c.Call.Return(userCounts, err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_GetCachedCounts_Call) RunAndReturn(run func(ctx context.Context, userId int64) (*model.UserCounts, error)) *MockUserCacheDAI_GetCachedCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCachedUserByID provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) GetCachedUserByID(ctx context.Context, userId int64) (*model.User, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCachedUserByID")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.User, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.User); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserCacheDAI_GetCachedUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCachedUserByID'
+type MockUserCacheDAI_GetCachedUserByID_Call struct {
+	*mock.Call
+}
+
+// GetCachedUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockUserCacheDAI_Expecter) GetCachedUserByID(ctx interface{}, userId interface{}) *MockUserCacheDAI_GetCachedUserByID_Call {
+	return &MockUserCacheDAI_GetCachedUserByID_Call{Call: _e.mock.On("GetCachedUserByID", ctx, userId)}
+}
+
+func (_c *MockUserCacheDAI_GetCachedUserByID_Call) Run(run func(ctx context.Context, userId int64)) *MockUserCacheDAI_GetCachedUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_GetCachedUserByID_Call) Return(user *model.User, err error) *MockUserCacheDAI_GetCachedUserByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_GetCachedUserByID_Call) RunAndReturn(run func(ctx context.Context, userId int64) (*model.User, error)) *MockUserCacheDAI_GetCachedUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) GetFollowers(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, []int64, error) {
+	ret := _mock.Called(ctx, userId, paging)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 []*model.Follow
+	var r1 []int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.Follow, []int64, error)); ok {
+		return returnFunc(ctx, userId, paging)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.Follow); ok {
+		r0 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) []int64); ok {
+		r1 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, *model.Paging) error); ok {
+		r2 = returnFunc(ctx, userId, paging)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserCacheDAI_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type MockUserCacheDAI_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - paging *model.Paging
+func (_e *MockUserCacheDAI_Expecter) GetFollowers(ctx interface{}, userId interface{}, paging interface{}) *MockUserCacheDAI_GetFollowers_Call {
+	return &MockUserCacheDAI_GetFollowers_Call{Call: _e.mock.On("GetFollowers", ctx, userId, paging)}
+}
+
+func (_c *MockUserCacheDAI_GetFollowers_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockUserCacheDAI_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *model.Paging
+		if args[2] != nil {
+			arg2 = args[2].(*model.Paging)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_GetFollowers_Call) Return(follows []*model.Follow, int64s []int64, err error) *MockUserCacheDAI_GetFollowers_Call {
+	_c.Call.Return(follows, int64s, err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_GetFollowers_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, []int64, error)) *MockUserCacheDAI_GetFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowings provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) GetFollowings(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, []int64, error) {
+	ret := _mock.Called(ctx, userId, paging)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowings")
+	}
+
+	var r0 []*model.Follow
+	var r1 []int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) ([]*model.Follow, []int64, error)); ok {
+		return returnFunc(ctx, userId, paging)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.Paging) []*model.Follow); ok {
+		r0 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Follow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.Paging) []int64); ok {
+		r1 = returnFunc(ctx, userId, paging)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]int64)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, *model.Paging) error); ok {
+		r2 = returnFunc(ctx, userId, paging)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserCacheDAI_GetFollowings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowings'
+type MockUserCacheDAI_GetFollowings_Call struct {
+	*mock.Call
+}
+
+// GetFollowings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - paging *model.Paging
+func (_e *MockUserCacheDAI_Expecter) GetFollowings(ctx interface{}, userId interface{}, paging interface{}) *MockUserCacheDAI_GetFollowings_Call {
+	return &MockUserCacheDAI_GetFollowings_Call{Call: _e.mock.On("GetFollowings", ctx, userId, paging)}
+}
+
+func (_c *MockUserCacheDAI_GetFollowings_Call) Run(run func(ctx context.Context, userId int64, paging *model.Paging)) *MockUserCacheDAI_GetFollowings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *model.Paging
+		if args[2] != nil {
+			arg2 = args[2].(*model.Paging)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_GetFollowings_Call) Return(follows []*model.Follow, int64s []int64, err error) *MockUserCacheDAI_GetFollowings_Call {
+	_c.Call.Return(follows, int64s, err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_GetFollowings_Call) RunAndReturn(run func(ctx context.Context, userId int64, paging *model.Paging) ([]*model.Follow, []int64, error)) *MockUserCacheDAI_GetFollowings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRelationships provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) GetRelationships(ctx context.Context, viewerId int64, userIds []int64) ([]*model.Relationship, bool, error) {
+	ret := _mock.Called(ctx, viewerId, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRelationships")
+	}
+
+	var r0 []*model.Relationship
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, []int64) ([]*model.Relationship, bool, error)); ok {
+		return returnFunc(ctx, viewerId, userIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, []int64) []*model.Relationship); ok {
+		r0 = returnFunc(ctx, viewerId, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Relationship)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, []int64) bool); ok {
+		r1 = returnFunc(ctx, viewerId, userIds)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64, []int64) error); ok {
+		r2 = returnFunc(ctx, viewerId, userIds)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserCacheDAI_GetRelationships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRelationships'
+type MockUserCacheDAI_GetRelationships_Call struct {
+	*mock.Call
+}
+
+// GetRelationships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewerId int64
+//   - userIds []int64
+func (_e *MockUserCacheDAI_Expecter) GetRelationships(ctx interface{}, viewerId interface{}, userIds interface{}) *MockUserCacheDAI_GetRelationships_Call {
+	return &MockUserCacheDAI_GetRelationships_Call{Call: _e.mock.On("GetRelationships", ctx, viewerId, userIds)}
+}
+
+func (_c *MockUserCacheDAI_GetRelationships_Call) Run(run func(ctx context.Context, viewerId int64, userIds []int64)) *MockUserCacheDAI_GetRelationships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 []int64
+		if args[2] != nil {
+			arg2 = args[2].([]int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_GetRelationships_Call) Return(relationships []*model.Relationship, b bool, err error) *MockUserCacheDAI_GetRelationships_Call {
+	_c.Call.Return(relationships, b, err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_GetRelationships_Call) RunAndReturn(run func(ctx context.Context, viewerId int64, userIds []int64) ([]*model.Relationship, bool, error)) *MockUserCacheDAI_GetRelationships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsAudienceCached provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) IsAudienceCached(ctx context.Context, userId int64) (bool, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAudienceCached")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (bool, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) bool); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserCacheDAI_IsAudienceCached_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAudienceCached'
+type MockUserCacheDAI_IsAudienceCached_Call struct {
+	*mock.Call
+}
+
+// IsAudienceCached is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockUserCacheDAI_Expecter) IsAudienceCached(ctx interface{}, userId interface{}) *MockUserCacheDAI_IsAudienceCached_Call {
+	return &MockUserCacheDAI_IsAudienceCached_Call{Call: _e.mock.On("IsAudienceCached", ctx, userId)}
+}
+
+func (_c *MockUserCacheDAI_IsAudienceCached_Call) Run(run func(ctx context.Context, userId int64)) *MockUserCacheDAI_IsAudienceCached_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_IsAudienceCached_Call) Return(b bool, err error) *MockUserCacheDAI_IsAudienceCached_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_IsAudienceCached_Call) RunAndReturn(run func(ctx context.Context, userId int64) (bool, error)) *MockUserCacheDAI_IsAudienceCached_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsFollowersCached provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) IsFollowersCached(ctx context.Context, userId int64) (bool, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFollowersCached")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (bool, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) bool); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserCacheDAI_IsFollowersCached_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFollowersCached'
+type MockUserCacheDAI_IsFollowersCached_Call struct {
+	*mock.Call
+}
+
+// IsFollowersCached is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockUserCacheDAI_Expecter) IsFollowersCached(ctx interface{}, userId interface{}) *MockUserCacheDAI_IsFollowersCached_Call {
+	return &MockUserCacheDAI_IsFollowersCached_Call{Call: _e.mock.On("IsFollowersCached", ctx, userId)}
+}
+
+func (_c *MockUserCacheDAI_IsFollowersCached_Call) Run(run func(ctx context.Context, userId int64)) *MockUserCacheDAI_IsFollowersCached_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_IsFollowersCached_Call) Return(b bool, err error) *MockUserCacheDAI_IsFollowersCached_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_IsFollowersCached_Call) RunAndReturn(run func(ctx context.Context, userId int64) (bool, error)) *MockUserCacheDAI_IsFollowersCached_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsFollowingsCached provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) IsFollowingsCached(ctx context.Context, userId int64) (bool, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFollowingsCached")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (bool, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) bool); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserCacheDAI_IsFollowingsCached_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFollowingsCached'
+type MockUserCacheDAI_IsFollowingsCached_Call struct {
+	*mock.Call
+}
+
+// IsFollowingsCached is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockUserCacheDAI_Expecter) IsFollowingsCached(ctx interface{}, userId interface{}) *MockUserCacheDAI_IsFollowingsCached_Call {
+	return &MockUserCacheDAI_IsFollowingsCached_Call{Call: _e.mock.On("IsFollowingsCached", ctx, userId)}
+}
+
+func (_c *MockUserCacheDAI_IsFollowingsCached_Call) Run(run func(ctx context.Context, userId int64)) *MockUserCacheDAI_IsFollowingsCached_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_IsFollowingsCached_Call) Return(b bool, err error) *MockUserCacheDAI_IsFollowingsCached_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_IsFollowingsCached_Call) RunAndReturn(run func(ctx context.Context, userId int64) (bool, error)) *MockUserCacheDAI_IsFollowingsCached_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockFollowersRebuild provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) LockFollowersRebuild(ctx context.Context, userId int64) (string, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockFollowersRebuild")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (string, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) string); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserCacheDAI_LockFollowersRebuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockFollowersRebuild'
+type MockUserCacheDAI_LockFollowersRebuild_Call struct {
+	*mock.Call
+}
+
+// LockFollowersRebuild is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockUserCacheDAI_Expecter) LockFollowersRebuild(ctx interface{}, userId interface{}) *MockUserCacheDAI_LockFollowersRebuild_Call {
+	return &MockUserCacheDAI_LockFollowersRebuild_Call{Call: _e.mock.On("LockFollowersRebuild", ctx, userId)}
+}
+
+func (_c *MockUserCacheDAI_LockFollowersRebuild_Call) Run(run func(ctx context.Context, userId int64)) *MockUserCacheDAI_LockFollowersRebuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_LockFollowersRebuild_Call) Return(s string, err error) *MockUserCacheDAI_LockFollowersRebuild_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_LockFollowersRebuild_Call) RunAndReturn(run func(ctx context.Context, userId int64) (string, error)) *MockUserCacheDAI_LockFollowersRebuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockFollowingsRebuild provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) LockFollowingsRebuild(ctx context.Context, userId int64) (string, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockFollowingsRebuild")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (string, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) string); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserCacheDAI_LockFollowingsRebuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockFollowingsRebuild'
+type MockUserCacheDAI_LockFollowingsRebuild_Call struct {
+	*mock.Call
+}
+
+// LockFollowingsRebuild is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockUserCacheDAI_Expecter) LockFollowingsRebuild(ctx interface{}, userId interface{}) *MockUserCacheDAI_LockFollowingsRebuild_Call {
+	return &MockUserCacheDAI_LockFollowingsRebuild_Call{Call: _e.mock.On("LockFollowingsRebuild", ctx, userId)}
+}
+
+func (_c *MockUserCacheDAI_LockFollowingsRebuild_Call) Run(run func(ctx context.Context, userId int64)) *MockUserCacheDAI_LockFollowingsRebuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_LockFollowingsRebuild_Call) Return(s string, err error) *MockUserCacheDAI_LockFollowingsRebuild_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_LockFollowingsRebuild_Call) RunAndReturn(run func(ctx context.Context, userId int64) (string, error)) *MockUserCacheDAI_LockFollowingsRebuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveBlock provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) RemoveBlock(ctx context.Context, userId int64, peerId int64) error {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveBlock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserCacheDAI_RemoveBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveBlock'
+type MockUserCacheDAI_RemoveBlock_Call struct {
+	*mock.Call
+}
+
+// RemoveBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserCacheDAI_Expecter) RemoveBlock(ctx interface{}, userId interface{}, peerId interface{}) *MockUserCacheDAI_RemoveBlock_Call {
+	return &MockUserCacheDAI_RemoveBlock_Call{Call: _e.mock.On("RemoveBlock", ctx, userId, peerId)}
+}
+
+func (_c *MockUserCacheDAI_RemoveBlock_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserCacheDAI_RemoveBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_RemoveBlock_Call) Return(err error) *MockUserCacheDAI_RemoveBlock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_RemoveBlock_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserCacheDAI_RemoveBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCachedFollow provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) RemoveCachedFollow(ctx context.Context, userId int64, peerId int64) error {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCachedFollow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserCacheDAI_RemoveCachedFollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCachedFollow'
+type MockUserCacheDAI_RemoveCachedFollow_Call struct {
+	*mock.Call
+}
+
+// RemoveCachedFollow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserCacheDAI_Expecter) RemoveCachedFollow(ctx interface{}, userId interface{}, peerId interface{}) *MockUserCacheDAI_RemoveCachedFollow_Call {
+	return &MockUserCacheDAI_RemoveCachedFollow_Call{Call: _e.mock.On("RemoveCachedFollow", ctx, userId, peerId)}
+}
+
+func (_c *MockUserCacheDAI_RemoveCachedFollow_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserCacheDAI_RemoveCachedFollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_RemoveCachedFollow_Call) Return(err error) *MockUserCacheDAI_RemoveCachedFollow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_RemoveCachedFollow_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserCacheDAI_RemoveCachedFollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveCloseFriend provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) RemoveCloseFriend(ctx context.Context, ownerId int64, memberId int64) error {
+	ret := _mock.Called(ctx, ownerId, memberId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCloseFriend")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, ownerId, memberId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserCacheDAI_RemoveCloseFriend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveCloseFriend'
+type MockUserCacheDAI_RemoveCloseFriend_Call struct {
+	*mock.Call
+}
+
+// RemoveCloseFriend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerId int64
+//   - memberId int64
+func (_e *MockUserCacheDAI_Expecter) RemoveCloseFriend(ctx interface{}, ownerId interface{}, memberId interface{}) *MockUserCacheDAI_RemoveCloseFriend_Call {
+	return &MockUserCacheDAI_RemoveCloseFriend_Call{Call: _e.mock.On("RemoveCloseFriend", ctx, ownerId, memberId)}
+}
+
+func (_c *MockUserCacheDAI_RemoveCloseFriend_Call) Run(run func(ctx context.Context, ownerId int64, memberId int64)) *MockUserCacheDAI_RemoveCloseFriend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_RemoveCloseFriend_Call) Return(err error) *MockUserCacheDAI_RemoveCloseFriend_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_RemoveCloseFriend_Call) RunAndReturn(run func(ctx context.Context, ownerId int64, memberId int64) error) *MockUserCacheDAI_RemoveCloseFriend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMute provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) RemoveMute(ctx context.Context, userId int64, peerId int64) error {
+	ret := _mock.Called(ctx, userId, peerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMute")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = returnFunc(ctx, userId, peerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserCacheDAI_RemoveMute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMute'
+type MockUserCacheDAI_RemoveMute_Call struct {
+	*mock.Call
+}
+
+// RemoveMute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - peerId int64
+func (_e *MockUserCacheDAI_Expecter) RemoveMute(ctx interface{}, userId interface{}, peerId interface{}) *MockUserCacheDAI_RemoveMute_Call {
+	return &MockUserCacheDAI_RemoveMute_Call{Call: _e.mock.On("RemoveMute", ctx, userId, peerId)}
+}
+
+func (_c *MockUserCacheDAI_RemoveMute_Call) Run(run func(ctx context.Context, userId int64, peerId int64)) *MockUserCacheDAI_RemoveMute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_RemoveMute_Call) Return(err error) *MockUserCacheDAI_RemoveMute_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_RemoveMute_Call) RunAndReturn(run func(ctx context.Context, userId int64, peerId int64) error) *MockUserCacheDAI_RemoveMute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCachedCounts provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) SetCachedCounts(ctx context.Context, userId int64, counts *model.UserCounts) error {
+	ret := _mock.Called(ctx, userId, counts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCachedCounts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.UserCounts) error); ok {
+		r0 = returnFunc(ctx, userId, counts)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserCacheDAI_SetCachedCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCachedCounts'
+type MockUserCacheDAI_SetCachedCounts_Call struct {
+	*mock.Call
+}
+
+// SetCachedCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - counts *model.UserCounts
+func (_e *MockUserCacheDAI_Expecter) SetCachedCounts(ctx interface{}, userId interface{}, counts interface{}) *MockUserCacheDAI_SetCachedCounts_Call {
+	return &MockUserCacheDAI_SetCachedCounts_Call{Call: _e.mock.On("SetCachedCounts", ctx, userId, counts)}
+}
+
+func (_c *MockUserCacheDAI_SetCachedCounts_Call) Run(run func(ctx context.Context, userId int64, counts *model.UserCounts)) *MockUserCacheDAI_SetCachedCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *model.UserCounts
+		if args[2] != nil {
+			arg2 = args[2].(*model.UserCounts)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_SetCachedCounts_Call) Return(err error) *MockUserCacheDAI_SetCachedCounts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_SetCachedCounts_Call) RunAndReturn(run func(ctx context.Context, userId int64, counts *model.UserCounts) error) *MockUserCacheDAI_SetCachedCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCachedFollowers provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) SetCachedFollowers(ctx context.Context, userId int64, token string, followTsById map[int64]int64) (bool, error) {
+	ret := _mock.Called(ctx, userId, token, followTsById)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCachedFollowers")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, map[int64]int64) (bool, error)); ok {
+		return returnFunc(ctx, userId, token, followTsById)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, map[int64]int64) bool); ok {
+		r0 = returnFunc(ctx, userId, token, followTsById)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, map[int64]int64) error); ok {
+		r1 = returnFunc(ctx, userId, token, followTsById)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserCacheDAI_SetCachedFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCachedFollowers'
+type MockUserCacheDAI_SetCachedFollowers_Call struct {
+	*mock.Call
+}
+
+// SetCachedFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - token string
+//   - followTsById map[int64]int64
+func (_e *MockUserCacheDAI_Expecter) SetCachedFollowers(ctx interface{}, userId interface{}, token interface{}, followTsById interface{}) *MockUserCacheDAI_SetCachedFollowers_Call {
+	return &MockUserCacheDAI_SetCachedFollowers_Call{Call: _e.mock.On("SetCachedFollowers", ctx, userId, token, followTsById)}
+}
+
+func (_c *MockUserCacheDAI_SetCachedFollowers_Call) Run(run func(ctx context.Context, userId int64, token string, followTsById map[int64]int64)) *MockUserCacheDAI_SetCachedFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 map[int64]int64
+		if args[3] != nil {
+			arg3 = args[3].(map[int64]int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_SetCachedFollowers_Call) Return(b bool, err error) *MockUserCacheDAI_SetCachedFollowers_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_SetCachedFollowers_Call) RunAndReturn(run func(ctx context.Context, userId int64, token string, followTsById map[int64]int64) (bool, error)) *MockUserCacheDAI_SetCachedFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCachedFollowings provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) SetCachedFollowings(ctx context.Context, userId int64, token string, followTsById map[int64]int64) (bool, error) {
+	ret := _mock.Called(ctx, userId, token, followTsById)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCachedFollowings")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, map[int64]int64) (bool, error)); ok {
+		return returnFunc(ctx, userId, token, followTsById)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, map[int64]int64) bool); ok {
+		r0 = returnFunc(ctx, userId, token, followTsById)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, map[int64]int64) error); ok {
+		r1 = returnFunc(ctx, userId, token, followTsById)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserCacheDAI_SetCachedFollowings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCachedFollowings'
+type MockUserCacheDAI_SetCachedFollowings_Call struct {
+	*mock.Call
+}
+
+// SetCachedFollowings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - token string
+//   - followTsById map[int64]int64
+func (_e *MockUserCacheDAI_Expecter) SetCachedFollowings(ctx interface{}, userId interface{}, token interface{}, followTsById interface{}) *MockUserCacheDAI_SetCachedFollowings_Call {
+	return &MockUserCacheDAI_SetCachedFollowings_Call{Call: _e.mock.On("SetCachedFollowings", ctx, userId, token, followTsById)}
+}
+
+func (_c *MockUserCacheDAI_SetCachedFollowings_Call) Run(run func(ctx context.Context, userId int64, token string, followTsById map[int64]int64)) *MockUserCacheDAI_SetCachedFollowings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 map[int64]int64
+		if args[3] != nil {
+			arg3 = args[3].(map[int64]int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_SetCachedFollowings_Call) Return(b bool, err error) *MockUserCacheDAI_SetCachedFollowings_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_SetCachedFollowings_Call) RunAndReturn(run func(ctx context.Context, userId int64, token string, followTsById map[int64]int64) (bool, error)) *MockUserCacheDAI_SetCachedFollowings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCachedUser provides a mock function for the type MockUserCacheDAI
+func (_mock *MockUserCacheDAI) SetCachedUser(ctx context.Context, user *model.User) error {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCachedUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User) error); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserCacheDAI_SetCachedUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCachedUser'
+type MockUserCacheDAI_SetCachedUser_Call struct {
+	*mock.Call
+}
+
+// SetCachedUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.User
+func (_e *MockUserCacheDAI_Expecter) SetCachedUser(ctx interface{}, user interface{}) *MockUserCacheDAI_SetCachedUser_Call {
+	return &MockUserCacheDAI_SetCachedUser_Call{Call: _e.mock.On("SetCachedUser", ctx, user)}
+}
+
+func (_c *MockUserCacheDAI_SetCachedUser_Call) Run(run func(ctx context.Context, user *model.User)) *MockUserCacheDAI_SetCachedUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.User
+		if args[1] != nil {
+			arg1 = args[1].(*model.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserCacheDAI_SetCachedUser_Call) Return(err error) *MockUserCacheDAI_SetCachedUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserCacheDAI_SetCachedUser_Call) RunAndReturn(run func(ctx context.Context, user *model.User) error) *MockUserCacheDAI_SetCachedUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAudienceDAI creates a new instance of MockAudienceDAI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAudienceDAI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAudienceDAI {
+	mock := &MockAudienceDAI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAudienceDAI is an autogenerated mock type for the AudienceDAI type
+type MockAudienceDAI struct {
+	mock.Mock
+}
+
+type MockAudienceDAI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAudienceDAI) EXPECT() *MockAudienceDAI_Expecter {
+	return &MockAudienceDAI_Expecter{mock: &_m.Mock}
+}
+
+// AddAudienceMember provides a mock function for the type MockAudienceDAI
+func (_mock *MockAudienceDAI) AddAudienceMember(ctx context.Context, member *model.AudienceMember) error {
+	ret := _mock.Called(ctx, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAudienceMember")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.AudienceMember) error); ok {
+		r0 = returnFunc(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAudienceDAI_AddAudienceMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAudienceMember'
+type MockAudienceDAI_AddAudienceMember_Call struct {
+	*mock.Call
+}
+
+// AddAudienceMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member *model.AudienceMember
+func (_e *MockAudienceDAI_Expecter) AddAudienceMember(ctx interface{}, member interface{}) *MockAudienceDAI_AddAudienceMember_Call {
+	return &MockAudienceDAI_AddAudienceMember_Call{Call: _e.mock.On("AddAudienceMember", ctx, member)}
+}
+
+func (_c *MockAudienceDAI_AddAudienceMember_Call) Run(run func(ctx context.Context, member *model.AudienceMember)) *MockAudienceDAI_AddAudienceMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.AudienceMember
+		if args[1] != nil {
+			arg1 = args[1].(*model.AudienceMember)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAudienceDAI_AddAudienceMember_Call) Return(err error) *MockAudienceDAI_AddAudienceMember_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAudienceDAI_AddAudienceMember_Call) RunAndReturn(run func(ctx context.Context, member *model.AudienceMember) error) *MockAudienceDAI_AddAudienceMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAudienceMembers provides a mock function for the type MockAudienceDAI
+func (_mock *MockAudienceDAI) GetAudienceMembers(ctx context.Context, ownerId int64, list string, paging *model.Paging) ([]*model.AudienceMember, error) {
+	ret := _mock.Called(ctx, ownerId, list, paging)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAudienceMembers")
+	}
+
+	var r0 []*model.AudienceMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, *model.Paging) ([]*model.AudienceMember, error)); ok {
+		return returnFunc(ctx, ownerId, list, paging)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, *model.Paging) []*model.AudienceMember); ok {
+		r0 = returnFunc(ctx, ownerId, list, paging)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AudienceMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, *model.Paging) error); ok {
+		r1 = returnFunc(ctx, ownerId, list, paging)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAudienceDAI_GetAudienceMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAudienceMembers'
+type MockAudienceDAI_GetAudienceMembers_Call struct {
+	*mock.Call
+}
+
+// GetAudienceMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerId int64
+//   - list string
+//   - paging *model.Paging
+func (_e *MockAudienceDAI_Expecter) GetAudienceMembers(ctx interface{}, ownerId interface{}, list interface{}, paging interface{}) *MockAudienceDAI_GetAudienceMembers_Call {
+	return &MockAudienceDAI_GetAudienceMembers_Call{Call: _e.mock.On("GetAudienceMembers", ctx, ownerId, list, paging)}
+}
+
+func (_c *MockAudienceDAI_GetAudienceMembers_Call) Run(run func(ctx context.Context, ownerId int64, list string, paging *model.Paging)) *MockAudienceDAI_GetAudienceMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *model.Paging
+		if args[3] != nil {
+			arg3 = args[3].(*model.Paging)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAudienceDAI_GetAudienceMembers_Call) Return(audienceMembers []*model.AudienceMember, err error) *MockAudienceDAI_GetAudienceMembers_Call {
+	_c.Call.Return(audienceMembers, err)
+	return _c
+}
+
+func (_c *MockAudienceDAI_GetAudienceMembers_Call) RunAndReturn(run func(ctx context.Context, ownerId int64, list string, paging *model.Paging) ([]*model.AudienceMember, error)) *MockAudienceDAI_GetAudienceMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAudienceMembersAmong provides a mock function for the type MockAudienceDAI
+func (_mock *MockAudienceDAI) GetAudienceMembersAmong(ctx context.Context, list string, userIds []int64) ([]*model.AudienceMember, error) {
+	ret := _mock.Called(ctx, list, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAudienceMembersAmong")
+	}
+
+	var r0 []*model.AudienceMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []int64) ([]*model.AudienceMember, error)); ok {
+		return returnFunc(ctx, list, userIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []int64) []*model.AudienceMember); ok {
+		r0 = returnFunc(ctx, list, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AudienceMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []int64) error); ok {
+		r1 = returnFunc(ctx, list, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAudienceDAI_GetAudienceMembersAmong_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAudienceMembersAmong'
+type MockAudienceDAI_GetAudienceMembersAmong_Call struct {
+	*mock.Call
+}
+
+// GetAudienceMembersAmong is a helper method to define mock.On call
+//   - ctx context.Context
+//   - list string
+//   - userIds []int64
+func (_e *MockAudienceDAI_Expecter) GetAudienceMembersAmong(ctx interface{}, list interface{}, userIds interface{}) *MockAudienceDAI_GetAudienceMembersAmong_Call {
+	return &MockAudienceDAI_GetAudienceMembersAmong_Call{Call: _e.mock.On("GetAudienceMembersAmong", ctx, list, userIds)}
+}
+
+func (_c *MockAudienceDAI_GetAudienceMembersAmong_Call) Run(run func(ctx context.Context, list string, userIds []int64)) *MockAudienceDAI_GetAudienceMembersAmong_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []int64
+		if args[2] != nil {
+			arg2 = args[2].([]int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAudienceDAI_GetAudienceMembersAmong_Call) Return(audienceMembers []*model.AudienceMember, err error) *MockAudienceDAI_GetAudienceMembersAmong_Call {
+	_c.Call.Return(audienceMembers, err)
+	return _c
+}
+
+func (_c *MockAudienceDAI_GetAudienceMembersAmong_Call) RunAndReturn(run func(ctx context.Context, list string, userIds []int64) ([]*model.AudienceMember, error)) *MockAudienceDAI_GetAudienceMembersAmong_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAudienceMembersBetween provides a mock function for the type MockAudienceDAI
+func (_mock *MockAudienceDAI) GetAudienceMembersBetween(ctx context.Context, userId int64, list string, peerIds []int64) ([]*model.AudienceMember, error) {
+	ret := _mock.Called(ctx, userId, list, peerIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAudienceMembersBetween")
+	}
+
+	var r0 []*model.AudienceMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, []int64) ([]*model.AudienceMember, error)); ok {
+		return returnFunc(ctx, userId, list, peerIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, []int64) []*model.AudienceMember); ok {
+		r0 = returnFunc(ctx, userId, list, peerIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AudienceMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, []int64) error); ok {
+		r1 = returnFunc(ctx, userId, list, peerIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAudienceDAI_GetAudienceMembersBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAudienceMembersBetween'
+type MockAudienceDAI_GetAudienceMembersBetween_Call struct {
+	*mock.Call
+}
+
+// GetAudienceMembersBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - list string
+//   - peerIds []int64
+func (_e *MockAudienceDAI_Expecter) GetAudienceMembersBetween(ctx interface{}, userId interface{}, list interface{}, peerIds interface{}) *MockAudienceDAI_GetAudienceMembersBetween_Call {
+	return &MockAudienceDAI_GetAudienceMembersBetween_Call{Call: _e.mock.On("GetAudienceMembersBetween", ctx, userId, list, peerIds)}
+}
+
+func (_c *MockAudienceDAI_GetAudienceMembersBetween_Call) Run(run func(ctx context.Context, userId int64, list string, peerIds []int64)) *MockAudienceDAI_GetAudienceMembersBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []int64
+		if args[3] != nil {
+			arg3 = args[3].([]int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAudienceDAI_GetAudienceMembersBetween_Call) Return(audienceMembers []*model.AudienceMember, err error) *MockAudienceDAI_GetAudienceMembersBetween_Call {
+	_c.Call.Return(audienceMembers, err)
+	return _c
+}
+
+func (_c *MockAudienceDAI_GetAudienceMembersBetween_Call) RunAndReturn(run func(ctx context.Context, userId int64, list string, peerIds []int64) ([]*model.AudienceMember, error)) *MockAudienceDAI_GetAudienceMembersBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsAudienceMember provides a mock function for the type MockAudienceDAI
+func (_mock *MockAudienceDAI) IsAudienceMember(ctx context.Context, ownerId int64, list string, memberId int64) (bool, error) {
+	ret := _mock.Called(ctx, ownerId, list, memberId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAudienceMember")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, int64) (bool, error)); ok {
+		return returnFunc(ctx, ownerId, list, memberId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, int64) bool); ok {
+		r0 = returnFunc(ctx, ownerId, list, memberId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, string, int64) error); ok {
+		r1 = returnFunc(ctx, ownerId, list, memberId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAudienceDAI_IsAudienceMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAudienceMember'
+type MockAudienceDAI_IsAudienceMember_Call struct {
+	*mock.Call
+}
+
+// IsAudienceMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerId int64
+//   - list string
+//   - memberId int64
+func (_e *MockAudienceDAI_Expecter) IsAudienceMember(ctx interface{}, ownerId interface{}, list interface{}, memberId interface{}) *MockAudienceDAI_IsAudienceMember_Call {
+	return &MockAudienceDAI_IsAudienceMember_Call{Call: _e.mock.On("IsAudienceMember", ctx, ownerId, list, memberId)}
+}
+
+func (_c *MockAudienceDAI_IsAudienceMember_Call) Run(run func(ctx context.Context, ownerId int64, list string, memberId int64)) *MockAudienceDAI_IsAudienceMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAudienceDAI_IsAudienceMember_Call) Return(b bool, err error) *MockAudienceDAI_IsAudienceMember_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockAudienceDAI_IsAudienceMember_Call) RunAndReturn(run func(ctx context.Context, ownerId int64, list string, memberId int64) (bool, error)) *MockAudienceDAI_IsAudienceMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAudienceMember provides a mock function for the type MockAudienceDAI
+func (_mock *MockAudienceDAI) RemoveAudienceMember(ctx context.Context, ownerId int64, list string, memberId int64) error {
+	ret := _mock.Called(ctx, ownerId, list, memberId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAudienceMember")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, string, int64) error); ok {
+		r0 = returnFunc(ctx, ownerId, list, memberId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAudienceDAI_RemoveAudienceMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAudienceMember'
+type MockAudienceDAI_RemoveAudienceMember_Call struct {
+	*mock.Call
+}
+
+// RemoveAudienceMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerId int64
+//   - list string
+//   - memberId int64
+func (_e *MockAudienceDAI_Expecter) RemoveAudienceMember(ctx interface{}, ownerId interface{}, list interface{}, memberId interface{}) *MockAudienceDAI_RemoveAudienceMember_Call {
+	return &MockAudienceDAI_RemoveAudienceMember_Call{Call: _e.mock.On("RemoveAudienceMember", ctx, ownerId, list, memberId)}
+}
+
+func (_c *MockAudienceDAI_RemoveAudienceMember_Call) Run(run func(ctx context.Context, ownerId int64, list string, memberId int64)) *MockAudienceDAI_RemoveAudienceMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAudienceDAI_RemoveAudienceMember_Call) Return(err error) *MockAudienceDAI_RemoveAudienceMember_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAudienceDAI_RemoveAudienceMember_Call) RunAndReturn(run func(ctx context.Context, ownerId int64, list string, memberId int64) error) *MockAudienceDAI_RemoveAudienceMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFollowMsgProducer creates a new instance of MockFollowMsgProducer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFollowMsgProducer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFollowMsgProducer {
+	mock := &MockFollowMsgProducer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockFollowMsgProducer is an autogenerated mock type for the FollowMsgProducer type
+type MockFollowMsgProducer struct {
+	mock.Mock
+}
+
+type MockFollowMsgProducer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFollowMsgProducer) EXPECT() *MockFollowMsgProducer_Expecter {
+	return &MockFollowMsgProducer_Expecter{mock: &_m.Mock}
+}
+
+// SendUnfollow provides a mock function for the type MockFollowMsgProducer
+func (_mock *MockFollowMsgProducer) SendUnfollow(ctx context.Context, follow *model.Follow) error {
+	ret := _mock.Called(ctx, follow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendUnfollow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Follow) error); ok {
+		r0 = returnFunc(ctx, follow)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFollowMsgProducer_SendUnfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendUnfollow'
+type MockFollowMsgProducer_SendUnfollow_Call struct {
+	*mock.Call
+}
+
+// SendUnfollow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follow *model.Follow
+func (_e *MockFollowMsgProducer_Expecter) SendUnfollow(ctx interface{}, follow interface{}) *MockFollowMsgProducer_SendUnfollow_Call {
+	return &MockFollowMsgProducer_SendUnfollow_Call{Call: _e.mock.On("SendUnfollow", ctx, follow)}
+}
+
+func (_c *MockFollowMsgProducer_SendUnfollow_Call) Run(run func(ctx context.Context, follow *model.Follow)) *MockFollowMsgProducer_SendUnfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Follow
+		if args[1] != nil {
+			arg1 = args[1].(*model.Follow)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFollowMsgProducer_SendUnfollow_Call) Return(err error) *MockFollowMsgProducer_SendUnfollow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFollowMsgProducer_SendUnfollow_Call) RunAndReturn(run func(ctx context.Context, follow *model.Follow) error) *MockFollowMsgProducer_SendUnfollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMailer creates a new instance of MockMailer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMailer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMailer {
+	mock := &MockMailer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMailer is an autogenerated mock type for the Mailer type
+type MockMailer struct {
+	mock.Mock
+}
+
+type MockMailer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMailer) EXPECT() *MockMailer_Expecter {
+	return &MockMailer_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function for the type MockMailer
+func (_mock *MockMailer) Send(ctx context.Context, mail *model.Mail) error {
+	ret := _mock.Called(ctx, mail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Mail) error); ok {
+		r0 = returnFunc(ctx, mail)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMailer_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockMailer_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mail *model.Mail
+func (_e *MockMailer_Expecter) Send(ctx interface{}, mail interface{}) *MockMailer_Send_Call {
+	return &MockMailer_Send_Call{Call: _e.mock.On("Send", ctx, mail)}
+}
+
+func (_c *MockMailer_Send_Call) Run(run func(ctx context.Context, mail *model.Mail)) *MockMailer_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *model.Mail
+		if args[1] != nil {
+			arg1 = args[1].(*model.Mail)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMailer_Send_Call) Return(err error) *MockMailer_Send_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMailer_Send_Call) RunAndReturn(run func(ctx context.Context, mail *model.Mail) error) *MockMailer_Send_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,13 +3,16 @@ package user_service
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"ep.k16/newsfeed/internal/common"
 	"ep.k16/newsfeed/internal/service/model"
+	"ep.k16/newsfeed/pkg/cursor"
 )
 
 func Test_checkPassword(t *testing.T) {
@@ -109,14 +112,941 @@ func TestUserService_Signup(t *testing.T) {
 				Dob:            "19900101",
 			}, nil)
 
+		mockDAI.On("CreateEmailVerificationToken", ctx, mock.MatchedBy(func(token *model.EmailVerificationToken) bool {
+			return token.UserID == 1 && len(token.TokenHash) == 64
+		})).Return(nil)
+
 		mockCache := new(MockUserCacheDAI)
 		mockCache.On("SetCachedUser", ctx, mock.AnythingOfType("*model.User")).Return(nil)
 
-		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true}
+		mockMailer := new(MockMailer)
+		mockMailer.On("Send", ctx, mock.MatchedBy(func(mail *model.Mail) bool {
+			return mail.To == "abc@gmail.com" && mail.Subject == "Verify your email"
+		})).Return(nil)
+
+		service := &UserService{
+			cfg:          Config{EmailVerificationTTL: time.Hour},
+			dai:          mockDAI,
+			cacheDai:     mockCache,
+			enabledCache: true,
+			mailer:       mockMailer,
+		}
 
 		res, err := service.Signup(ctx, user)
 
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), res.ID)
+		assert.False(t, res.EmailVerified)
+		mockDAI.AssertExpectations(t)
+		mockMailer.AssertExpectations(t)
+	})
+
+	t.Run("failing to send verification does not fail signup", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetByUsername", ctx, "username").Return((*model.User)(nil), nil)
+		mockDAI.On("Create", ctx, mock.AnythingOfType("*model.User")).
+			Return(&model.User{ID: 1, Username: "username", Email: "abc@gmail.com"}, nil)
+		mockDAI.On("CreateEmailVerificationToken", ctx, mock.AnythingOfType("*model.EmailVerificationToken")).Return(nil)
+
+		mockMailer := new(MockMailer)
+		mockMailer.On("Send", ctx, mock.AnythingOfType("*model.Mail")).Return(errors.New("smtp down"))
+
+		service := &UserService{cfg: Config{EmailVerificationTTL: time.Hour}, dai: mockDAI, mailer: mockMailer}
+
+		res, err := service.Signup(ctx, &model.User{Username: "username", Password: "password", Email: "abc@gmail.com"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), res.ID)
+	})
+}
+
+func TestUserService_AddCloseFriend(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("add yourself", func(t *testing.T) {
+		service := &UserService{}
+
+		res, err := service.AddCloseFriend(ctx, 1, 1)
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+
+	t.Run("success with cache enabled", func(t *testing.T) {
+		peer := &model.User{ID: 2, Username: "peer"}
+
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetByID", ctx, int64(2)).Return(peer, nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("AddAudienceMember", ctx, mock.MatchedBy(func(m *model.AudienceMember) bool {
+			return m.OwnerID == 1 && m.MemberID == 2 && m.List == model.AudienceListCloseFriends
+		})).Return(nil)
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetCachedUserByID", ctx, int64(2)).Return(nil, nil)
+		mockCache.On("AddCloseFriend", ctx, int64(1), int64(2)).Return(nil)
+
+		service := &UserService{dai: mockDAI, audienceDai: mockAudienceDAI, cacheDai: mockCache, enabledCache: true}
+
+		res, err := service.AddCloseFriend(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, peer, res.Member)
+		mockAudienceDAI.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+}
+
+func TestUserService_GetFollowers(t *testing.T) {
+	ctx := context.Background()
+	paging := &model.Paging{Limit: 10}
+	user := &model.User{ID: 1, Username: "username"}
+	followers := []*model.Follow{
+		{ID: 7, Follower: &model.User{ID: 3}, FollowTs: 1700000200},
+		{ID: 4, Follower: &model.User{ID: 2}, FollowTs: 1700000000},
+	}
+
+	t.Run("from cache", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetCachedUserByID", ctx, int64(1)).Return(user, nil)
+		mockCache.On("IsFollowersCached", ctx, int64(1)).Return(true, nil)
+		mockCache.On("GetFollowers", ctx, int64(1), paging).Return(followers, []int64{}, nil)
+
+		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true}
+
+		res, next, err := service.GetFollowers(ctx, 1, paging)
+
+		assert.NoError(t, err)
+		assert.Equal(t, followers, res)
+		assert.Nil(t, next)
+		mockDAI.AssertNotCalled(t, "GetFollowers", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rebuild cache on miss", func(t *testing.T) {
+		followTsById := map[int64]int64{3: 1700000200, 2: 1700000000}
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetFollowerTimestamps", ctx, int64(1)).Return(followTsById, nil)
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetCachedUserByID", ctx, int64(1)).Return(user, nil)
+		mockCache.On("IsFollowersCached", ctx, int64(1)).Return(false, nil)
+		mockCache.On("LockFollowersRebuild", ctx, int64(1)).Return("token", nil)
+		mockCache.On("SetCachedFollowers", ctx, int64(1), "token", followTsById).Return(true, nil)
+		mockCache.On("GetFollowers", ctx, int64(1), paging).Return(followers, []int64{}, nil)
+
+		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true}
+
+		res, next, err := service.GetFollowers(ctx, 1, paging)
+
+		assert.NoError(t, err)
+		assert.Equal(t, followers, res)
+		assert.Nil(t, next)
+		mockCache.AssertExpectations(t)
+		mockDAI.AssertNotCalled(t, "GetFollowers", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("read db while another request rebuilds cache", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetFollowers", ctx, int64(1), paging).Return(followers, nil, nil)
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetCachedUserByID", ctx, int64(1)).Return(user, nil)
+		mockCache.On("IsFollowersCached", ctx, int64(1)).Return(false, nil)
+		mockCache.On("LockFollowersRebuild", ctx, int64(1)).Return("", nil)
+
+		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true}
+
+		res, next, err := service.GetFollowers(ctx, 1, paging)
+
+		assert.NoError(t, err)
+		assert.Equal(t, followers, res)
+		assert.Nil(t, next)
+		mockDAI.AssertNotCalled(t, "GetFollowerTimestamps", mock.Anything, mock.Anything)
+	})
+
+	t.Run("backfill users missing from cache", func(t *testing.T) {
+		paging := &model.Paging{Limit: 3}
+		cachedFollowers := []*model.Follow{
+			{Follower: &model.User{ID: 4}, FollowTs: 1700000300}, // removed
+			{Follower: &model.User{ID: 3}, FollowTs: 1700000200},
+			{Follower: &model.User{ID: 2, Username: "second"}, FollowTs: 1700000000},
+		}
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetByID", ctx, int64(4)).Return(nil, nil)
+		mockDAI.On("GetByID", ctx, int64(3)).Return(&model.User{ID: 3, Username: "third"}, nil)
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetCachedUserByID", ctx, int64(1)).Return(user, nil)
+		mockCache.On("IsFollowersCached", ctx, int64(1)).Return(true, nil)
+		mockCache.On("GetFollowers", ctx, int64(1), paging).Return(cachedFollowers, []int64{4, 3}, nil)
+		mockCache.On("SetCachedUser", ctx, &model.User{ID: 3, Username: "third"}).Return(nil)
+
+		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true}
+
+		res, next, err := service.GetFollowers(ctx, 1, paging)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, "third", res[0].Follower.Username)
+		assert.Equal(t, "second", res[1].Follower.Username)
+		assert.Equal(t, &cursor.Cursor{SortKey: 1700000000, ID: 2}, next) // dropped follower does not end the list
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("fall back to db on cache error", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetFollowers", ctx, int64(1), paging).Return(followers, nil, nil)
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetCachedUserByID", ctx, int64(1)).Return(user, nil)
+		mockCache.On("IsFollowersCached", ctx, int64(1)).Return(false, errors.New("connection refused"))
+
+		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true}
+
+		res, next, err := service.GetFollowers(ctx, 1, paging)
+
+		assert.NoError(t, err)
+		assert.Equal(t, followers, res)
+		assert.Nil(t, next)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetByID", ctx, int64(1)).Return(nil, nil)
+
+		service := &UserService{dai: mockDAI}
+
+		res, next, err := service.GetFollowers(ctx, 1, paging)
+
+		assert.Nil(t, res)
+		assert.Nil(t, next)
+		assertAppError(t, err, common.CodeNotExistedUserID)
+	})
+
+	t.Run("limit too large", func(t *testing.T) {
+		service := &UserService{}
+
+		res, next, err := service.GetFollowers(ctx, 1, &model.Paging{Limit: maxFollowsPerPage + 1})
+
+		assert.Nil(t, res)
+		assert.Nil(t, next)
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+}
+
+func TestUserService_Unfollow(t *testing.T) {
+	ctx := context.Background()
+	user := &model.User{ID: 1, Username: "user"}
+	peer := &model.User{ID: 2, Username: "peer"}
+
+	t.Run("peer not found", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetByID", ctx, int64(1)).Return(user, nil)
+		mockDAI.On("GetByID", ctx, int64(2)).Return(nil, nil)
+
+		service := &UserService{dai: mockDAI}
+
+		err := service.Unfollow(ctx, 1, 2)
+
+		assertAppError(t, err, common.CodeNotExistedUserID)
+		mockDAI.AssertNotCalled(t, "Unfollow", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("remove cached follow and send unfollow", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("Unfollow", ctx, int64(1), int64(2)).Return(nil)
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetCachedUserByID", ctx, int64(1)).Return(user, nil)
+		mockCache.On("GetCachedUserByID", ctx, int64(2)).Return(peer, nil)
+		mockCache.On("RemoveCachedFollow", ctx, int64(1), int64(2)).Return(nil)
+		mockProducer := new(MockFollowMsgProducer)
+		mockProducer.On("SendUnfollow", ctx, mock.MatchedBy(func(f *model.Follow) bool {
+			return f.Follower == user && f.Following == peer
+		})).Return(nil)
+
+		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true, followMsgProducer: mockProducer}
+
+		err := service.Unfollow(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		mockDAI.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+		mockProducer.AssertExpectations(t)
+	})
+}
+
+func TestUserService_Follow_PrivatePeer(t *testing.T) {
+	ctx := context.Background()
+	user := &model.User{ID: 1, Username: "user"}
+	peer := &model.User{ID: 2, Username: "peer", IsPrivate: true}
+
+	t.Run("request to follow", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetByID", ctx, int64(1)).Return(user, nil)
+		mockDAI.On("GetByID", ctx, int64(2)).Return(peer, nil)
+		mockDAI.On("GetFollow", ctx, int64(1), int64(2)).Return(nil, nil)
+		mockDAI.On("CreateFollowRequest", ctx, int64(1), int64(2)).
+			Return(&model.FollowRequest{ID: 5, RequesterID: 1, TargetID: 2, CreatedTimestamp: 1700000000}, nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("IsAudienceMember", ctx, mock.Anything, model.AudienceListBlocked, mock.Anything).Return(false, nil)
+
+		service := &UserService{dai: mockDAI, audienceDai: mockAudienceDAI}
+
+		f, err := service.Follow(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		assert.True(t, f.Pending)
+		assert.Equal(t, int64(5), f.ID)
+		assert.Equal(t, user, f.Follower)
+		assert.Equal(t, peer, f.Following)
+		mockDAI.AssertNotCalled(t, "Follow", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("already followed", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetByID", ctx, int64(1)).Return(user, nil)
+		mockDAI.On("GetByID", ctx, int64(2)).Return(peer, nil)
+		mockDAI.On("GetFollow", ctx, int64(1), int64(2)).
+			Return(&model.Follow{ID: 3, FollowTs: 1600000000}, nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("IsAudienceMember", ctx, mock.Anything, model.AudienceListBlocked, mock.Anything).Return(false, nil)
+
+		service := &UserService{dai: mockDAI, audienceDai: mockAudienceDAI}
+
+		f, err := service.Follow(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		assert.False(t, f.Pending)
+		assert.Equal(t, int64(3), f.ID)
+		mockDAI.AssertNotCalled(t, "CreateFollowRequest", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserService_ApproveFollowRequest(t *testing.T) {
+	ctx := context.Background()
+	user := &model.User{ID: 1, Username: "user", IsPrivate: true}
+	requester := &model.User{ID: 2, Username: "requester"}
+
+	t.Run("request not found", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetByID", ctx, int64(1)).Return(user, nil)
+		mockDAI.On("GetByID", ctx, int64(2)).Return(requester, nil)
+		mockDAI.On("ApproveFollowRequest", ctx, int64(2), int64(1)).Return(nil, nil)
+
+		service := &UserService{dai: mockDAI}
+
+		f, err := service.ApproveFollowRequest(ctx, 1, 2)
+
+		assert.Nil(t, f)
+		assertAppError(t, err, common.CodeNotFound)
+	})
+
+	t.Run("add cached follow", func(t *testing.T) {
+		follow := &model.Follow{ID: 9, Follower: &model.User{ID: 2}, Following: &model.User{ID: 1}, FollowTs: 1700000000}
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("ApproveFollowRequest", ctx, int64(2), int64(1)).Return(follow, nil)
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetCachedUserByID", ctx, int64(1)).Return(user, nil)
+		mockCache.On("GetCachedUserByID", ctx, int64(2)).Return(requester, nil)
+		mockCache.On("AddCachedFollow", ctx, follow).Return(nil)
+
+		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true}
+
+		f, err := service.ApproveFollowRequest(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, requester, f.Follower)
+		assert.Equal(t, user, f.Following)
+		mockCache.AssertExpectations(t)
+	})
+}
+
+func TestUserService_Follow_Yourself(t *testing.T) {
+	mockDAI := new(MockUserDAI)
+	service := &UserService{dai: mockDAI}
+
+	f, err := service.Follow(context.Background(), 1, 1)
+
+	assert.Nil(t, f)
+	assertAppError(t, err, common.CodeInvalidRequest)
+	mockDAI.AssertNotCalled(t, "Follow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserService_Follow_Blocked(t *testing.T) {
+	ctx := context.Background()
+
+	mockDAI := new(MockUserDAI)
+	mockDAI.On("GetByID", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+	mockDAI.On("GetByID", ctx, int64(2)).Return(&model.User{ID: 2}, nil)
+	mockAudienceDAI := new(MockAudienceDAI)
+	mockAudienceDAI.On("IsAudienceMember", ctx, int64(1), model.AudienceListBlocked, int64(2)).Return(false, nil)
+	mockAudienceDAI.On("IsAudienceMember", ctx, int64(2), model.AudienceListBlocked, int64(1)).Return(true, nil)
+
+	service := &UserService{dai: mockDAI, audienceDai: mockAudienceDAI}
+
+	f, err := service.Follow(ctx, 1, 2)
+
+	assert.Nil(t, f)
+	assertAppError(t, err, common.CodeForbidden)
+	mockDAI.AssertNotCalled(t, "Follow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserService_Follow_BlockedAfterCheck(t *testing.T) {
+	ctx := context.Background()
+
+	// block is committed after isBlocked reads no block and before the follow transaction checks blocks again
+	mockDAI := new(MockUserDAI)
+	mockDAI.On("Follow", ctx, int64(1), int64(2)).Return(nil, nil)
+	mockAudienceDAI := new(MockAudienceDAI)
+	mockAudienceDAI.On("IsAudienceMember", ctx, mock.Anything, model.AudienceListBlocked, mock.Anything).Return(false, nil)
+	mockCache := new(MockUserCacheDAI)
+	mockCache.On("GetCachedUserByID", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+	mockCache.On("GetCachedUserByID", ctx, int64(2)).Return(&model.User{ID: 2}, nil)
+
+	service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true, audienceDai: mockAudienceDAI}
+
+	f, err := service.Follow(ctx, 1, 2)
+
+	assert.Nil(t, f)
+	assertAppError(t, err, common.CodeForbidden)
+	mockDAI.AssertExpectations(t)
+	mockCache.AssertNotCalled(t, "AddCachedFollow", mock.Anything, mock.Anything)
+}
+
+func TestUserService_Block(t *testing.T) {
+	ctx := context.Background()
+	user := &model.User{ID: 1, Username: "user"}
+	peer := &model.User{ID: 2, Username: "peer"}
+
+	t.Run("block yourself", func(t *testing.T) {
+		service := &UserService{}
+
+		err := service.Block(ctx, 1, 1)
+
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+
+	t.Run("remove follows and requests in both directions", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetFollow", ctx, int64(1), int64(2)).Return(nil, nil)
+		mockDAI.On("GetFollow", ctx, int64(2), int64(1)).Return(&model.Follow{ID: 3}, nil)
+		mockDAI.On("Unfollow", ctx, int64(2), int64(1)).Return(nil)
+		mockDAI.On("DeleteFollowRequest", ctx, int64(1), int64(2)).Return(true, nil)
+		mockDAI.On("DeleteFollowRequest", ctx, int64(2), int64(1)).Return(false, nil)
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetCachedUserByID", ctx, int64(1)).Return(user, nil)
+		mockCache.On("GetCachedUserByID", ctx, int64(2)).Return(peer, nil)
+		mockCache.On("AddBlock", ctx, int64(1), int64(2)).Return(nil)
+		mockCache.On("RemoveCachedFollow", ctx, int64(2), int64(1)).Return(nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("AddAudienceMember", ctx, mock.MatchedBy(func(m *model.AudienceMember) bool {
+			return m.OwnerID == 1 && m.List == model.AudienceListBlocked && m.MemberID == 2
+		})).Return(nil)
+		mockProducer := new(MockFollowMsgProducer)
+		mockProducer.On("SendUnfollow", ctx, mock.MatchedBy(func(f *model.Follow) bool {
+			return f.Follower == peer && f.Following == user
+		})).Return(nil)
+
+		service := &UserService{
+			dai:               mockDAI,
+			cacheDai:          mockCache,
+			enabledCache:      true,
+			audienceDai:       mockAudienceDAI,
+			followMsgProducer: mockProducer,
+		}
+
+		err := service.Block(ctx, 1, 2)
+
+		assert.NoError(t, err)
+		mockDAI.AssertExpectations(t)
+		mockDAI.AssertNotCalled(t, "Unfollow", ctx, int64(1), int64(2))
+		mockCache.AssertExpectations(t)
+		mockAudienceDAI.AssertExpectations(t)
+		mockProducer.AssertExpectations(t)
+	})
+
+	t.Run("fail if block is not cached", func(t *testing.T) {
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetCachedUserByID", ctx, int64(1)).Return(user, nil)
+		mockCache.On("GetCachedUserByID", ctx, int64(2)).Return(peer, nil)
+		mockCache.On("AddBlock", ctx, int64(1), int64(2)).Return(errors.New("redis down"))
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("AddAudienceMember", ctx, mock.Anything).Return(nil)
+		mockDAI := new(MockUserDAI)
+
+		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true, audienceDai: mockAudienceDAI}
+
+		err := service.Block(ctx, 1, 2)
+
+		assertAppError(t, err, common.CodeInternal)
+		mockDAI.AssertNotCalled(t, "GetFollow", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserService_GetProfile(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("blocked by user", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetByID", ctx, int64(2)).Return(&model.User{ID: 2}, nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("IsAudienceMember", ctx, int64(1), model.AudienceListBlocked, int64(2)).Return(false, nil)
+		mockAudienceDAI.On("IsAudienceMember", ctx, int64(2), model.AudienceListBlocked, int64(1)).Return(true, nil)
+
+		service := &UserService{dai: mockDAI, audienceDai: mockAudienceDAI}
+
+		user, err := service.GetProfile(ctx, 1, 2)
+
+		assert.Nil(t, user)
+		assertAppError(t, err, common.CodeNotExistedUserID)
+		mockDAI.AssertNotCalled(t, "GetCounts", mock.Anything, mock.Anything)
+	})
+
+	t.Run("load counts from db on cache miss", func(t *testing.T) {
+		counts := &model.UserCounts{Followers: 3, Followings: 2, Posts: 1}
+
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetCounts", ctx, int64(1)).Return(counts, nil)
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetCachedUserByID", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+		mockCache.On("GetCachedCounts", ctx, int64(1)).Return(nil, nil)
+		mockCache.On("SetCachedCounts", ctx, int64(1), counts).Return(nil)
+
+		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true}
+
+		user, err := service.GetProfile(ctx, 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, counts, user.Counts)
+		mockCache.AssertExpectations(t)
+	})
+}
+
+func TestUserService_ReconcileCounts(t *testing.T) {
+	ctx := context.Background()
+
+	mockDAI := new(MockUserDAI)
+	mockDAI.On("GetUserIDs", ctx, int64(0), 2).Return([]int64{1, 3}, nil)
+	mockDAI.On("ReconcileCounts", ctx, []int64{1, 3}).Return(nil)
+	mockCache := new(MockUserCacheDAI)
+	mockCache.On("DeleteCachedCounts", ctx, []int64{1, 3}).Return(nil)
+
+	service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true}
+
+	lastId, n, err := service.ReconcileCounts(ctx, 0, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), lastId)
+	assert.Equal(t, 2, n)
+	mockDAI.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+func TestUserService_GetRelationships(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalid number of user ids", func(t *testing.T) {
+		service := &UserService{}
+
+		res, err := service.GetRelationships(ctx, 1, nil)
+
+		assert.Nil(t, res)
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+
+	t.Run("load follows from db if they are not cached", func(t *testing.T) {
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetRelationships", ctx, int64(1), []int64{2, 3}).Return([]*model.Relationship{
+			{UserID: 2, Blocking: true},
+			{UserID: 3},
+		}, false, nil)
+		mockCache.On("IsAudienceCached", ctx, int64(1)).Return(true, nil)
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetFollowsBetween", ctx, int64(1), []int64{2, 3}).Return([]*model.Follow{
+			{Follower: &model.User{ID: 1}, Following: &model.User{ID: 3}},
+			{Follower: &model.User{ID: 3}, Following: &model.User{ID: 1}},
+		}, nil)
+
+		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true}
+
+		res, err := service.GetRelationships(ctx, 1, []int64{2, 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.Relationship{
+			{UserID: 2, Blocking: true},
+			{UserID: 3, Following: true, FollowedBy: true},
+		}, res)
+	})
+
+	t.Run("load blocks from db if they are not cached", func(t *testing.T) {
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetRelationships", ctx, int64(1), []int64{2, 3}).Return([]*model.Relationship{
+			{UserID: 2, Following: true},
+			{UserID: 3},
+		}, true, nil)
+		mockCache.On("IsAudienceCached", ctx, int64(1)).Return(false, nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("GetAudienceMembersBetween", ctx, int64(1), model.AudienceListBlocked, []int64{2, 3}).Return([]*model.AudienceMember{
+			{OwnerID: 1, List: model.AudienceListBlocked, MemberID: 3},
+		}, nil)
+
+		service := &UserService{dai: new(MockUserDAI), audienceDai: mockAudienceDAI, cacheDai: mockCache, enabledCache: true}
+
+		res, err := service.GetRelationships(ctx, 1, []int64{2, 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.Relationship{
+			{UserID: 2, Following: true},
+			{UserID: 3, Blocking: true},
+		}, res)
+	})
+
+	t.Run("load follows and blocks from db if cache is disabled", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetFollowsBetween", ctx, int64(1), []int64{2, 3}).Return([]*model.Follow{
+			{Follower: &model.User{ID: 1}, Following: &model.User{ID: 2}},
+		}, nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("GetAudienceMembersBetween", ctx, int64(1), model.AudienceListBlocked, []int64{2, 3}).Return([]*model.AudienceMember{
+			{OwnerID: 3, List: model.AudienceListBlocked, MemberID: 1},
+		}, nil)
+
+		service := &UserService{dai: mockDAI, audienceDai: mockAudienceDAI}
+
+		res, err := service.GetRelationships(ctx, 1, []int64{2, 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.Relationship{
+			{UserID: 2, Following: true},
+			{UserID: 3, BlockedBy: true},
+		}, res)
+	})
+}
+
+func TestUserService_ImportFollows(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalid follow", func(t *testing.T) {
+		service := &UserService{dai: new(MockUserDAI)}
+
+		n, _, err := service.ImportFollows(ctx, []*model.Follow{
+			{Follower: &model.User{ID: 1}, Following: &model.User{ID: 1}},
+		})
+
+		assert.Equal(t, 0, n)
+		assertAppError(t, err, common.CodeInvalidRequest)
+	})
+
+	t.Run("recount and cache imported follows", func(t *testing.T) {
+		follows := []*model.Follow{
+			{Follower: &model.User{ID: 1}, Following: &model.User{ID: 2}, FollowTs: 100},
+			{Follower: &model.User{ID: 3}, Following: &model.User{ID: 2}, FollowTs: 200},
+		}
+		imported := []*model.Follow{
+			{ID: 9, Follower: &model.User{ID: 3}, Following: &model.User{ID: 2}, FollowTs: 200},
+		}
+
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetExistingUserIDs", ctx, []int64{1, 2, 3}).Return([]int64{1, 2, 3}, nil)
+		mockDAI.On("ImportFollows", ctx, follows).Return(imported, nil)
+		mockDAI.On("ReconcileCounts", ctx, []int64{1, 2, 3}).Return(nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("GetAudienceMembersAmong", ctx, model.AudienceListBlocked, []int64{1, 2, 3}).Return([]*model.AudienceMember{}, nil)
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("AddCachedFollows", ctx, imported).Return(errors.New("redis down"))
+
+		service := &UserService{dai: mockDAI, audienceDai: mockAudienceDAI, cacheDai: mockCache, enabledCache: true}
+
+		n, skipped, err := service.ImportFollows(ctx, follows)
+
+		// follows are committed, a cache failure is not returned
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, 0, skipped)
+		mockDAI.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("skip follows of missing users and blocked users", func(t *testing.T) {
+		follows := []*model.Follow{
+			{Follower: &model.User{ID: 1}, Following: &model.User{ID: 2}, FollowTs: 100}, // 2 blocked 1
+			{Follower: &model.User{ID: 3}, Following: &model.User{ID: 4}, FollowTs: 200}, // 4 does not exist
+			{Follower: &model.User{ID: 3}, Following: &model.User{ID: 1}, FollowTs: 300},
+		}
+		imported := []*model.Follow{
+			{ID: 9, Follower: &model.User{ID: 3}, Following: &model.User{ID: 1}, FollowTs: 300},
+		}
+
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetExistingUserIDs", ctx, []int64{1, 2, 3, 4}).Return([]int64{1, 2, 3}, nil)
+		mockDAI.On("ImportFollows", ctx, follows[2:]).Return(imported, nil)
+		mockDAI.On("ReconcileCounts", ctx, []int64{3, 1}).Return(nil)
+		mockAudienceDAI := new(MockAudienceDAI)
+		mockAudienceDAI.On("GetAudienceMembersAmong", ctx, model.AudienceListBlocked, []int64{1, 2, 3}).Return([]*model.AudienceMember{
+			{OwnerID: 2, List: model.AudienceListBlocked, MemberID: 1},
+		}, nil)
+
+		service := &UserService{dai: mockDAI, audienceDai: mockAudienceDAI}
+
+		n, skipped, err := service.ImportFollows(ctx, follows)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, 2, skipped)
+		mockDAI.AssertExpectations(t)
+	})
+}
+
+func TestUserService_RefreshSession(t *testing.T) {
+	ctx := context.Background()
+	refreshToken := "refresh-token"
+	tokenHash := hashToken(refreshToken)
+	expiresTs := time.Now().Add(time.Hour).Unix()
+
+	t.Run("rotate refresh token", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetRefreshToken", ctx, tokenHash).
+			Return(&model.RefreshToken{SessionID: "s1", TokenHash: tokenHash, ExpiresTimestamp: expiresTs}, nil)
+		mockDAI.On("GetSession", ctx, "s1").Return(&model.Session{ID: "s1", UserID: 1}, nil)
+		mockDAI.On("RotateRefreshToken", ctx, tokenHash, mock.MatchedBy(func(next *model.RefreshToken) bool {
+			return next.SessionID == "s1" && next.TokenHash != tokenHash && !next.Used
+		})).Return(true, nil)
+		mockDAI.On("GetByID", ctx, int64(1)).Return(&model.User{ID: 1, Username: "user1"}, nil)
+
+		service := &UserService{cfg: Config{RefreshTokenTTL: time.Hour}, dai: mockDAI}
+
+		refreshed, err := service.RefreshSession(ctx, refreshToken)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "s1", refreshed.ID)
+		assert.Equal(t, "user1", refreshed.User.Username)
+		assert.NotEmpty(t, refreshed.RefreshToken)
+		assert.NotEqual(t, refreshToken, refreshed.RefreshToken)
+		mockDAI.AssertExpectations(t)
+	})
+
+	t.Run("reused refresh token revokes session", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetRefreshToken", ctx, tokenHash).
+			Return(&model.RefreshToken{SessionID: "s1", TokenHash: tokenHash, ExpiresTimestamp: expiresTs, Used: true}, nil)
+		mockDAI.On("GetSession", ctx, "s1").Return(&model.Session{ID: "s1", UserID: 1}, nil)
+		mockDAI.On("RevokeSession", ctx, int64(1), "s1").Return(nil)
+
+		service := &UserService{cfg: Config{RefreshTokenTTL: time.Hour}, dai: mockDAI}
+
+		refreshed, err := service.RefreshSession(ctx, refreshToken)
+
+		assert.Nil(t, refreshed)
+		assertAppError(t, err, common.CodeUnauthorized)
+		mockDAI.AssertExpectations(t)
+	})
+
+	t.Run("concurrent refresh revokes session", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetRefreshToken", ctx, tokenHash).
+			Return(&model.RefreshToken{SessionID: "s1", TokenHash: tokenHash, ExpiresTimestamp: expiresTs}, nil)
+		mockDAI.On("GetSession", ctx, "s1").Return(&model.Session{ID: "s1", UserID: 1}, nil)
+		mockDAI.On("RotateRefreshToken", ctx, tokenHash, mock.AnythingOfType("*model.RefreshToken")).Return(false, nil)
+		mockDAI.On("RevokeSession", ctx, int64(1), "s1").Return(nil)
+
+		service := &UserService{cfg: Config{RefreshTokenTTL: time.Hour}, dai: mockDAI}
+
+		_, err := service.RefreshSession(ctx, refreshToken)
+
+		assertAppError(t, err, common.CodeUnauthorized)
+		mockDAI.AssertExpectations(t)
 	})
+
+	t.Run("revoked session", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetRefreshToken", ctx, tokenHash).
+			Return(&model.RefreshToken{SessionID: "s1", TokenHash: tokenHash, ExpiresTimestamp: expiresTs}, nil)
+		mockDAI.On("GetSession", ctx, "s1").Return(&model.Session{ID: "s1", UserID: 1, Revoked: true}, nil)
+
+		service := &UserService{cfg: Config{RefreshTokenTTL: time.Hour}, dai: mockDAI}
+
+		_, err := service.RefreshSession(ctx, refreshToken)
+
+		assertAppError(t, err, common.CodeUnauthorized)
+		mockDAI.AssertExpectations(t)
+	})
+}
+
+func TestUserService_RequestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("mail reset token", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockMailer := new(MockMailer)
+		mockDAI.On("GetByEmail", ctx, "user1@example.com").
+			Return([]*model.User{{ID: 1, Username: "user1", Email: "user1@example.com"}}, nil)
+		mockDAI.On("CreatePasswordResetToken", ctx, mock.MatchedBy(func(token *model.PasswordResetToken) bool {
+			return token.UserID == 1 && len(token.TokenHash) == 64 && token.ExpiresTimestamp > token.CreatedTimestamp
+		})).Return(nil)
+		mockMailer.On("Send", ctx, mock.MatchedBy(func(mail *model.Mail) bool {
+			return mail.To == "user1@example.com" && strings.Contains(mail.Body, "https://example.com/reset?token=")
+		})).Return(nil)
+
+		service := &UserService{
+			cfg:    Config{PasswordResetTTL: time.Hour, PasswordResetURL: "https://example.com/reset"},
+			dai:    mockDAI,
+			mailer: mockMailer,
+		}
+
+		err := service.RequestPasswordReset(ctx, "user1@example.com")
+
+		assert.NoError(t, err)
+		mockDAI.AssertExpectations(t)
+		mockMailer.AssertExpectations(t)
+	})
+
+	t.Run("failing to mail known email responds as unknown email", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockMailer := new(MockMailer)
+		mockDAI.On("GetByEmail", ctx, "user1@example.com").
+			Return([]*model.User{{ID: 1, Username: "user1", Email: "user1@example.com"}}, nil)
+		mockDAI.On("CreatePasswordResetToken", ctx, mock.AnythingOfType("*model.PasswordResetToken")).Return(nil)
+		mockMailer.On("Send", ctx, mock.AnythingOfType("*model.Mail")).Return(errors.New("smtp down"))
+
+		service := &UserService{cfg: Config{PasswordResetTTL: time.Hour}, dai: mockDAI, mailer: mockMailer}
+
+		err := service.RequestPasswordReset(ctx, "user1@example.com")
+
+		assert.NoError(t, err)
+		mockMailer.AssertExpectations(t)
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockMailer := new(MockMailer)
+		mockDAI.On("GetByEmail", ctx, "nobody@example.com").Return([]*model.User{}, nil)
+
+		service := &UserService{cfg: Config{PasswordResetTTL: time.Hour}, dai: mockDAI, mailer: mockMailer}
+
+		err := service.RequestPasswordReset(ctx, "nobody@example.com")
+
+		assert.NoError(t, err)
+		mockDAI.AssertExpectations(t)
+		mockMailer.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserService_ConfirmPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	resetToken := "reset-token"
+
+	t.Run("reset password", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("ResetPassword", ctx, hashToken(resetToken), mock.MatchedBy(func(hashedPassword string) bool {
+			return checkPassword(hashedPassword, "new-password")
+		})).Return(int64(1), nil)
+
+		service := &UserService{dai: mockDAI}
+
+		err := service.ConfirmPasswordReset(ctx, resetToken, "new-password")
+
+		assert.NoError(t, err)
+		mockDAI.AssertExpectations(t)
+	})
+
+	t.Run("invalid or expired token", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("ResetPassword", ctx, hashToken(resetToken), mock.AnythingOfType("string")).Return(int64(0), nil)
+
+		service := &UserService{dai: mockDAI}
+
+		err := service.ConfirmPasswordReset(ctx, resetToken, "new-password")
+
+		assertAppError(t, err, common.CodeInvalidRequest)
+		mockDAI.AssertExpectations(t)
+	})
+
+	t.Run("short password", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+
+		service := &UserService{dai: mockDAI}
+
+		err := service.ConfirmPasswordReset(ctx, resetToken, "short")
+
+		assertAppError(t, err, common.CodeInvalidRequest)
+		mockDAI.AssertNotCalled(t, "ResetPassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserService_VerifyEmail(t *testing.T) {
+	ctx := context.Background()
+	verificationToken := "verification-token"
+
+	t.Run("verify email and refresh cached user", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("VerifyEmail", ctx, hashToken(verificationToken)).Return(int64(1), nil)
+		mockDAI.On("GetByID", ctx, int64(1)).Return(&model.User{ID: 1, EmailVerified: true}, nil)
+
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("SetCachedUser", ctx, mock.MatchedBy(func(user *model.User) bool {
+			return user.ID == 1 && user.EmailVerified
+		})).Return(nil)
+
+		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true}
+
+		user, err := service.VerifyEmail(ctx, verificationToken)
+
+		assert.NoError(t, err)
+		assert.True(t, user.EmailVerified)
+		mockDAI.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("invalid or expired token", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("VerifyEmail", ctx, hashToken(verificationToken)).Return(int64(0), nil)
+
+		service := &UserService{dai: mockDAI}
+
+		user, err := service.VerifyEmail(ctx, verificationToken)
+
+		assert.Nil(t, user)
+		assertAppError(t, err, common.CodeInvalidRequest)
+		mockDAI.AssertExpectations(t)
+	})
+}
+
+func TestUserService_CheckVerified(t *testing.T) {
+	ctx := context.Background()
+	unverifiedDenied := map[string]bool{model.ActionPost: true}
+
+	t.Run("allowed action", func(t *testing.T) {
+		service := &UserService{unverifiedDenied: unverifiedDenied}
+
+		err := service.CheckVerified(ctx, 1, model.ActionFollow)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("denied action of unverified user", func(t *testing.T) {
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetByID", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+
+		service := &UserService{dai: mockDAI, unverifiedDenied: unverifiedDenied}
+
+		err := service.CheckVerified(ctx, 1, model.ActionPost)
+
+		assertAppError(t, err, common.CodeEmailNotVerified)
+	})
+
+	t.Run("stale cached user is checked in db", func(t *testing.T) {
+		mockCache := new(MockUserCacheDAI)
+		mockCache.On("GetCachedUserByID", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+		mockDAI := new(MockUserDAI)
+		mockDAI.On("GetByID", ctx, int64(1)).Return(&model.User{ID: 1, EmailVerified: true}, nil)
+
+		service := &UserService{dai: mockDAI, cacheDai: mockCache, enabledCache: true, unverifiedDenied: unverifiedDenied}
+
+		err := service.CheckVerified(ctx, 1, model.ActionPost)
+
+		assert.NoError(t, err)
+		mockDAI.AssertExpectations(t)
+	})
+}
+
+func TestNew_UnverifiedDeniedActions(t *testing.T) {
+	_, err := New(Config{UnverifiedDeniedActions: []string{model.ActionPost, "delete"}}, nil, nil, nil, nil, nil)
+
+	assert.Error(t, err)
 }
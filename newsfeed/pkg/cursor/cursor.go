@@ -0,0 +1,74 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	version = 1
+
+	payloadSize   = 1 + 8 + 8 // version, sort key, id
+	signatureSize = 16        // truncated hmac-sha256, enough to stop forged cursors
+)
+
+var (
+	ErrEmptySecret   = errors.New("cursor secret is empty")
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// Cursor points to the last item of a page in a list sorted by (SortKey, ID).
+// ID breaks ties of items having the same SortKey, so no item is skipped or repeated across pages.
+// SortKey is 0 for lists sorted by id only.
+type Cursor struct {
+	SortKey int64
+	ID      int64
+}
+
+// Codec encodes cursors into opaque tokens signed with a secret, so clients can only pass back cursors they were given
+type Codec struct {
+	secret []byte
+}
+
+func NewCodec(secret string) (*Codec, error) {
+	if len(secret) == 0 {
+		return nil, ErrEmptySecret
+	}
+	return &Codec{secret: []byte(secret)}, nil
+}
+
+// Encode returns the url-safe token of a cursor
+func (c *Codec) Encode(cur Cursor) string {
+	data := make([]byte, payloadSize, payloadSize+signatureSize)
+	data[0] = version
+	binary.BigEndian.PutUint64(data[1:9], uint64(cur.SortKey))
+	binary.BigEndian.PutUint64(data[9:17], uint64(cur.ID))
+	data = append(data, c.sign(data)...)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode returns the cursor of a token, ErrInvalidCursor if the token is malformed or not signed by this codec
+func (c *Codec) Decode(token string) (Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(data) != payloadSize+signatureSize || data[0] != version {
+		return Cursor{}, ErrInvalidCursor
+	}
+	payload, signature := data[:payloadSize], data[payloadSize:]
+	if !hmac.Equal(signature, c.sign(payload)) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return Cursor{
+		SortKey: int64(binary.BigEndian.Uint64(payload[1:9])),
+		ID:      int64(binary.BigEndian.Uint64(payload[9:17])),
+	}, nil
+}
+
+func (c *Codec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)[:signatureSize]
+}
@@ -0,0 +1,42 @@
+package cursor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodec(t *testing.T) {
+	codec, err := NewCodec("secret")
+	assert.NoError(t, err)
+
+	t.Run("decode encoded cursor", func(t *testing.T) {
+		token := codec.Encode(Cursor{SortKey: 1700000000, ID: 42})
+
+		cur, err := codec.Decode(token)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Cursor{SortKey: 1700000000, ID: 42}, cur)
+	})
+
+	t.Run("reject cursor signed by another secret", func(t *testing.T) {
+		otherCodec, err := NewCodec("other secret")
+		assert.NoError(t, err)
+		token := otherCodec.Encode(Cursor{SortKey: 1700000000, ID: 42})
+
+		_, err = codec.Decode(token)
+
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("reject tampered or malformed cursor", func(t *testing.T) {
+		token := []byte(codec.Encode(Cursor{SortKey: 1700000000, ID: 42}))
+		token[5] ^= 1
+
+		for _, tc := range []string{string(token), "", "42", "not base64!"} {
+			_, err := codec.Decode(tc)
+
+			assert.ErrorIs(t, err, ErrInvalidCursor, tc)
+		}
+	})
+}
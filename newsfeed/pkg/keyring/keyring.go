@@ -0,0 +1,236 @@
+package keyring
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"ep.k16/newsfeed/pkg/logger"
+)
+
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+
+	keyFileExt     = ".pem"
+	signingKidFile = "signing_kid"
+
+	minRSAKeyBits = 2048
+)
+
+var (
+	ErrNoSigningKey = errors.New("no signing key")
+	ErrUnknownKey   = errors.New("unknown key")
+)
+
+// Key is a signing key pair, or a public key only for keys kept to verify tokens signed before a rotation
+type Key struct {
+	ID        string // kid header of tokens signed by the key
+	Algorithm string // RS256 for RSA keys, EdDSA for Ed25519 keys
+	Private   crypto.Signer
+	Public    crypto.PublicKey
+}
+
+// Keyring holds the keys of a dir, each <kid>.pem file is a PKCS#8 or PKCS#1 private key, or a PKIX public key.
+// Tokens are signed with the private key named by the signing_kid file of the dir, or the last kid in lexical order
+// if there is no such file. All keys verify tokens.
+//
+// To rotate: add the new private key, wait until verifiers fetched it, write its kid into signing_kid,
+// then replace the old private key with its public key until tokens signed by it expire, and finally remove it.
+type Keyring struct {
+	dir string
+
+	mu         sync.RWMutex
+	keys       map[string]*Key
+	signingKey *Key
+
+	watching atomic.Bool
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// Load loads keys of dir, it fails if dir has no private key to sign with
+func Load(dir string) (*Keyring, error) {
+	k := &Keyring{
+		dir:    dir,
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+	if err := k.Reload(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Reload replaces keys by those of dir, current keys are kept if dir is invalid
+func (k *Keyring) Reload() error {
+	keys, signingKey, err := loadDir(k.dir)
+	if err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys = keys
+	k.signingKey = signingKey
+	return nil
+}
+
+// Watch reloads keys every interval until Stop is called, it is blocking
+func (k *Keyring) Watch(interval time.Duration) {
+	k.watching.Store(true)
+	defer close(k.doneCh)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-k.stopCh:
+			return
+		case <-ticker.C:
+			if err := k.Reload(); err != nil {
+				logger.Error("failed to reload keys, keeping current keys", logger.E(err), logger.F("dir", k.dir))
+			}
+		}
+	}
+}
+
+// Stop stops Watch and waits for it to return, a Watch started later returns at once
+func (k *Keyring) Stop() {
+	close(k.stopCh)
+	if k.watching.Load() {
+		<-k.doneCh
+	}
+}
+
+// SigningKey returns the key signing new tokens
+func (k *Keyring) SigningKey() *Key {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.signingKey
+}
+
+// VerificationKey returns the key of a kid
+func (k *Keyring) VerificationKey(kid string) (*Key, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, kid)
+	}
+	return key, nil
+}
+
+// Keys returns all keys sorted by kid
+func (k *Keyring) Keys() []*Key {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	keys := make([]*Key, 0, len(k.keys))
+	for _, key := range k.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	return keys
+}
+
+func loadDir(dir string) (map[string]*Key, *Key, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read key dir: %w", err)
+	}
+
+	keys := make(map[string]*Key)
+	var lastPrivateKey *Key
+	for _, entry := range entries { // sorted by file name
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), keyFileExt) {
+			continue
+		}
+
+		kid := strings.TrimSuffix(entry.Name(), keyFileExt)
+		key, err := loadKey(filepath.Join(dir, entry.Name()), kid)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys[kid] = key
+		if key.Private != nil {
+			lastPrivateKey = key
+		}
+	}
+
+	signingKey := lastPrivateKey
+	data, err := os.ReadFile(filepath.Join(dir, signingKidFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("failed to read %s: %w", signingKidFile, err)
+	}
+	if err == nil {
+		kid := strings.TrimSpace(string(data))
+		signingKey = keys[kid]
+		if signingKey == nil || signingKey.Private == nil {
+			return nil, nil, fmt.Errorf("%w: %s has no private key", ErrNoSigningKey, kid)
+		}
+	}
+	if signingKey == nil {
+		return nil, nil, fmt.Errorf("%w in %s", ErrNoSigningKey, dir)
+	}
+
+	return keys, signingKey, nil
+}
+
+func loadKey(file string, kid string) (*Key, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key %s: %w", kid, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("key %s is not pem", kid)
+	}
+
+	var parsed any
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("key %s has unsupported pem type %s", kid, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key %s: %w", kid, err)
+	}
+
+	key := &Key{ID: kid}
+	switch v := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Algorithm, key.Private, key.Public = AlgorithmRS256, v, &v.PublicKey
+	case *rsa.PublicKey:
+		key.Algorithm, key.Public = AlgorithmRS256, v
+	case ed25519.PrivateKey:
+		key.Algorithm, key.Private, key.Public = AlgorithmEdDSA, v, v.Public()
+	case ed25519.PublicKey:
+		key.Algorithm, key.Public = AlgorithmEdDSA, v
+	default:
+		return nil, fmt.Errorf("key %s is neither rsa nor ed25519", kid)
+	}
+
+	if rsaKey, ok := key.Public.(*rsa.PublicKey); ok && rsaKey.N.BitLen() < minRSAKeyBits {
+		return nil, fmt.Errorf("rsa key %s must have at least %d bits", kid, minRSAKeyBits)
+	}
+	return key, nil
+}
@@ -0,0 +1,75 @@
+package keyring
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writePrivateKey(t *testing.T, dir, kid string, key any) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600))
+}
+
+func writePublicKey(t *testing.T, dir, kid string, key any) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	assert.NoError(t, err)
+	data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o644))
+}
+
+func TestKeyring_Reload(t *testing.T) {
+	dir := t.TempDir()
+
+	_, oldKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	writePrivateKey(t, dir, "2026-01", oldKey)
+
+	k, err := Load(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-01", k.SigningKey().ID)
+	assert.Equal(t, AlgorithmEdDSA, k.SigningKey().Algorithm)
+
+	// new key is published but old key keeps signing until signing_kid names the new key
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	writePrivateKey(t, dir, "2026-02", rsaKey)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "signing_kid"), []byte("2026-01\n"), 0o644))
+
+	assert.NoError(t, k.Reload())
+	assert.Equal(t, "2026-01", k.SigningKey().ID)
+	key, err := k.VerificationKey("2026-02")
+	assert.NoError(t, err)
+	assert.Equal(t, AlgorithmRS256, key.Algorithm)
+
+	// switch signing key, old key is kept to verify tokens it signed
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "signing_kid"), []byte("2026-02"), 0o644))
+	writePublicKey(t, dir, "2026-01", oldKey.Public())
+
+	assert.NoError(t, k.Reload())
+	assert.Equal(t, "2026-02", k.SigningKey().ID)
+	key, err = k.VerificationKey("2026-01")
+	assert.NoError(t, err)
+	assert.Nil(t, key.Private)
+
+	jwks := k.JWKS()
+	assert.Len(t, jwks.Keys, 2)
+	assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+	assert.Equal(t, "Ed25519", jwks.Keys[0].Crv)
+	assert.Equal(t, "RSA", jwks.Keys[1].Kty)
+	assert.Equal(t, "AQAB", jwks.Keys[1].E)
+
+	// invalid dir keeps current keys
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "signing_kid"), []byte("2026-01"), 0o644))
+	assert.ErrorIs(t, k.Reload(), ErrNoSigningKey)
+	assert.Equal(t, "2026-02", k.SigningKey().ID)
+}
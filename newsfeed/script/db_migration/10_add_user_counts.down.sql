@@ -0,0 +1,4 @@
+alter table users
+    drop column follower_count,
+    drop column following_count,
+    drop column post_count;
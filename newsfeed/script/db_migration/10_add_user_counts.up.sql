@@ -0,0 +1,10 @@
+alter table users
+    add column follower_count  bigint default 0,
+    add column following_count bigint default 0,
+    add column post_count      bigint default 0;
+
+-- backfill counts of existing users
+update users u
+set u.follower_count  = (select count(*) from user_users uu where uu.following_id = u.id and uu.removed = false),
+    u.following_count = (select count(*) from user_users uu where uu.follower_id = u.id and uu.removed = false),
+    u.post_count      = (select count(*) from posts p where p.user_id = u.id and p.removed = false);
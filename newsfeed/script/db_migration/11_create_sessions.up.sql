@@ -0,0 +1,20 @@
+create table sessions
+(
+    id                varchar(32) NOT NULL PRIMARY KEY,
+    user_id           bigint      NOT NULL,
+    created_timestamp bigint,
+    revoked           boolean default false,
+    index idx_sessions_user (user_id)
+);
+
+-- refresh tokens are stored as sha256 hex of the token
+create table refresh_tokens
+(
+    id                bigint      NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    session_id        varchar(32) NOT NULL,
+    token_hash        char(64)    NOT NULL,
+    expires_timestamp bigint,
+    used              boolean default false,
+    unique index uniq_refresh_tokens_hash (token_hash),
+    index idx_refresh_tokens_session (session_id)
+);
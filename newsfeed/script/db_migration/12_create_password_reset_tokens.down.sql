@@ -0,0 +1,4 @@
+alter table users
+    drop index idx_users_email;
+
+drop table if exists password_reset_tokens;
@@ -0,0 +1,15 @@
+-- reset tokens are stored as sha256 hex of the token
+create table password_reset_tokens
+(
+    id                bigint   NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    user_id           bigint   NOT NULL,
+    token_hash        char(64) NOT NULL,
+    created_timestamp bigint,
+    expires_timestamp bigint,
+    used              boolean default false,
+    unique index uniq_password_reset_tokens_hash (token_hash),
+    index idx_password_reset_tokens_user (user_id)
+);
+
+alter table users
+    add index idx_users_email (email);
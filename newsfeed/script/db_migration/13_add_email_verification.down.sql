@@ -0,0 +1,4 @@
+drop table if exists email_verification_tokens;
+
+alter table users
+    drop column email_verified;
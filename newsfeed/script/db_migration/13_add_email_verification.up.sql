@@ -0,0 +1,18 @@
+-- new accounts start unverified, existing accounts were active before verification existed so they are kept verified
+alter table users
+    add column email_verified boolean default false;
+update users
+set email_verified = true;
+
+-- verification tokens are stored as sha256 hex of the token
+create table email_verification_tokens
+(
+    id                bigint   NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    user_id           bigint   NOT NULL,
+    token_hash        char(64) NOT NULL,
+    created_timestamp bigint,
+    expires_timestamp bigint,
+    used              boolean default false,
+    unique index uniq_email_verification_tokens_hash (token_hash),
+    index idx_email_verification_tokens_user (user_id)
+);
@@ -0,0 +1,3 @@
+alter table user_users
+    drop index uniq_user_users_follow,
+    add index idx_user_users_follower (follower_id, following_id);
@@ -0,0 +1,15 @@
+-- concurrent follows could insert the same pair twice, keep one row of each pair, an active one if any
+delete uu
+from user_users uu
+         join user_users keep
+              on keep.follower_id = uu.follower_id and keep.following_id = uu.following_id and
+                 (keep.removed < uu.removed or (keep.removed = uu.removed and keep.id < uu.id));
+
+alter table user_users
+    drop index idx_user_users_follower,
+    add unique index uniq_user_users_follow (follower_id, following_id);
+
+-- duplicated follows were counted twice
+update users u
+set u.follower_count  = (select count(*) from user_users uu where uu.following_id = u.id and uu.removed = false),
+    u.following_count = (select count(*) from user_users uu where uu.follower_id = u.id and uu.removed = false);
@@ -0,0 +1,2 @@
+alter table audience_members
+    drop index idx_audience_members_member;
@@ -0,0 +1,3 @@
+-- blocks and mutes are also looked up by member, e.g. to rebuild cached blocked_by and muted_by sets
+alter table audience_members
+    add index idx_audience_members_member (member_id, list_name);
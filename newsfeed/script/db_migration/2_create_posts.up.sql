@@ -0,0 +1,9 @@
+create table posts
+(
+    id                bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    user_id           bigint,
+    content           text,
+    created_timestamp bigint,
+    removed           boolean,
+    index idx_posts_user_id (user_id, id)
+);
@@ -0,0 +1,15 @@
+create table media
+(
+    id                bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    user_id           bigint,
+    post_id           bigint default 0,
+    position          int default 0,
+    content_type      varchar(64),
+    size              bigint,
+    width             int,
+    height            int,
+    blob_key          varchar(255),
+    thumbnail_key     varchar(255),
+    created_timestamp bigint,
+    index idx_media_post_id (post_id)
+);
@@ -0,0 +1,4 @@
+alter table posts
+    drop column comment_count;
+
+drop table if exists comments;
@@ -0,0 +1,15 @@
+create table comments
+(
+    id                bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    post_id           bigint,
+    user_id           bigint,
+    parent_id         bigint default 0,
+    content           text,
+    reply_count       bigint default 0,
+    created_timestamp bigint,
+    removed           boolean,
+    index idx_comments_post_id (post_id, parent_id, id)
+);
+
+alter table posts
+    add column comment_count bigint default 0;
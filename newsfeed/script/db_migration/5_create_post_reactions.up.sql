@@ -0,0 +1,8 @@
+create table post_reactions
+(
+    post_id           bigint NOT NULL,
+    user_id           bigint NOT NULL,
+    reaction_type     varchar(16),
+    updated_timestamp bigint,
+    PRIMARY KEY (post_id, user_id)
+);
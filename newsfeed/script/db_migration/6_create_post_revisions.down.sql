@@ -0,0 +1,4 @@
+alter table posts
+    drop column edited_timestamp;
+
+drop table if exists post_revisions;
@@ -0,0 +1,11 @@
+create table post_revisions
+(
+    id                bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    post_id           bigint,
+    content           text,
+    created_timestamp bigint,
+    index idx_post_revisions_post_id (post_id, id)
+);
+
+alter table posts
+    add column edited_timestamp bigint default 0;
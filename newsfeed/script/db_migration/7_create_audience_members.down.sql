@@ -0,0 +1,4 @@
+alter table posts
+    drop column visibility;
+
+drop table if exists audience_members;
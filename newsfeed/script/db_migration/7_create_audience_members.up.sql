@@ -0,0 +1,12 @@
+create table if not exists audience_members
+(
+    id                bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    owner_id          bigint NOT NULL,
+    list_name         varchar(32) NOT NULL,
+    member_id         bigint NOT NULL,
+    created_timestamp bigint,
+    unique index uniq_audience_members (owner_id, list_name, member_id)
+);
+
+alter table posts
+    add column visibility varchar(16) default 'public';
@@ -0,0 +1,4 @@
+alter table users
+    drop column is_private;
+
+drop table if exists follow_requests;
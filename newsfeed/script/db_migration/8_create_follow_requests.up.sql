@@ -0,0 +1,12 @@
+alter table users
+    add column is_private boolean default false;
+
+create table follow_requests
+(
+    id                bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    requester_id      bigint NOT NULL,
+    target_id         bigint NOT NULL,
+    created_timestamp bigint,
+    unique index uniq_follow_requests (requester_id, target_id),
+    index idx_follow_requests_target (target_id, id)
+);
@@ -0,0 +1,3 @@
+alter table user_users
+    drop index idx_user_users_follower,
+    drop index idx_user_users_following;
@@ -0,0 +1,3 @@
+alter table user_users
+    add index idx_user_users_follower (follower_id, following_id),
+    add index idx_user_users_following (following_id, follower_id);
@@ -5,16 +5,18 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"time"
+	"strconv"
 
 	"github.com/caarlos0/env/v11"
 	"github.com/joho/godotenv"
 	"golang.org/x/time/rate"
 )
 
+// http server must run with DEV_AUTH_ENABLED=true, requests impersonate DevUserID instead of logging in
 type config struct {
-	HttpHost string `env:"HTTP_HOST"`
-	HttpPort int    `env:"HTTP_PORT"`
+	HttpHost  string `env:"HTTP_HOST"`
+	HttpPort  int    `env:"HTTP_PORT"`
+	DevUserID int64  `env:"DEV_USER_ID" envDefault:"3"`
 }
 
 func main() {
@@ -30,16 +32,23 @@ func main() {
 	for {
 		rateLimiter.Wait(ctx)
 
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, getFollowingsUrl(cfg.HttpHost, cfg.HttpPort), nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+		req.Header.Set("X-Dev-User-Id", strconv.FormatInt(cfg.DevUserID, 10))
+
 		httpClient := &http.Client{}
-		resp, err := httpClient.Get(getFollowingsUrl(cfg.HttpHost, cfg.HttpPort))
+		resp, err := httpClient.Do(req)
 		if err != nil {
 			log.Println("err", err)
 		} else {
 			log.Println("resp", resp.Status)
+			resp.Body.Close()
 		}
 	}
 }
 
 func getFollowingsUrl(host string, port int) string {
-	return fmt.Sprintf("http://%s:%d/user/me/followings?last_value=%d&limit=10&mock=true", host, port, time.Now().Unix())
+	return fmt.Sprintf("http://%s:%d/grpc/me/followings?limit=10", host, port)
 }